@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+
+	"github.com/ledgerwatch/log/v3"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/eth/tracers/logger"
+	"github.com/ledgerwatch/erigon/tests"
+)
+
+var RunFlag = cli.StringFlag{
+	Name:  "run",
+	Value: ".*",
+	Usage: "Run only those tests matching the regular expression.",
+}
+
+var blockTestCommand = cli.Command{
+	Action:    blockTestCmd,
+	Name:      "blocktest",
+	Usage:     "executes the given blockchain tests",
+	ArgsUsage: "<file>",
+	Flags:     []cli.Flag{&RunFlag},
+}
+
+// BlocktestResult contains the outcome of a blockchain test.
+type BlocktestResult struct {
+	Name  string `json:"name"`
+	Pass  bool   `json:"pass"`
+	Fork  string `json:"fork"`
+	Error string `json:"error,omitempty"`
+}
+
+func blockTestCmd(ctx *cli.Context) error {
+	machineFriendlyOutput := ctx.Bool(MachineFlag.Name)
+	if machineFriendlyOutput {
+		log.Root().SetHandler(log.DiscardHandler())
+	} else {
+		log.Root().SetHandler(log.LvlFilterHandler(log.LvlWarn, log.StderrHandler))
+	}
+
+	// Configure the EVM logger
+	config := &logger.LogConfig{
+		DisableMemory:     ctx.Bool(DisableMemoryFlag.Name),
+		DisableStack:      ctx.Bool(DisableStackFlag.Name),
+		DisableStorage:    ctx.Bool(DisableStorageFlag.Name),
+		DisableReturnData: ctx.Bool(DisableReturnDataFlag.Name),
+	}
+	newTracer := func() vm.EVMLogger { return nil }
+	if machineFriendlyOutput {
+		newTracer = func() vm.EVMLogger { return logger.NewJSONLogger(config, os.Stderr) }
+	} else if ctx.Bool(DebugFlag.Name) {
+		newTracer = func() vm.EVMLogger { return logger.NewStructLogger(config) }
+	}
+	re, err := regexp.Compile(ctx.String(RunFlag.Name))
+	if err != nil {
+		return fmt.Errorf("invalid regex -%s: %v", RunFlag.Name, err)
+	}
+
+	if len(ctx.Args().First()) != 0 {
+		return runBlockTest(ctx.Args().First(), re, newTracer)
+	}
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		fname := scanner.Text()
+		if len(fname) == 0 {
+			return nil
+		}
+		if err := runBlockTest(fname, re, newTracer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runBlockTest loads the blockchain tests given by fname, and executes the ones matching re.
+func runBlockTest(fname string, re *regexp.Regexp, newTracer func() vm.EVMLogger) error {
+	src, err := os.ReadFile(fname)
+	if err != nil {
+		return err
+	}
+	var blockTests map[string]*tests.BlockTest
+	if err = json.Unmarshal(src, &blockTests); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(blockTests))
+	for name := range blockTests {
+		if re.MatchString(name) {
+			keys = append(keys, name)
+		}
+	}
+	sort.Strings(keys)
+
+	results := make([]BlocktestResult, 0, len(keys))
+	for _, name := range keys {
+		test := blockTests[name]
+		result := BlocktestResult{Name: name, Fork: test.Network(), Pass: true}
+		tracer := newTracer()
+		if err := runBlockTestCase(test, tracer); err != nil {
+			result.Pass, result.Error = false, err.Error()
+		}
+		if structLogger, ok := tracer.(*logger.StructLogger); ok {
+			logger.WriteTrace(os.Stderr, structLogger.StructLogs())
+		}
+		results = append(results, result)
+	}
+	out, _ := json.MarshalIndent(results, "", "  ")
+	fmt.Println(string(out))
+	return nil
+}
+
+// runBlockTestCase runs a single block test. Without a testing.TB the mock chain panics on setup
+// failures (e.g. a genesis which can't be inserted), those are reported as the failure of the test.
+func runBlockTestCase(test *tests.BlockTest, tracer vm.EVMLogger) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return test.Run(nil, true, tracer)
+}
@@ -0,0 +1,240 @@
+package t8ntool
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+
+	"github.com/ledgerwatch/erigon/common/math"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/rlp"
+)
+
+// header is the b11r input header, the fields which t8n doesn't compute are optional.
+type header struct {
+	ParentHash            libcommon.Hash        `json:"parentHash"`
+	OmmerHash             *libcommon.Hash       `json:"sha3Uncles"`
+	Coinbase              *libcommon.Address    `json:"miner"`
+	Root                  libcommon.Hash        `json:"stateRoot"`
+	TxHash                *libcommon.Hash       `json:"transactionsRoot"`
+	ReceiptHash           *libcommon.Hash       `json:"receiptsRoot"`
+	Bloom                 types.Bloom           `json:"logsBloom"`
+	Difficulty            *math.HexOrDecimal256 `json:"difficulty"`
+	Number                *math.HexOrDecimal256 `json:"number"`
+	GasLimit              math.HexOrDecimal64   `json:"gasLimit"`
+	GasUsed               math.HexOrDecimal64   `json:"gasUsed"`
+	Time                  math.HexOrDecimal64   `json:"timestamp"`
+	Extra                 hexutility.Bytes      `json:"extraData"`
+	MixDigest             libcommon.Hash        `json:"mixHash"`
+	Nonce                 *types.BlockNonce     `json:"nonce"`
+	BaseFee               *math.HexOrDecimal256 `json:"baseFeePerGas"`
+	WithdrawalsHash       *libcommon.Hash       `json:"withdrawalsRoot"`
+	BlobGasUsed           *math.HexOrDecimal64  `json:"blobGasUsed"`
+	ExcessBlobGas         *math.HexOrDecimal64  `json:"excessBlobGas"`
+	ParentBeaconBlockRoot *libcommon.Hash       `json:"parentBeaconBlockRoot"`
+	RequestsRoot          *libcommon.Hash       `json:"requestsRoot"`
+}
+
+type bbInput struct {
+	Header      *header             `json:"header,omitempty"`
+	OmmersRlp   []hexutility.Bytes  `json:"ommers,omitempty"`
+	TxRlp       hexutility.Bytes    `json:"txs,omitempty"`
+	Withdrawals []*types.Withdrawal `json:"withdrawals,omitempty"`
+	RequestsRlp hexutility.Bytes    `json:"requests,omitempty"`
+}
+
+// ToBlock assembles the block from the input as is, the roots of the header are not recomputed.
+func (i *bbInput) ToBlock() (*types.Block, error) {
+	if i.Header == nil {
+		return nil, errors.New("missing header")
+	}
+	if i.Header.Number == nil {
+		return nil, errors.New("missing required field 'number' for header")
+	}
+	h := &types.Header{
+		ParentHash:            i.Header.ParentHash,
+		UncleHash:             types.EmptyUncleHash,
+		Root:                  i.Header.Root,
+		TxHash:                types.EmptyRootHash,
+		ReceiptHash:           types.EmptyRootHash,
+		Bloom:                 i.Header.Bloom,
+		Difficulty:            new(big.Int),
+		Number:                (*big.Int)(i.Header.Number),
+		GasLimit:              uint64(i.Header.GasLimit),
+		GasUsed:               uint64(i.Header.GasUsed),
+		Time:                  uint64(i.Header.Time),
+		Extra:                 i.Header.Extra,
+		MixDigest:             i.Header.MixDigest,
+		WithdrawalsHash:       i.Header.WithdrawalsHash,
+		ParentBeaconBlockRoot: i.Header.ParentBeaconBlockRoot,
+		RequestsRoot:          i.Header.RequestsRoot,
+	}
+	if i.Header.OmmerHash != nil {
+		h.UncleHash = *i.Header.OmmerHash
+	}
+	if i.Header.Coinbase != nil {
+		h.Coinbase = *i.Header.Coinbase
+	}
+	if i.Header.TxHash != nil {
+		h.TxHash = *i.Header.TxHash
+	}
+	if i.Header.ReceiptHash != nil {
+		h.ReceiptHash = *i.Header.ReceiptHash
+	}
+	if i.Header.Difficulty != nil {
+		h.Difficulty = (*big.Int)(i.Header.Difficulty)
+	}
+	if i.Header.Nonce != nil {
+		h.Nonce = *i.Header.Nonce
+	}
+	if i.Header.BaseFee != nil {
+		h.BaseFee = (*big.Int)(i.Header.BaseFee)
+	}
+	if i.Header.BlobGasUsed != nil {
+		blobGasUsed := uint64(*i.Header.BlobGasUsed)
+		h.BlobGasUsed = &blobGasUsed
+	}
+	if i.Header.ExcessBlobGas != nil {
+		excessBlobGas := uint64(*i.Header.ExcessBlobGas)
+		h.ExcessBlobGas = &excessBlobGas
+	}
+
+	ommers := make([]*types.Header, 0, len(i.OmmersRlp))
+	for _, enc := range i.OmmersRlp {
+		var ommer types.Header
+		if err := rlp.DecodeBytes(enc, &ommer); err != nil {
+			return nil, fmt.Errorf("failed decoding ommer: %w", err)
+		}
+		ommers = append(ommers, &ommer)
+	}
+
+	var txs []types.Transaction
+	if len(i.TxRlp) > 0 {
+		s := rlp.NewStream(bytes.NewReader(i.TxRlp), uint64(len(i.TxRlp)))
+		if _, err := s.List(); err != nil {
+			return nil, fmt.Errorf("failed decoding txs: %w", err)
+		}
+		for {
+			tx, err := types.DecodeRLPTransaction(s, false)
+			if errors.Is(err, rlp.EOL) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed decoding tx %d: %w", len(txs), err)
+			}
+			txs = append(txs, tx)
+		}
+	}
+
+	var requests types.Requests
+	if len(i.RequestsRlp) > 0 {
+		if err := rlp.DecodeBytes(i.RequestsRlp, &requests); err != nil {
+			return nil, fmt.Errorf("failed decoding requests: %w", err)
+		}
+	} else if h.RequestsRoot != nil {
+		requests = types.Requests{}
+	}
+	withdrawals := i.Withdrawals
+	if withdrawals == nil && h.WithdrawalsHash != nil {
+		withdrawals = []*types.Withdrawal{}
+	}
+	return types.NewBlockFromStorage(h.Hash(), h, txs, ommers, withdrawals, requests), nil
+}
+
+// BuildBlock is the entry point of the b11r tool, it assembles an RLP block from the t8n outputs.
+func BuildBlock(ctx *cli.Context) error {
+	inputData, err := readInput(ctx)
+	if err != nil {
+		return err
+	}
+	block, err := inputData.ToBlock()
+	if err != nil {
+		return NewError(ErrorVMConfig, err)
+	}
+	return dispatchBlock(ctx, block)
+}
+
+func readInput(ctx *cli.Context) (*bbInput, error) {
+	var (
+		headerStr      = ctx.String(InputHeaderFlag.Name)
+		ommersStr      = ctx.String(InputOmmersFlag.Name)
+		txsStr         = ctx.String(InputTxsRlpFlag.Name)
+		withdrawalsStr = ctx.String(InputWithdrawalsFlag.Name)
+		requestsStr    = ctx.String(InputRequestsFlag.Name)
+		inputData      = &bbInput{}
+	)
+	if headerStr == stdinSelector || ommersStr == stdinSelector || txsStr == stdinSelector ||
+		withdrawalsStr == stdinSelector || requestsStr == stdinSelector {
+		decoder := json.NewDecoder(os.Stdin)
+		if err := decoder.Decode(inputData); err != nil {
+			return nil, NewError(ErrorJson, fmt.Errorf("failed unmarshaling stdin: %v", err))
+		}
+	}
+	for _, in := range []struct {
+		file, name string
+		dest       interface{}
+	}{
+		{headerStr, "header", &inputData.Header},
+		{ommersStr, "ommers", &inputData.OmmersRlp},
+		{txsStr, "txs", &inputData.TxRlp},
+		{withdrawalsStr, "withdrawals", &inputData.Withdrawals},
+		{requestsStr, "requests", &inputData.RequestsRlp},
+	} {
+		if in.file == stdinSelector || in.file == "" {
+			continue
+		}
+		if err := readFile(in.file, in.name, in.dest); err != nil {
+			return nil, err
+		}
+	}
+	return inputData, nil
+}
+
+func readFile(path, desc string, dest interface{}) error {
+	inFile, err := os.Open(path)
+	if err != nil {
+		return NewError(ErrorIO, fmt.Errorf("failed reading %s file: %v", desc, err))
+	}
+	defer inFile.Close()
+	if err = json.NewDecoder(inFile).Decode(dest); err != nil {
+		return NewError(ErrorJson, fmt.Errorf("failed unmarshaling %s file: %v", desc, err))
+	}
+	return nil
+}
+
+// dispatchBlock writes the RLP of the block and its hash to either stderr or stdout, or to the specified file
+func dispatchBlock(ctx *cli.Context, block *types.Block) error {
+	raw, err := rlp.EncodeToBytes(block)
+	if err != nil {
+		return NewError(ErrorRlp, fmt.Errorf("failed encoding block: %v", err))
+	}
+	enc := struct {
+		Rlp  hexutility.Bytes `json:"rlp"`
+		Hash libcommon.Hash   `json:"hash"`
+	}{raw, block.Hash()}
+	b, err := json.MarshalIndent(enc, "", "  ")
+	if err != nil {
+		return NewError(ErrorJson, fmt.Errorf("failed marshalling output: %v", err))
+	}
+	switch dest := ctx.String(OutputBlockFlag.Name); dest {
+	case "stdout":
+		os.Stdout.Write(b)
+		os.Stdout.WriteString("\n")
+	case "stderr":
+		os.Stderr.Write(b)
+		os.Stderr.WriteString("\n")
+	default:
+		if err := os.WriteFile(dest, b, 0644); err != nil { //nolint:gosec
+			return NewError(ErrorIO, fmt.Errorf("failed writing output: %v", err))
+		}
+	}
+	return nil
+}
@@ -61,6 +61,7 @@ const (
 
 	ErrorJson = 10
 	ErrorIO   = 11
+	ErrorRlp  = 12
 
 	stdinSelector = "stdin"
 )
@@ -429,7 +430,7 @@ func getTransaction(txJson jsonrpc.RPCTransaction) (types.Transaction, error) {
 			return legacyTx, nil
 		}
 
-	case types.DynamicFeeTxType:
+	case types.DynamicFeeTxType, types.SetCodeTxType:
 		var tip *uint256.Int
 		var feeCap *uint256.Int
 		if txJson.Tip != nil {
@@ -464,6 +465,12 @@ func getTransaction(txJson jsonrpc.RPCTransaction) (types.Transaction, error) {
 		dynamicFeeTx.S.SetFromBig(txJson.S.ToInt())
 		dynamicFeeTx.R.SetFromBig(txJson.R.ToInt())
 
+		if txJson.Type == types.SetCodeTxType {
+			return &types.SetCodeTransaction{
+				DynamicFeeTransaction: dynamicFeeTx,
+				Authorizations:        txJson.AuthorizationList,
+			}, nil
+		}
 		return &dynamicFeeTx, nil
 
 	default:
@@ -154,6 +154,22 @@ var stateTransitionCommand = cli.Command{
 	},
 }
 
+var blockBuilderCommand = cli.Command{
+	Name:    "block-builder",
+	Aliases: []string{"b11r"},
+	Usage:   "builds a block",
+	Action:  t8ntool.BuildBlock,
+	Flags: []cli.Flag{
+		&t8ntool.OutputBlockFlag,
+		&t8ntool.InputHeaderFlag,
+		&t8ntool.InputOmmersFlag,
+		&t8ntool.InputTxsRlpFlag,
+		&t8ntool.InputWithdrawalsFlag,
+		&t8ntool.InputRequestsFlag,
+		&t8ntool.VerbosityFlag,
+	},
+}
+
 func init() {
 	app.Flags = []cli.Flag{
 		&BenchFlag,
@@ -181,6 +197,8 @@ func init() {
 		&DisableReturnDataFlag,
 	}
 	app.Commands = []*cli.Command{
+		&blockBuilderCommand,
+		&blockTestCommand,
 		&compileCommand,
 		&disasmCommand,
 		&runCommand,
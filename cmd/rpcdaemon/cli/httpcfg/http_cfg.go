@@ -48,10 +48,13 @@ type HttpCfg struct {
 	WebsocketCompression              bool
 	WebsocketSubscribeLogsChannelSize int
 	RpcAllowListFilePath              string
+	RpcRateLimitFilePath              string
 	RpcBatchConcurrency               uint
 	RpcStreamingDisable               bool
 	DBReadConcurrency                 int
-	TraceCompatibility                bool // Bug for bug compatibility for trace_ routines with OpenEthereum
+	TraceCompatibility                bool   // Bug for bug compatibility for trace_ routines with OpenEthereum
+	TraceWorkers                      int    // Number of workers tracing transactions of a block concurrently (debug_traceBlock*, trace_replayBlockTransactions)
+	TraceFilterMaxBlocks              uint64 // Max blocks replayed per page of paginated trace_filter
 	TxPoolApiAddr                     string
 	StateCache                        kvcache.CoherentConfig
 	Snap                              ethconfig.BlocksFreezing
@@ -87,4 +90,6 @@ type HttpCfg struct {
 	OtsMaxPageSize uint64
 
 	RPCSlowLogThreshold time.Duration
+
+	ExtraIndices []string // must match the extra indices of Erigon, see state.ExtraIndices
 }
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/rpc"
+)
+
+// parseRateLimitForRPC reads rpc.RateLimitConfig from a JSON file, for example:
+//
+//	{
+//	  "clientKey": "header:X-Api-Key",
+//	  "default": {"rate": 100, "burst": 1000},
+//	  "clients": {"trusted-key": {"rate": 10000, "burst": 100000}},
+//	  "defaultCost": 1,
+//	  "costs": {"debug_traceBlockByNumber": 500, "trace_filter": 1000},
+//	  "maxWait": "2s"
+//	}
+//
+// With "clientKey": "jwt", "jwtSecretPath" points to the hex encoded secret verifying the bearer tokens.
+func parseRateLimitForRPC(path string) (*rpc.CostRateLimiter, error) {
+	path = strings.TrimSpace(path)
+	if path == "" { // no file is provided
+		return nil, nil
+	}
+
+	fileContents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg struct {
+		rpc.RateLimitConfig
+		JwtSecretPath string `json:"jwtSecretPath"`
+	}
+	if err = json.Unmarshal(fileContents, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if cfg.JwtSecretPath != "" {
+		data, err := os.ReadFile(cfg.JwtSecretPath)
+		if err != nil {
+			return nil, err
+		}
+		cfg.JwtSecret = common.FromHex(strings.TrimSpace(string(data)))
+		if len(cfg.JwtSecret) != 32 {
+			return nil, fmt.Errorf("invalid JWT secret in %s: length %d", cfg.JwtSecretPath, len(cfg.JwtSecret))
+		}
+	}
+
+	return rpc.NewCostRateLimiter(cfg.RateLimitConfig)
+}
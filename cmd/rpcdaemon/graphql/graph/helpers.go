@@ -3,8 +3,10 @@ package graph
 import (
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
+	"strings"
 
 	hexutil2 "github.com/ledgerwatch/erigon-lib/common/hexutil"
 
@@ -13,7 +15,11 @@ import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 
+	"github.com/ledgerwatch/erigon/cmd/rpcdaemon/graphql/graph/model"
+	"github.com/ledgerwatch/erigon/core"
 	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/rpc"
+	"github.com/ledgerwatch/erigon/turbo/adapter/ethapi"
 )
 
 func convertDataToStringP(abstractMap map[string]interface{}, field string) *string {
@@ -112,12 +118,11 @@ func convertDataToUint64P(abstractMap map[string]interface{}, field string) *uin
 	case *hexutil2.Big:
 		result = v.ToInt().Uint64()
 	case int:
-		result = abstractMap[field].(uint64)
+		result = uint64(v)
 	case uint64:
-		result = abstractMap[field].(uint64)
+		result = v
 	default:
-		fmt.Println("unhandled/uint64", reflect.TypeOf(abstractMap[field]), field, abstractMap[field])
-		result = 0
+		return nil
 	}
 
 	return &result
@@ -135,3 +140,140 @@ func convertStrHexToDec(hexString *string) *string {
 
 	return &result
 }
+
+// parseBigInt parses a BigInt scalar, which is either decimal or 0x-prefixed hexadecimal
+func parseBigInt(s string) (*big.Int, error) {
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return hexutil2.DecodeBig(s)
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid BigInt: %s", s)
+	}
+	return v, nil
+}
+
+func parseAddress(s string) (libcommon.Address, error) {
+	if !libcommon.IsHexAddress(s) {
+		return libcommon.Address{}, fmt.Errorf("invalid Address: %s", s)
+	}
+	return libcommon.HexToAddress(s), nil
+}
+
+func parseAddresses(addresses []string) ([]libcommon.Address, error) {
+	result := make([]libcommon.Address, 0, len(addresses))
+	for _, address := range addresses {
+		addr, err := parseAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, addr)
+	}
+	return result, nil
+}
+
+func parseTopics(topics [][]string) [][]libcommon.Hash {
+	result := make([][]libcommon.Hash, 0, len(topics))
+	for _, alternatives := range topics {
+		hashes := make([]libcommon.Hash, 0, len(alternatives))
+		for _, topic := range alternatives {
+			hashes = append(hashes, libcommon.HexToHash(topic))
+		}
+		result = append(result, hashes)
+	}
+	return result
+}
+
+func convertCallData(data model.CallData) (ethapi.CallArgs, error) {
+	var args ethapi.CallArgs
+	if data.From != nil {
+		from, err := parseAddress(*data.From)
+		if err != nil {
+			return args, err
+		}
+		args.From = &from
+	}
+	if data.To != nil {
+		to, err := parseAddress(*data.To)
+		if err != nil {
+			return args, err
+		}
+		args.To = &to
+	}
+	if data.Gas != nil {
+		args.Gas = (*hexutil2.Uint64)(data.Gas)
+	}
+	for _, field := range []struct {
+		value *string
+		dest  **hexutil2.Big
+	}{
+		{data.GasPrice, &args.GasPrice},
+		{data.MaxFeePerGas, &args.MaxFeePerGas},
+		{data.MaxPriorityFeePerGas, &args.MaxPriorityFeePerGas},
+		{data.Value, &args.Value},
+	} {
+		if field.value == nil {
+			continue
+		}
+		v, err := parseBigInt(*field.value)
+		if err != nil {
+			return args, err
+		}
+		*field.dest = (*hexutil2.Big)(v)
+	}
+	if data.Data != nil {
+		input, err := hexutil2.Decode(*data.Data)
+		if err != nil {
+			return args, err
+		}
+		args.Data = (*hexutility.Bytes)(&input)
+	}
+	return args, nil
+}
+
+func convertCallResult(result *core.ExecutionResult) *model.CallResult {
+	status := uint64(1)
+	if result.Failed() {
+		status = 0
+	}
+	return &model.CallResult{
+		Data:    hexutility.Bytes(result.ReturnData).String(),
+		GasUsed: result.UsedGas,
+		Status:  status,
+	}
+}
+
+func convertLog(log *types.Log) *model.Log {
+	l := &model.Log{
+		Index:           int(log.Index),
+		Account:         &model.Account{Address: strings.ToLower(log.Address.String()), BlockNumber: rpc.BlockNumber(log.BlockNumber)},
+		Topics:          make([]string, 0, len(log.Topics)),
+		Data:            hexutility.Bytes(log.Data).String(),
+		TransactionHash: log.TxHash.String(),
+	}
+	for _, topic := range log.Topics {
+		l.Topics = append(l.Topics, topic.String())
+	}
+	return l
+}
+
+// convertTransaction converts a transaction which has no receipt yet, e.g. a pending one
+func convertTransaction(txn types.Transaction, blockNumber rpc.BlockNumber) *model.Transaction {
+	txType := int(txn.Type())
+	trans := &model.Transaction{
+		Hash:      txn.Hash().String(),
+		Nonce:     hexutil2.EncodeUint64(txn.GetNonce()),
+		Value:     txn.GetValue().Hex(),
+		GasPrice:  txn.GetPrice().Hex(),
+		Gas:       txn.GetGas(),
+		InputData: hexutility.Bytes(txn.GetData()).String(),
+		Type:      &txType,
+		Logs:      []*model.Log{},
+	}
+	sender, _ := txn.GetSender()
+	trans.From = &model.Account{Address: strings.ToLower(sender.String()), BlockNumber: blockNumber}
+	if to := txn.GetTo(); to != nil {
+		trans.To = &model.Account{Address: strings.ToLower(to.String()), BlockNumber: blockNumber}
+	}
+	return trans
+}
@@ -0,0 +1,21 @@
+package model
+
+import "github.com/ledgerwatch/erigon/rpc"
+
+// Models below are written by hand, gqlgen binds them instead of generating.
+// Schema fields they don't have are served by resolvers.
+
+// Account is an account whose state (balance, transactionCount, code, storage) is resolved as of BlockNumber
+type Account struct {
+	Address     string          `json:"address"`
+	BlockNumber rpc.BlockNumber `json:"-"`
+}
+
+// Log keeps the hash of its transaction, the transaction itself is resolved on demand
+type Log struct {
+	Index           int      `json:"index"`
+	Account         *Account `json:"account"`
+	Topics          []string `json:"topics"`
+	Data            string   `json:"data"`
+	TransactionHash string   `json:"-"`
+}
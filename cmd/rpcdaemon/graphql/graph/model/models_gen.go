@@ -7,14 +7,6 @@ type AccessTuple struct {
 	StorageKeys []string `json:"storageKeys"`
 }
 
-type Account struct {
-	Address          string `json:"address"`
-	Balance          string `json:"balance"`
-	TransactionCount uint64 `json:"transactionCount"`
-	Code             string `json:"code"`
-	Storage          string `json:"storage"`
-}
-
 type Block struct {
 	Number            uint64         `json:"number"`
 	Hash              string         `json:"hash"`
@@ -78,12 +70,7 @@ type FilterCriteria struct {
 	Topics    [][]string `json:"topics,omitempty"`
 }
 
-type Log struct {
-	Index       int          `json:"index"`
-	Account     *Account     `json:"account"`
-	Topics      []string     `json:"topics"`
-	Data        string       `json:"data"`
-	Transaction *Transaction `json:"transaction"`
+type Mutation struct {
 }
 
 type Pending struct {
@@ -94,6 +81,9 @@ type Pending struct {
 	EstimateGas      uint64         `json:"estimateGas"`
 }
 
+type Query struct {
+}
+
 type SyncState struct {
 	StartingBlock uint64 `json:"startingBlock"`
 	CurrentBlock  uint64 `json:"currentBlock"`
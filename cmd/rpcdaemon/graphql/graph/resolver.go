@@ -1,7 +1,15 @@
 package graph
 
 import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon/cmd/rpcdaemon/graphql/graph/model"
+	"github.com/ledgerwatch/erigon/rpc"
 	"github.com/ledgerwatch/erigon/turbo/jsonrpc"
 	"github.com/ledgerwatch/erigon/turbo/rpchelper"
 	"github.com/ledgerwatch/erigon/turbo/services"
@@ -17,3 +25,85 @@ type Resolver struct {
 	filters     *rpchelper.Filters
 	blockReader services.FullBlockReader
 }
+
+// maxBlocksRange limits the amount of blocks returned by the blocks query, longer ranges are rejected
+const maxBlocksRange = 25
+
+type blockCacheKey struct{}
+
+// blockCache keeps the blocks built while resolving one request. Building a block computes all its receipts,
+// so nested fields like the transaction of every log must not rebuild it each time.
+type blockCache struct {
+	mu     sync.Mutex
+	blocks map[uint64]*cachedBlock
+}
+
+type cachedBlock struct {
+	once  sync.Once
+	block *model.Block
+	err   error
+}
+
+// WithBlockCache returns a context sharing built blocks between the resolvers of one request
+func WithBlockCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, blockCacheKey{}, &blockCache{blocks: map[uint64]*cachedBlock{}})
+}
+
+func (r *Resolver) blockByNumber(ctx context.Context, number uint64) (*model.Block, error) {
+	numberStr := strconv.FormatUint(number, 10)
+	cache, ok := ctx.Value(blockCacheKey{}).(*blockCache)
+	if !ok {
+		return r.Query().Block(ctx, &numberStr, nil)
+	}
+	cache.mu.Lock()
+	entry, ok := cache.blocks[number]
+	if !ok {
+		entry = &cachedBlock{}
+		cache.blocks[number] = entry
+	}
+	cache.mu.Unlock()
+	entry.once.Do(func() {
+		entry.block, entry.err = r.Query().Block(ctx, &numberStr, nil)
+	})
+	return entry.block, entry.err
+}
+
+// transaction returns a mined transaction together with its block, nil if it is not mined
+func (r *Resolver) transaction(ctx context.Context, hash string) (*model.Transaction, error) {
+	blockNumber, err := r.GraphQLAPI.GetTransactionBlockNumber(ctx, libcommon.HexToHash(hash))
+	if err != nil || blockNumber == nil {
+		return nil, err
+	}
+	block, err := r.blockByNumber(ctx, *blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	for _, trans := range block.Transactions {
+		if strings.EqualFold(trans.Hash, hash) {
+			return trans, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Resolver) call(ctx context.Context, blockNumber rpc.BlockNumber, data model.CallData) (*model.CallResult, error) {
+	args, err := convertCallData(data)
+	if err != nil {
+		return nil, err
+	}
+	result, err := r.GraphQLAPI.Call(ctx, args, rpc.BlockNumberOrHashWithNumber(blockNumber))
+	if err != nil || result == nil {
+		return nil, err
+	}
+	return convertCallResult(result), nil
+}
+
+func (r *Resolver) estimateGas(ctx context.Context, blockNumber rpc.BlockNumber, data model.CallData) (uint64, error) {
+	args, err := convertCallData(data)
+	if err != nil {
+		return 0, err
+	}
+	blockNrOrHash := rpc.BlockNumberOrHashWithNumber(blockNumber)
+	gas, err := r.GraphQLAPI.EstimateGas(ctx, &args, &blockNrOrHash)
+	return uint64(gas), err
+}
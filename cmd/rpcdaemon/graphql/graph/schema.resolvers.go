@@ -8,18 +8,118 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 	"github.com/ledgerwatch/erigon/cmd/rpcdaemon/graphql/graph/model"
 	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/eth/filters"
 	"github.com/ledgerwatch/erigon/rpc"
 )
 
+// Balance is the resolver for the balance field.
+func (r *accountResolver) Balance(ctx context.Context, obj *model.Account) (string, error) {
+	balance, err := r.GraphQLAPI.GetBalance(ctx, libcommon.HexToAddress(obj.Address), rpc.BlockNumberOrHashWithNumber(obj.BlockNumber))
+	if err != nil {
+		return "", err
+	}
+	return balance.String(), nil
+}
+
+// TransactionCount is the resolver for the transactionCount field.
+func (r *accountResolver) TransactionCount(ctx context.Context, obj *model.Account) (uint64, error) {
+	nonce, err := r.GraphQLAPI.GetTransactionCount(ctx, libcommon.HexToAddress(obj.Address), rpc.BlockNumberOrHashWithNumber(obj.BlockNumber))
+	if err != nil {
+		return 0, err
+	}
+	return uint64(*nonce), nil
+}
+
+// Code is the resolver for the code field.
+func (r *accountResolver) Code(ctx context.Context, obj *model.Account) (string, error) {
+	code, err := r.GraphQLAPI.GetCode(ctx, libcommon.HexToAddress(obj.Address), rpc.BlockNumberOrHashWithNumber(obj.BlockNumber))
+	if err != nil {
+		return "", err
+	}
+	return code.String(), nil
+}
+
+// Storage is the resolver for the storage field.
+func (r *accountResolver) Storage(ctx context.Context, obj *model.Account, slot string) (string, error) {
+	return r.GraphQLAPI.GetStorageAt(ctx, libcommon.HexToAddress(obj.Address), slot, rpc.BlockNumberOrHashWithNumber(obj.BlockNumber))
+}
+
+// Logs is the resolver for the logs field.
+func (r *blockResolver) Logs(ctx context.Context, obj *model.Block, filter model.BlockFilterCriteria) ([]*model.Log, error) {
+	addresses, err := parseAddresses(filter.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	blockHash := libcommon.HexToHash(obj.Hash)
+	logs, err := r.GraphQLAPI.GetLogs(ctx, filters.FilterCriteria{
+		BlockHash: &blockHash,
+		Addresses: addresses,
+		Topics:    parseTopics(filter.Topics),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Log, 0, len(logs))
+	for _, log := range logs {
+		result = append(result, convertLog(log))
+	}
+	return result, nil
+}
+
+// Account is the resolver for the account field.
+func (r *blockResolver) Account(ctx context.Context, obj *model.Block, address string) (*model.Account, error) {
+	return &model.Account{Address: strings.ToLower(address), BlockNumber: rpc.BlockNumber(obj.Number)}, nil
+}
+
+// Call is the resolver for the call field.
+func (r *blockResolver) Call(ctx context.Context, obj *model.Block, data model.CallData) (*model.CallResult, error) {
+	return r.call(ctx, rpc.BlockNumber(obj.Number), data)
+}
+
+// EstimateGas is the resolver for the estimateGas field.
+func (r *blockResolver) EstimateGas(ctx context.Context, obj *model.Block, data model.CallData) (uint64, error) {
+	return r.estimateGas(ctx, rpc.BlockNumber(obj.Number), data)
+}
+
+// Transaction is the resolver for the transaction field.
+func (r *logResolver) Transaction(ctx context.Context, obj *model.Log) (*model.Transaction, error) {
+	return r.transaction(ctx, obj.TransactionHash)
+}
+
 // SendRawTransaction is the resolver for the sendRawTransaction field.
 func (r *mutationResolver) SendRawTransaction(ctx context.Context, data string) (string, error) {
-	panic(fmt.Errorf("not implemented: SendRawTransaction - sendRawTransaction"))
+	encodedTx, err := hexutil.Decode(data)
+	if err != nil {
+		return "", err
+	}
+	hash, err := r.GraphQLAPI.SendRawTransaction(ctx, encodedTx)
+	if err != nil {
+		return "", err
+	}
+	return hash.String(), nil
+}
+
+// Account is the resolver for the account field.
+func (r *pendingResolver) Account(ctx context.Context, obj *model.Pending, address string) (*model.Account, error) {
+	return &model.Account{Address: strings.ToLower(address), BlockNumber: rpc.PendingBlockNumber}, nil
+}
+
+// Call is the resolver for the call field.
+func (r *pendingResolver) Call(ctx context.Context, obj *model.Pending, data model.CallData) (*model.CallResult, error) {
+	return r.call(ctx, rpc.PendingBlockNumber, data)
+}
+
+// EstimateGas is the resolver for the estimateGas field.
+func (r *pendingResolver) EstimateGas(ctx context.Context, obj *model.Pending, data model.CallData) (uint64, error) {
+	return r.estimateGas(ctx, rpc.PendingBlockNumber, data)
 }
 
 // Block is the resolver for the block field.
@@ -79,7 +179,8 @@ func (r *queryResolver) Block(ctx context.Context, number *string, hash *string)
 		block.GasLimit = uint64(*convertDataToUint64P(blk, "gasLimit"))
 		block.GasUsed = *convertDataToUint64P(blk, "gasUsed")
 		block.Hash = *convertDataToStringP(blk, "hash")
-		block.Miner = &model.Account{}
+		block.Number = *convertDataToUint64P(blk, "number")
+		block.Miner = &model.Account{BlockNumber: rpc.BlockNumber(block.Number)}
 		address := convertDataToStringP(blk, "miner")
 		if address != nil {
 			block.Miner.Address = strings.ToLower(*address)
@@ -92,7 +193,6 @@ func (r *queryResolver) Block(ctx context.Context, number *string, hash *string)
 		if blockNonce != nil {
 			block.Nonce = *blockNonce
 		}
-		block.Number = *convertDataToUint64P(blk, "number")
 		block.Ommers = []*model.Block{}
 		block.Parent = &model.Block{}
 		block.Parent.Hash = *convertDataToStringP(blk, "parentHash")
@@ -111,7 +211,7 @@ func (r *queryResolver) Block(ctx context.Context, number *string, hash *string)
 		absRcp := res["receipts"]
 		rcp := absRcp.([]map[string]interface{})
 		for _, transReceipt := range rcp {
-			trans := &model.Transaction{}
+			trans := &model.Transaction{Block: block}
 			trans.CumulativeGasUsed = convertDataToUint64P(transReceipt, "cumulativeGasUsed")
 			trans.InputData = *convertDataToStringP(transReceipt, "data")
 			trans.EffectiveGasPrice = convertDataToStringP(transReceipt, "effectiveGasPrice")
@@ -130,10 +230,11 @@ func (r *queryResolver) Block(ctx context.Context, number *string, hash *string)
 			trans.Logs = make([]*model.Log, 0)
 			for _, rlog := range transReceipt["logs"].(types.Logs) {
 				tlog := model.Log{
-					Index: int(rlog.Index),
-					Data:  "0x" + hex.EncodeToString(rlog.Data),
+					Index:           int(rlog.Index),
+					Data:            "0x" + hex.EncodeToString(rlog.Data),
+					TransactionHash: trans.Hash,
 				}
-				tlog.Account = &model.Account{}
+				tlog.Account = &model.Account{BlockNumber: rpc.BlockNumber(block.Number)}
 				tlog.Account.Address = strings.ToLower(rlog.Address.String())
 
 				for _, rtopic := range rlog.Topics {
@@ -143,10 +244,10 @@ func (r *queryResolver) Block(ctx context.Context, number *string, hash *string)
 				trans.Logs = append(trans.Logs, &tlog)
 			}
 
-			trans.From = &model.Account{}
+			trans.From = &model.Account{BlockNumber: rpc.BlockNumber(block.Number)}
 			trans.From.Address = strings.ToLower(*convertDataToStringP(transReceipt, "from"))
 
-			trans.To = &model.Account{}
+			trans.To = &model.Account{BlockNumber: rpc.BlockNumber(block.Number)}
 			address := convertDataToStringP(transReceipt, "to")
 			// To address could be nil in case of contract creation
 			if address != nil {
@@ -164,19 +265,36 @@ func (r *queryResolver) Block(ctx context.Context, number *string, hash *string)
 func (r *queryResolver) Blocks(ctx context.Context, from *uint64, to *uint64) ([]*model.Block, error) {
 	var blocks []*model.Block
 
-	const maxBlocks = 25
-
+	if from == nil {
+		return nil, fmt.Errorf("from block is required")
+	}
 	fromBlockNumber := *from
-	toBlockNumber := *to
+	var toBlockNumber uint64
+	if to != nil {
+		toBlockNumber = *to
+	} else {
+		latest, err := r.Block(ctx, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		toBlockNumber = latest.Number
+	}
 
-	if toBlockNumber >= fromBlockNumber && (toBlockNumber-fromBlockNumber+1) < maxBlocks {
+	if toBlockNumber < fromBlockNumber {
+		return []*model.Block{}, nil
+	}
+	if toBlockNumber-fromBlockNumber+1 > maxBlocksRange {
+		return nil, fmt.Errorf("block range %d-%d is longer than %d blocks", fromBlockNumber, toBlockNumber, maxBlocksRange)
+	}
 
-		for i := fromBlockNumber; i <= toBlockNumber; i++ {
-			blockNumberStr := strconv.FormatUint(i, 10)
-			block, _ := r.Block(ctx, &blockNumberStr, nil)
-			if block != nil {
-				blocks = append(blocks, block)
-			}
+	for i := fromBlockNumber; i <= toBlockNumber; i++ {
+		blockNumberStr := strconv.FormatUint(i, 10)
+		block, err := r.Block(ctx, &blockNumberStr, nil)
+		if err != nil {
+			return nil, err
+		}
+		if block != nil && block.Hash != "" {
+			blocks = append(blocks, block)
 		}
 	}
 
@@ -185,32 +303,94 @@ func (r *queryResolver) Blocks(ctx context.Context, from *uint64, to *uint64) ([
 
 // Pending is the resolver for the pending field.
 func (r *queryResolver) Pending(ctx context.Context) (*model.Pending, error) {
-	panic(fmt.Errorf("not implemented: Pending - pending"))
+	pending := &model.Pending{Transactions: []*model.Transaction{}}
+	block, err := r.GraphQLAPI.GetPendingBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return pending, nil
+	}
+	for i, txn := range block.Transactions() {
+		trans := convertTransaction(txn, rpc.PendingBlockNumber)
+		index := i
+		trans.Index = &index
+		pending.Transactions = append(pending.Transactions, trans)
+	}
+	pending.TransactionCount = len(pending.Transactions)
+	return pending, nil
 }
 
 // Transaction is the resolver for the transaction field.
 func (r *queryResolver) Transaction(ctx context.Context, hash string) (*model.Transaction, error) {
-	panic(fmt.Errorf("not implemented: Transaction - transaction"))
+	return r.transaction(ctx, hash)
 }
 
 // Logs is the resolver for the logs field.
 func (r *queryResolver) Logs(ctx context.Context, filter model.FilterCriteria) ([]*model.Log, error) {
-	panic(fmt.Errorf("not implemented: Logs - logs"))
+	addresses, err := parseAddresses(filter.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	crit := filters.FilterCriteria{
+		Addresses: addresses,
+		Topics:    parseTopics(filter.Topics),
+	}
+	// unset bounds default to the latest block
+	if filter.FromBlock != nil {
+		crit.FromBlock = new(big.Int).SetUint64(*filter.FromBlock)
+	}
+	if filter.ToBlock != nil {
+		crit.ToBlock = new(big.Int).SetUint64(*filter.ToBlock)
+	}
+	logs, err := r.GraphQLAPI.GetLogs(ctx, crit)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Log, 0, len(logs))
+	for _, log := range logs {
+		result = append(result, convertLog(log))
+	}
+	return result, nil
 }
 
 // GasPrice is the resolver for the gasPrice field.
 func (r *queryResolver) GasPrice(ctx context.Context) (string, error) {
-	panic(fmt.Errorf("not implemented: GasPrice - gasPrice"))
+	price, err := r.GraphQLAPI.GasPrice(ctx)
+	if err != nil {
+		return "", err
+	}
+	return price.String(), nil
 }
 
 // MaxPriorityFeePerGas is the resolver for the maxPriorityFeePerGas field.
 func (r *queryResolver) MaxPriorityFeePerGas(ctx context.Context) (string, error) {
-	panic(fmt.Errorf("not implemented: MaxPriorityFeePerGas - maxPriorityFeePerGas"))
+	tip, err := r.GraphQLAPI.MaxPriorityFeePerGas(ctx)
+	if err != nil {
+		return "", err
+	}
+	return tip.String(), nil
 }
 
 // Syncing is the resolver for the syncing field.
 func (r *queryResolver) Syncing(ctx context.Context) (*model.SyncState, error) {
-	panic(fmt.Errorf("not implemented: Syncing - syncing"))
+	res, err := r.GraphQLAPI.Syncing(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status, ok := res.(map[string]interface{})
+	if !ok {
+		// not syncing
+		return nil, nil
+	}
+	currentBlock, highestBlock := convertDataToUint64P(status, "currentBlock"), convertDataToUint64P(status, "highestBlock")
+	if currentBlock == nil || highestBlock == nil {
+		return nil, fmt.Errorf("unexpected syncing status: %v", status)
+	}
+	return &model.SyncState{
+		CurrentBlock: *currentBlock,
+		HighestBlock: *highestBlock,
+	}, nil
 }
 
 // ChainID is the resolver for the chainID field.
@@ -220,11 +400,27 @@ func (r *queryResolver) ChainID(ctx context.Context) (string, error) {
 	return "0x" + strconv.FormatUint(chainID.Uint64(), 16), err
 }
 
+// Account returns AccountResolver implementation.
+func (r *Resolver) Account() AccountResolver { return &accountResolver{r} }
+
+// Block returns BlockResolver implementation.
+func (r *Resolver) Block() BlockResolver { return &blockResolver{r} }
+
+// Log returns LogResolver implementation.
+func (r *Resolver) Log() LogResolver { return &logResolver{r} }
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
+// Pending returns PendingResolver implementation.
+func (r *Resolver) Pending() PendingResolver { return &pendingResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+type accountResolver struct{ *Resolver }
+type blockResolver struct{ *Resolver }
+type logResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
+type pendingResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
@@ -1,9 +1,11 @@
 package graphql
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
 
@@ -33,7 +35,11 @@ func CreateHandler(api []rpc.API) *handler.Server {
 	resolver := graph.Resolver{}
 	resolver.GraphQLAPI = graphqlAPI
 
-	return handler.NewDefaultServer(graph.NewExecutableSchema(graph.Config{Resolvers: &resolver})) // TODO : init resolver.DB here !!!
+	srv := handler.NewDefaultServer(graph.NewExecutableSchema(graph.Config{Resolvers: &resolver})) // TODO : init resolver.DB here !!!
+	srv.AroundOperations(func(ctx context.Context, next gqlgraphql.OperationHandler) gqlgraphql.ResponseHandler {
+		return next(graph.WithBlockCache(ctx))
+	})
+	return srv
 }
 
 func ProcessGraphQLcheckIfNeeded(
@@ -3,9 +3,19 @@ package graphql
 import (
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
 	"testing"
+
+	"github.com/ledgerwatch/erigon-lib/kv/kvcache"
+	"github.com/ledgerwatch/log/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon/cmd/rpcdaemon/rpcdaemontest"
+	"github.com/ledgerwatch/erigon/rpc"
+	"github.com/ledgerwatch/erigon/rpc/rpccfg"
+	"github.com/ledgerwatch/erigon/turbo/jsonrpc"
 )
 
 func TestGraphQLQueryBlock(t *testing.T) {
@@ -132,3 +142,70 @@ func TestGraphQLQueryBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestGraphQLResolvers(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	base := jsonrpc.NewBaseApi(nil, kvcache.New(kvcache.DefaultCoherentConfig), m.BlockReader, m.HistoryV3Components(), false, rpccfg.DefaultEvmCallTimeout, m.Engine, m.Dirs)
+	eth := jsonrpc.NewEthAPI(base, m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, 128, log.New())
+	gql := jsonrpc.NewGraphQLAPI(base, m.DB, eth)
+	server := httptest.NewServer(CreateHandler([]rpc.API{{Namespace: "graphql", Service: jsonrpc.GraphQLAPI(gql)}}))
+	defer server.Close()
+
+	for i, tt := range []struct {
+		body string
+		want string
+		comp string
+	}{
+		{ // Account state at a block
+			body: `{"query": "{block(number:\"0\"){account(address:\"0x71562b71999873db5b286df957af199ec94617f7\"){balance,transactionCount,code}}}"}`,
+			want: `{"data":{"block":{"account":{"balance":"0x7ce66c50e2840000","transactionCount":0,"code":"0x"}}}}`,
+		},
+		{ // Transaction by hash, together with its block
+			body: `{"query": "{transaction(hash:\"0x3f3cb8a0e13ed2481f97f53f7095b9cbc78b6ffb779f2d3e565146371a8830ea\"){hash,status,block{number},from{address}}}"}`,
+			want: `{"data":{"transaction":{"hash":"0x3f3cb8a0e13ed2481f97f53f7095b9cbc78b6ffb779f2d3e565146371a8830ea","status":1,"block":{"number":\d+},"from":{"address":"0x[0-9a-f]{40}"}}}}`,
+			comp: "regexp",
+		},
+		{ // Unknown transaction
+			body: `{"query": "{transaction(hash:\"0x0000000000000000000000000000000000000000000000000000000000000001\"){hash}}"}`,
+			want: `{"data":{"transaction":null}}`,
+		},
+		{ // Logs filter, the transaction of a log is resolved on demand
+			body: `{"query": "{logs(filter:{fromBlock:0,toBlock:10}){index,account{address},transaction{hash}}}"}`,
+			want: `{"data":{"logs":\[{"index":\d+,"account":{"address":"0x[0-9a-f]{40}"},"transaction":{"hash":"0x[0-9a-f]{64}"}}`,
+			comp: "regexp",
+		},
+		{ // Call and estimateGas at a block
+			body: `{"query": "{block(number:\"1\"){call(data:{from:\"0x71562b71999873db5b286df957af199ec94617f7\",to:\"0x703c4b2bd70c169f5717101caee543299fc946c7\",value:\"1\"}){data,gasUsed,status},estimateGas(data:{from:\"0x71562b71999873db5b286df957af199ec94617f7\",to:\"0x703c4b2bd70c169f5717101caee543299fc946c7\",value:\"0x1\"})}}"}`,
+			want: `{"data":{"block":{"call":{"data":"0x","gasUsed":21000,"status":1},"estimateGas":21000}}}`,
+		},
+		{ // No pending block without a miner
+			body: `{"query": "{pending{transactionCount,transactions{hash}}}"}`,
+			want: `{"data":{"pending":{"transactionCount":0,"transactions":[]}}}`,
+		},
+		{ // Block range
+			body: `{"query": "{blocks(from:1,to:3){number}}"}`,
+			want: `{"data":{"blocks":[{"number":1},{"number":2},{"number":3}]}}`,
+		},
+		{ // Block range over the limit
+			body: `{"query": "{blocks(from:0,to:100){number}}"}`,
+			want: `{"errors":\[{"message":"block range 0-100 is longer than 25 blocks"`,
+			comp: "regexp",
+		},
+	} {
+		resp, err := http.Post(server.URL, "application/json", strings.NewReader(tt.body))
+		require.NoError(t, err)
+		bodyBytes, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.NoError(t, err)
+
+		if have := string(bodyBytes); tt.comp == "" && have != tt.want {
+			t.Errorf("testcase (exact match) %d %s,\nhave:\n%v\nwant:\n%v", i, tt.body, have, tt.want)
+		}
+		if have := string(bodyBytes); tt.comp == "regexp" {
+			match, err := regexp.MatchString(tt.want, have)
+			if err != nil || !match {
+				t.Errorf("testcase (regexp) %d %s,\nhave:\n%v\nwant:\n%v %t", i, tt.body, have, tt.want, match)
+			}
+		}
+	}
+}
@@ -58,6 +58,11 @@ var (
 	blobPriceBump      uint64
 
 	noTxGossip bool
+	ordering   string
+
+	journal          bool
+	journalRetention time.Duration
+	journalLimit     uint64
 
 	commitEvery time.Duration
 )
@@ -86,6 +91,10 @@ func init() {
 	rootCmd.PersistentFlags().Uint64Var(&blobPriceBump, "txpool.blobpricebump", txpoolcfg.DefaultConfig.BlobPriceBump, "Price bump percentage to replace an existing blob (type-3) transaction")
 	rootCmd.PersistentFlags().DurationVar(&commitEvery, utils.TxPoolCommitEveryFlag.Name, utils.TxPoolCommitEveryFlag.Value, utils.TxPoolCommitEveryFlag.Usage)
 	rootCmd.PersistentFlags().BoolVar(&noTxGossip, utils.TxPoolGossipDisableFlag.Name, utils.TxPoolGossipDisableFlag.Value, utils.TxPoolGossipDisableFlag.Usage)
+	rootCmd.PersistentFlags().StringVar(&ordering, utils.TxPoolOrderingFlag.Name, utils.TxPoolOrderingFlag.Value, utils.TxPoolOrderingFlag.Usage)
+	rootCmd.PersistentFlags().BoolVar(&journal, utils.TxPoolJournalFlag.Name, utils.TxPoolJournalFlag.Value, utils.TxPoolJournalFlag.Usage)
+	rootCmd.PersistentFlags().DurationVar(&journalRetention, utils.TxPoolJournalRetentionFlag.Name, utils.TxPoolJournalRetentionFlag.Value, utils.TxPoolJournalRetentionFlag.Usage)
+	rootCmd.PersistentFlags().Uint64Var(&journalLimit, utils.TxPoolJournalLimitFlag.Name, utils.TxPoolJournalLimitFlag.Value, utils.TxPoolJournalLimitFlag.Usage)
 	rootCmd.Flags().StringSliceVar(&traceSenders, utils.TxPoolTraceSendersFlag.Name, []string{}, utils.TxPoolTraceSendersFlag.Usage)
 }
 
@@ -154,6 +163,12 @@ func doTxpool(ctx context.Context, logger log.Logger) error {
 	cfg.PriceBump = priceBump
 	cfg.BlobPriceBump = blobPriceBump
 	cfg.NoGossip = noTxGossip
+	if cfg.Ordering, err = txpoolcfg.ParseOrdering(ordering); err != nil {
+		return err
+	}
+	cfg.Journal = journal
+	cfg.JournalRetention = journalRetention
+	cfg.JournalLimit = journalLimit
 
 	cacheConfig := kvcache.DefaultCoherentConfig
 	cacheConfig.MetricsLabel = "txpool"
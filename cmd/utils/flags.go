@@ -42,6 +42,7 @@ import (
 	libkzg "github.com/ledgerwatch/erigon-lib/crypto/kzg"
 	"github.com/ledgerwatch/erigon-lib/direct"
 	downloadercfg2 "github.com/ledgerwatch/erigon-lib/downloader/downloadercfg"
+	"github.com/ledgerwatch/erigon-lib/downloader/snaptype"
 	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
 
 	"github.com/ledgerwatch/erigon/cl/clparams"
@@ -225,6 +226,26 @@ var (
 		Usage: "How often transactions should be committed to the storage",
 		Value: txpoolcfg.DefaultConfig.CommitEvery,
 	}
+	TxPoolOrderingFlag = cli.StringFlag{
+		Name:  "txpool.ordering",
+		Usage: "Order in which pending transactions are included into blocks: 'locals' (local senders first, then by tip), 'tip' (by tip only), 'fifo' (by arrival, for private chains)",
+		Value: txpoolcfg.DefaultConfig.Ordering.String(),
+	}
+	TxPoolJournalFlag = cli.BoolFlag{
+		Name:  "txpool.journal",
+		Usage: "Keep the journal of the pool events of every transaction (added, promoted, demoted, replaced, discarded, mined), see txpool_getTransactionStatusHistory",
+		Value: txpoolcfg.DefaultConfig.Journal,
+	}
+	TxPoolJournalRetentionFlag = cli.DurationFlag{
+		Name:  "txpool.journal.retention",
+		Usage: "How long the pool events are kept in the journal",
+		Value: txpoolcfg.DefaultConfig.JournalRetention,
+	}
+	TxPoolJournalLimitFlag = cli.Uint64Flag{
+		Name:  "txpool.journal.limit",
+		Usage: "Maximum number of pool events kept in the journal, the oldest are pruned first",
+		Value: txpoolcfg.DefaultConfig.JournalLimit,
+	}
 	// Miner settings
 	MiningEnabledFlag = cli.BoolFlag{
 		Name:  "mine",
@@ -414,6 +435,10 @@ var (
 		Name:  "rpc.accessList",
 		Usage: "Specify granular (method-by-method) API allowlist",
 	}
+	RpcRateLimitFlag = cli.StringFlag{
+		Name:  "rpc.ratelimit",
+		Usage: "Path to a JSON file with per-client rate limits and per-method call costs",
+	}
 
 	RpcGasCapFlag = cli.UintFlag{
 		Name:  "rpc.gascap",
@@ -436,6 +461,18 @@ var (
 		Value: 200,
 	}
 
+	TraceWorkersFlag = cli.IntFlag{
+		Name:  "trace.workers",
+		Usage: "Number of workers tracing transactions of a block concurrently in debug_traceBlockByNumber/Hash and trace_replayBlockTransactions. Every transaction is traced on its own pre-state read from history. 0 or 1 - trace sequentially",
+		Value: 0,
+	}
+
+	TraceFilterMaxBlocksFlag = cli.Uint64Flag{
+		Name:  "trace.filter.maxblocks",
+		Usage: "Sets a limit on blocks replayed for one page of trace_filter called with a cursor, the rest is left for the next page. 0 - unlimited",
+		Value: 1000,
+	}
+
 	HTTPPathPrefixFlag = cli.StringFlag{
 		Name:  "http.rpcprefix",
 		Usage: "HTTP path prefix on which JSON-RPC is served. Use '/' to serve on all paths.",
@@ -687,6 +724,14 @@ var (
 		Name:  ethconfig.FlagSnapStop,
 		Usage: "Workaround to stop producing new snapshots, if you meet some snapshots-related critical bug. It will stop move historical data from DB to new immutable snapshots. DB will grow and may slightly slow-down - and removing this flag in future will not fix this effect (db size will not greatly reduce).",
 	}
+	SnapPartialBlocksFlag = cli.StringFlag{
+		Name:  ethconfig.FlagSnapPartialBlocks,
+		Usage: "Partial-history node: download only snapshots of given block range `from-to` (or `from-` - up to chain tip). Headers, bodies and latest state are always downloaded. Queries outside of range return error",
+	}
+	SnapPartialTypesFlag = cli.StringFlag{
+		Name:  ethconfig.FlagSnapPartialTypes,
+		Usage: "Comma separated list of snapshot types to download for --" + ethconfig.FlagSnapPartialBlocks + " range: block types (transactions, ...) and state history (accounts,storage,code,logaddrs,logtopics,tracesfrom,tracesto). Empty - all types",
+	}
 	TorrentVerbosityFlag = cli.IntFlag{
 		Name:  "torrent.verbosity",
 		Value: 2,
@@ -764,7 +809,7 @@ var (
 
 	WebSeedsFlag = cli.StringFlag{
 		Name:  "webseed",
-		Usage: "Comma-separated URL's, holding metadata about network-support infrastructure (like S3 buckets with snapshots, bootnodes, etc...)",
+		Usage: "Comma-separated URL's, holding metadata about network-support infrastructure (like S3 buckets with snapshots, bootnodes, etc...). Private buckets: s3://bucket/prefix?endpoint=host&region=name, credentials from AWS_ACCESS_KEY_ID/AWS_SECRET_ACCESS_KEY",
 		Value: "",
 	}
 
@@ -850,6 +895,10 @@ var (
 		Usage: "Max allowed page size for search methods",
 		Value: 25,
 	}
+	ExtraIndicesFlag = cli.StringSliceFlag{
+		Name:  "experimental.extra-indices",
+		Usage: "Comma separated list of extra inverted indices to build during execution: token-transfers, contract-creators. RPCDaemon must be started with the same list",
+	}
 
 	DiagnosticsURLFlag = cli.StringFlag{
 		Name:  "diagnostics.addr",
@@ -1489,6 +1538,22 @@ func setTxPool(ctx *cli.Context, fullCfg *ethconfig.Config) {
 	if ctx.IsSet(TxPoolBlobPriceBumpFlag.Name) {
 		fullCfg.TxPool.BlobPriceBump = ctx.Uint64(TxPoolBlobPriceBumpFlag.Name)
 	}
+	if ctx.IsSet(TxPoolOrderingFlag.Name) {
+		ordering, err := txpoolcfg.ParseOrdering(ctx.String(TxPoolOrderingFlag.Name))
+		if err != nil {
+			Fatalf("Invalid --%s: %v", TxPoolOrderingFlag.Name, err)
+		}
+		fullCfg.TxPool.Ordering = ordering
+	}
+	if ctx.IsSet(TxPoolJournalFlag.Name) {
+		fullCfg.TxPool.Journal = ctx.Bool(TxPoolJournalFlag.Name)
+	}
+	if ctx.IsSet(TxPoolJournalRetentionFlag.Name) {
+		fullCfg.TxPool.JournalRetention = ctx.Duration(TxPoolJournalRetentionFlag.Name)
+	}
+	if ctx.IsSet(TxPoolJournalLimitFlag.Name) {
+		fullCfg.TxPool.JournalLimit = ctx.Uint64(TxPoolJournalLimitFlag.Name)
+	}
 	cfg.CommitEvery = common2.RandomizeDuration(ctx.Duration(TxPoolCommitEveryFlag.Name))
 }
 
@@ -1740,6 +1805,11 @@ func SetEthConfig(ctx *cli.Context, nodeConfig *nodecfg.Config, cfg *ethconfig.C
 	cfg.Snapshot.NoDownloader = ctx.Bool(NoDownloaderFlag.Name)
 	cfg.Snapshot.Verify = ctx.Bool(DownloaderVerifyFlag.Name)
 	cfg.Snapshot.DownloaderAddr = strings.TrimSpace(ctx.String(DownloaderAddrFlag.Name))
+	selection, err := snaptype.ParseSelection(ctx.String(SnapPartialBlocksFlag.Name), ctx.String(SnapPartialTypesFlag.Name))
+	if err != nil {
+		Fatalf("Option %s: %v", SnapPartialBlocksFlag.Name, err)
+	}
+	cfg.Snapshot.Selection = selection
 	if cfg.Snapshot.DownloaderAddr == "" {
 		downloadRateStr := ctx.String(TorrentDownloadRateFlag.Name)
 		uploadRateStr := ctx.String(TorrentUploadRateFlag.Name)
@@ -1861,6 +1931,8 @@ func SetEthConfig(ctx *cli.Context, nodeConfig *nodecfg.Config, cfg *ethconfig.C
 	if ctx.IsSet(TxPoolGossipDisableFlag.Name) {
 		cfg.DisableTxPoolGossip = ctx.Bool(TxPoolGossipDisableFlag.Name)
 	}
+
+	cfg.ExtraIndices = ctx.StringSlice(ExtraIndicesFlag.Name)
 }
 
 // SetDNSDiscoveryDefaults configures DNS discovery with the given URL if
@@ -138,10 +138,31 @@ func (s *Merge) Finalize(config *chain.Config, header *types.Header, state *stat
 		return s.eth1Engine.Finalize(config, header, state, txs, uncles, r, withdrawals, requests, chain, syscall, logger)
 	}
 
-	rewards, err := s.CalculateRewards(config, header, uncles, syscall)
+	elRequests, err := s.finalize(config, header, state, uncles, withdrawals, syscall)
 	if err != nil {
 		return nil, nil, err
 	}
+
+	if config.IsPrague(header.Time) {
+		// Deposits are checked against the receipt logs by the caller, the rest is known only here
+		rs := append(types.Requests(requests).Deposits().ToRequests(), elRequests...)
+		if rh := types.DeriveSha(rs); header.RequestsRoot == nil || *header.RequestsRoot != rh {
+			return nil, nil, fmt.Errorf("invalid requests root hash in header, expected: %v, got: %v", header.RequestsRoot, rh)
+		}
+	}
+
+	return txs, r, nil
+}
+
+// finalize applies the PoS block rewards and withdrawals and, from Prague on, dequeues the
+// EIP-7002 withdrawal and EIP-7251 consolidation requests from their system contracts.
+func (s *Merge) finalize(config *chain.Config, header *types.Header, state *state.IntraBlockState,
+	uncles []*types.Header, withdrawals []*types.Withdrawal, syscall consensus.SystemCall,
+) (types.Requests, error) {
+	rewards, err := s.CalculateRewards(config, header, uncles, syscall)
+	if err != nil {
+		return nil, err
+	}
 	for _, r := range rewards {
 		state.AddBalance(r.Beneficiary, &r.Amount)
 	}
@@ -149,7 +170,7 @@ func (s *Merge) Finalize(config *chain.Config, header *types.Header, state *stat
 	if withdrawals != nil {
 		if auraEngine, ok := s.eth1Engine.(*aura.AuRa); ok {
 			if err := auraEngine.ExecuteSystemWithdrawals(withdrawals, syscall); err != nil {
-				return nil, nil, err
+				return nil, err
 			}
 		} else {
 			for _, w := range withdrawals {
@@ -159,7 +180,18 @@ func (s *Merge) Finalize(config *chain.Config, header *types.Header, state *stat
 		}
 	}
 
-	return txs, r, nil
+	if !config.IsPrague(header.Time) {
+		return nil, nil
+	}
+	withdrawalRequests, err := misc.DequeueWithdrawalRequests7002(syscall)
+	if err != nil {
+		return nil, err
+	}
+	consolidationRequests, err := misc.DequeueConsolidationRequests7251(syscall)
+	if err != nil {
+		return nil, err
+	}
+	return append(withdrawalRequests, consolidationRequests...), nil
 }
 
 func (s *Merge) FinalizeAndAssemble(config *chain.Config, header *types.Header, state *state.IntraBlockState,
@@ -168,11 +200,25 @@ func (s *Merge) FinalizeAndAssemble(config *chain.Config, header *types.Header,
 	if !misc.IsPoSHeader(header) {
 		return s.eth1Engine.FinalizeAndAssemble(config, header, state, txs, uncles, receipts, withdrawals, requests, chain, syscall, call, logger)
 	}
-	outTxs, outReceipts, err := s.Finalize(config, header, state, txs, uncles, receipts, withdrawals, requests, chain, syscall, logger)
+	elRequests, err := s.finalize(config, header, state, uncles, withdrawals, syscall)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	return types.NewBlock(header, outTxs, uncles, outReceipts, withdrawals, requests), outTxs, outReceipts, nil
+	if config.IsPrague(header.Time) {
+		var deposits types.Requests
+		if config.DepositContract != nil {
+			var allLogs types.Logs
+			for _, r := range receipts {
+				allLogs = append(allLogs, r.Logs...)
+			}
+			if deposits, err = types.ParseDepositLogs(allLogs, config.DepositContract); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+		requests = append(make(types.Requests, 0, len(deposits)+len(elRequests)), deposits...)
+		requests = append(requests, elRequests...)
+	}
+	return types.NewBlock(header, txs, uncles, receipts, withdrawals, requests), txs, receipts, nil
 }
 
 func (s *Merge) SealHash(header *types.Header) (hash libcommon.Hash) {
@@ -0,0 +1,41 @@
+package misc
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ledgerwatch/erigon-lib/common/length"
+
+	"github.com/ledgerwatch/erigon/consensus"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/params"
+)
+
+// withdrawalRequestLen is the size of a request returned by the EIP-7002 contract:
+// source address, validator pubkey and the big endian amount in Gwei
+const withdrawalRequestLen = length.Addr + 48 + 8
+
+// DequeueWithdrawalRequests7002 calls the withdrawal request predeploy at the end of the block,
+// which pops the requests queued in this block from its storage and returns them.
+func DequeueWithdrawalRequests7002(syscall consensus.SystemCall) (types.Requests, error) {
+	res, err := syscall(params.WithdrawalRequestAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("withdrawal requests contract call: %w", err)
+	}
+	return parseWithdrawalRequests(res)
+}
+
+func parseWithdrawalRequests(data []byte) (types.Requests, error) {
+	if len(data)%withdrawalRequestLen != 0 {
+		return nil, fmt.Errorf("withdrawal requests contract returned %d bytes, not a multiple of %d", len(data), withdrawalRequestLen)
+	}
+	reqs := make(types.Requests, 0, len(data)/withdrawalRequestLen)
+	for i := 0; i < len(data); i += withdrawalRequestLen {
+		wr := new(types.WithdrawalRequest)
+		copy(wr.SourceAddress[:], data[i:i+length.Addr])
+		copy(wr.ValidatorPubkey[:], data[i+length.Addr:i+length.Addr+48])
+		wr.Amount = binary.BigEndian.Uint64(data[i+length.Addr+48 : i+withdrawalRequestLen])
+		reqs = append(reqs, types.NewRequest(wr))
+	}
+	return reqs, nil
+}
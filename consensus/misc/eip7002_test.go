@@ -0,0 +1,61 @@
+package misc
+
+import (
+	"bytes"
+	"testing"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+)
+
+func TestParseWithdrawalRequests(t *testing.T) {
+	addr := libcommon.HexToAddress("0x00000000000000000000000000000000000000aa")
+	pubkey := bytes.Repeat([]byte{0xbb}, 48)
+	amount := []byte{0, 0, 0, 0, 0, 0, 0x01, 0x02}
+
+	var data []byte
+	for i := 0; i < 2; i++ {
+		data = append(data, addr.Bytes()...)
+		data = append(data, pubkey...)
+		data = append(data, amount...)
+	}
+	reqs, err := parseWithdrawalRequests(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withdrawals := reqs.Withdrawals()
+	if len(withdrawals) != 2 {
+		t.Fatalf("expected 2 withdrawal requests, got %d", len(withdrawals))
+	}
+	for _, w := range withdrawals {
+		if w.SourceAddress != addr || !bytes.Equal(w.ValidatorPubkey[:], pubkey) || w.Amount != 0x0102 {
+			t.Errorf("unexpected withdrawal request %+v", w)
+		}
+	}
+
+	if _, err := parseWithdrawalRequests(data[:len(data)-1]); err == nil {
+		t.Error("expected an error for a truncated request")
+	}
+}
+
+func TestParseConsolidationRequests(t *testing.T) {
+	addr := libcommon.HexToAddress("0x00000000000000000000000000000000000000aa")
+	source, target := bytes.Repeat([]byte{0xbb}, 48), bytes.Repeat([]byte{0xcc}, 48)
+
+	data := append(append(addr.Bytes(), source...), target...)
+	reqs, err := parseConsolidationRequests(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	consolidations := reqs.Consolidations()
+	if len(consolidations) != 1 {
+		t.Fatalf("expected 1 consolidation request, got %d", len(consolidations))
+	}
+	c := consolidations[0]
+	if c.SourceAddress != addr || !bytes.Equal(c.SourcePubKey[:], source) || !bytes.Equal(c.TargetPubKey[:], target) {
+		t.Errorf("unexpected consolidation request %+v", c)
+	}
+
+	if _, err := parseConsolidationRequests(data[1:]); err == nil {
+		t.Error("expected an error for a truncated request")
+	}
+}
@@ -0,0 +1,40 @@
+package misc
+
+import (
+	"fmt"
+
+	"github.com/ledgerwatch/erigon-lib/common/length"
+
+	"github.com/ledgerwatch/erigon/consensus"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/params"
+)
+
+// consolidationRequestLen is the size of a request returned by the EIP-7251 contract:
+// source address, source pubkey and target pubkey
+const consolidationRequestLen = length.Addr + 48 + 48
+
+// DequeueConsolidationRequests7251 calls the consolidation request predeploy at the end of the block,
+// which pops the requests queued in this block from its storage and returns them.
+func DequeueConsolidationRequests7251(syscall consensus.SystemCall) (types.Requests, error) {
+	res, err := syscall(params.ConsolidationRequestAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("consolidation requests contract call: %w", err)
+	}
+	return parseConsolidationRequests(res)
+}
+
+func parseConsolidationRequests(data []byte) (types.Requests, error) {
+	if len(data)%consolidationRequestLen != 0 {
+		return nil, fmt.Errorf("consolidation requests contract returned %d bytes, not a multiple of %d", len(data), consolidationRequestLen)
+	}
+	reqs := make(types.Requests, 0, len(data)/consolidationRequestLen)
+	for i := 0; i < len(data); i += consolidationRequestLen {
+		cr := new(types.ConsolidationRequest)
+		copy(cr.SourceAddress[:], data[i:i+length.Addr])
+		copy(cr.SourcePubKey[:], data[i+length.Addr:i+length.Addr+48])
+		copy(cr.TargetPubKey[:], data[i+length.Addr+48:i+consolidationRequestLen])
+		reqs = append(reqs, types.NewRequest(cr))
+	}
+	return reqs, nil
+}
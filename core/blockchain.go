@@ -136,8 +136,8 @@ func ExecuteBlockEphemerally(
 			if !vmConfig.NoReceipts {
 				receipts = append(receipts, receipt)
 			}
+			allLogs = append(allLogs, receipt.Logs...)
 		}
-		allLogs = append(allLogs, receipt.Logs...)
 	}
 
 	receiptSha := types.DeriveSha(receipts)
@@ -204,16 +204,16 @@ func ExecuteBlockEphemerally(
 		execRs.StateSyncReceipt = stateSyncReceipt
 	}
 
-	if chainConfig.IsPrague(block.Time()) {
-		requests, err := types.ParseDepositLogs(allLogs, chainConfig.DepositContract)
+	if chainConfig.IsPrague(block.Time()) && !vmConfig.NoReceipts {
+		deposits, err := types.ParseDepositLogs(allLogs, chainConfig.DepositContract)
 		if err != nil {
 			return nil, fmt.Errorf("error: could not parse requests logs: %v", err)
 		}
 
-		rh := types.DeriveSha(requests)
-		if *block.Header().RequestsRoot != rh && !vmConfig.NoReceipts {
-			// TODO(racytech): do we have to check it here?
-			return nil, fmt.Errorf("error: invalid requests root hash, expected: %v, got :%v", *block.Header().RequestsRoot, rh)
+		// the requests root itself, which also covers the withdrawal and consolidation requests, is checked by the engine's Finalize
+		rh, bh := types.DeriveSha(deposits), types.DeriveSha(block.Requests().Deposits().ToRequests())
+		if rh != bh {
+			return nil, fmt.Errorf("error: invalid deposit requests, expected: %v, got :%v", bh, rh)
 		}
 	}
 
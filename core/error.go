@@ -107,4 +107,8 @@ var (
 	// ErrSenderNoEOA is returned if the sender of a transaction is a contract.
 	// See EIP-3607: Reject transactions from senders with deployed code.
 	ErrSenderNoEOA = errors.New("sender not an eoa")
+
+	// ErrSetCodeTxCreate is returned if a set code transaction has no destination.
+	// See EIP-7702: Set EOA account code.
+	ErrSetCodeTxCreate = errors.New("set code transaction cannot create a contract")
 )
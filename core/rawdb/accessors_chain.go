@@ -140,6 +140,74 @@ func ReadBadHeaderNumber(db kv.Getter, hash common.Hash) (*uint64, error) {
 	return &number, nil
 }
 
+// badBlocksToKeep - how many of the most recently written bad blocks are kept in kv.BadBlocks
+const badBlocksToKeep = 10
+
+// WriteBadBlock stores a block rejected by validation, so it can be inspected later (debug_getBadBlocks).
+// Only badBlocksToKeep most recently written blocks are kept, a block which is already stored is not written again.
+func WriteBadBlock(tx kv.RwTx, block *types.Block) error {
+	// keys are ordered by the write sequence, so the oldest bad blocks are at the beginning
+	c, err := tx.RwCursor(kv.BadBlocks)
+	if err != nil {
+		return fmt.Errorf("WriteBadBlock: %w", err)
+	}
+	defer c.Close()
+	blockKey := dbutils.BlockBodyKey(block.NumberU64(), block.Hash())
+	for k, _, err := c.First(); k != nil; k, _, err = c.Next() {
+		if err != nil {
+			return fmt.Errorf("WriteBadBlock: %w", err)
+		}
+		if bytes.Equal(k[8:], blockKey) {
+			return nil
+		}
+	}
+
+	data, err := rlp.EncodeToBytes(block)
+	if err != nil {
+		return fmt.Errorf("WriteBadBlock: %w", err)
+	}
+	seq, err := tx.IncrementSequence(kv.BadBlocks, 1)
+	if err != nil {
+		return fmt.Errorf("WriteBadBlock: %w", err)
+	}
+	if err := tx.Put(kv.BadBlocks, append(hexutility.EncodeTs(seq), blockKey...), data); err != nil {
+		return fmt.Errorf("WriteBadBlock: %w", err)
+	}
+
+	count, err := c.Count()
+	if err != nil {
+		return fmt.Errorf("WriteBadBlock: %w", err)
+	}
+	for ; count > badBlocksToKeep; count-- {
+		if _, _, err := c.First(); err != nil {
+			return fmt.Errorf("WriteBadBlock: %w", err)
+		}
+		if err := c.DeleteCurrent(); err != nil {
+			return fmt.Errorf("WriteBadBlock: %w", err)
+		}
+	}
+	return nil
+}
+
+// ReadAllBadBlocks returns the stored bad blocks, the most recently written first
+func ReadAllBadBlocks(tx kv.Tx) ([]*types.Block, error) {
+	var blocks []*types.Block
+	if err := tx.ForEach(kv.BadBlocks, nil, func(k, v []byte) error {
+		block := new(types.Block)
+		if err := rlp.DecodeBytes(v, block); err != nil {
+			return fmt.Errorf("invalid bad block RLP, block %d: %w", binary.BigEndian.Uint64(k[8:]), err)
+		}
+		blocks = append(blocks, block)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+		blocks[i], blocks[j] = blocks[j], blocks[i]
+	}
+	return blocks, nil
+}
+
 // WriteHeaderNumber stores the hash->number mapping.
 func WriteHeaderNumber(db kv.Putter, hash common.Hash, number uint64) error {
 	if err := db.Put(kv.HeaderNumber, hash[:], hexutility.EncodeTs(number)); err != nil {
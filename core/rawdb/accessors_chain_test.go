@@ -308,6 +308,52 @@ func TestPartialBlockStorage(t *testing.T) {
 	}
 }
 
+// Tests that only the most recent bad blocks are kept and they are returned newest first.
+func TestBadBlockStorage(t *testing.T) {
+	t.Parallel()
+	m := mock.Mock(t)
+	tx, err := m.DB.BeginRw(m.Ctx)
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	blocks, err := rawdb.ReadAllBadBlocks(tx)
+	require.NoError(t, err)
+	require.Empty(t, blocks)
+
+	badBlock := func(number int64, extra string) *types.Block {
+		return types.NewBlockWithHeader(&types.Header{
+			Number:      big.NewInt(number),
+			Extra:       []byte(extra),
+			UncleHash:   types.EmptyUncleHash,
+			TxHash:      types.EmptyRootHash,
+			ReceiptHash: types.EmptyRootHash,
+		})
+	}
+	for i := 1; i <= 15; i++ {
+		require.NoError(t, rawdb.WriteBadBlock(tx, badBlock(int64(i), "bad block")))
+	}
+
+	blocks, err = rawdb.ReadAllBadBlocks(tx)
+	require.NoError(t, err)
+	require.Len(t, blocks, 10)
+	for i, block := range blocks {
+		require.Equal(t, uint64(15-i), block.NumberU64())
+	}
+
+	// a new bad block with a lower number is the most recent one and the oldest written block is pruned,
+	// a block which is already stored doesn't change the order
+	lower := badBlock(3, "another bad block")
+	require.NoError(t, rawdb.WriteBadBlock(tx, lower))
+	require.NoError(t, rawdb.WriteBadBlock(tx, badBlock(10, "bad block")))
+	blocks, err = rawdb.ReadAllBadBlocks(tx)
+	require.NoError(t, err)
+	require.Len(t, blocks, 10)
+	require.Equal(t, lower.Hash(), blocks[0].Hash())
+	for i, block := range blocks[1:] {
+		require.Equal(t, uint64(15-i), block.NumberU64())
+	}
+}
+
 // Tests block total difficulty storage and retrieval operations.
 func TestTdStorage(t *testing.T) {
 	t.Parallel()
@@ -0,0 +1,123 @@
+package state
+
+import (
+	"fmt"
+	"slices"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/kv"
+)
+
+// ExtraIndexer - feeds an optional inverted index (see kv.ExtraInvertedIdxCfg) by executed transactions.
+// Called after the built-in log and trace indices of the transaction are written, `add` puts the key into
+// the given index at the txNum of the transaction.
+type ExtraIndexer func(txTask *TxTask, add func(name kv.InvertedIdx, key []byte) error) error
+
+func applyExtraIndexers(txTask *TxTask, extraIndices []kv.ExtraInvertedIdxCfg, add func(name kv.InvertedIdx, key []byte) error) error {
+	for _, cfg := range extraIndices {
+		indexer, ok := extraIndexerOf(cfg.Name)
+		if !ok {
+			return fmt.Errorf("no indexer for extra index: %s", cfg.Name)
+		}
+		if err := indexer(txTask, add); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const (
+	TokenTransfersIdx   kv.InvertedIdx = "TokenTransfersIdx"
+	ContractCreatorsIdx kv.InvertedIdx = "ContractCreatorsIdx"
+)
+
+var (
+	TokenTransfersIdxCfg = RegisterExtraIndexer("token-transfers", kv.ExtraInvertedIdxCfg{
+		Name:         TokenTransfersIdx,
+		FilenameBase: "tokentransfers",
+		KeysTable:    "TokenTransfersKeys",
+		IndexTable:   "TokenTransfersIdx",
+	}, IndexTokenTransfers)
+	ContractCreatorsIdxCfg = RegisterExtraIndexer("contract-creators", kv.ExtraInvertedIdxCfg{
+		Name:         ContractCreatorsIdx,
+		FilenameBase: "contractcreators",
+		KeysTable:    "ContractCreatorsKeys",
+		IndexTable:   "ContractCreatorsIdx",
+	}, IndexContractCreators)
+)
+
+// keccak256("Transfer(address,address,uint256)"), same topic for ERC-20 and ERC-721
+var transferEventTopic = libcommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+// IndexTokenTransfers - indexes `from` and `to` of ERC-20 and ERC-721 Transfer events.
+// Both standards have indexed `from` and `to`: ERC-20 has 3 topics, ERC-721 has 4 (tokenId is indexed too).
+func IndexTokenTransfers(txTask *TxTask, add func(name kv.InvertedIdx, key []byte) error) error {
+	for _, lg := range txTask.Logs {
+		if len(lg.Topics) < 3 || len(lg.Topics) > 4 || lg.Topics[0] != transferEventTopic {
+			continue
+		}
+		for _, participant := range lg.Topics[1:3] {
+			if err := add(TokenTransfersIdx, participant[12:]); err != nil {
+				return fmt.Errorf("IndexTokenTransfers: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// IndexContractCreators - indexes senders of contract creation transactions.
+// Contracts created by other contracts are not indexed.
+func IndexContractCreators(txTask *TxTask, add func(name kv.InvertedIdx, key []byte) error) error {
+	if txTask.Tx == nil || txTask.Tx.GetTo() != nil || txTask.Sender == nil {
+		return nil
+	}
+	if err := add(ContractCreatorsIdx, txTask.Sender[:]); err != nil {
+		return fmt.Errorf("IndexContractCreators: %w", err)
+	}
+	return nil
+}
+
+type extraIndex struct {
+	cfg     kv.ExtraInvertedIdxCfg
+	indexer ExtraIndexer
+}
+
+var registeredExtraIndices = map[string]extraIndex{}
+
+// RegisterExtraIndexer - makes the extra index available by its name for --experimental.extra-indices: registers its
+// inverted index and tables by kv.RegisterExtraInvertedIdx and the indexer which feeds it during execution.
+// Must be called before the chain db is opened, usually from init().
+func RegisterExtraIndexer(name string, cfg kv.ExtraInvertedIdxCfg, indexer ExtraIndexer) kv.ExtraInvertedIdxCfg {
+	if _, ok := registeredExtraIndices[name]; ok {
+		panic(fmt.Sprintf("extra index %s: registered twice", name))
+	}
+	cfg = kv.RegisterExtraInvertedIdx(cfg)
+	registeredExtraIndices[name] = extraIndex{cfg: cfg, indexer: indexer}
+	return cfg
+}
+
+func extraIndexerOf(name kv.InvertedIdx) (ExtraIndexer, bool) {
+	for _, registered := range registeredExtraIndices {
+		if registered.cfg.Name == name {
+			return registered.indexer, true
+		}
+	}
+	return nil, false
+}
+
+// ExtraIndices - configs of the registered extra indices by their names (see --experimental.extra-indices),
+// for libstate.NewAggregatorWithExtraIndices. Repeated names are skipped.
+func ExtraIndices(names []string) ([]kv.ExtraInvertedIdxCfg, error) {
+	var cfgs []kv.ExtraInvertedIdxCfg
+	for _, name := range names {
+		registered, ok := registeredExtraIndices[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown extra index: %s", name)
+		}
+		if slices.Contains(cfgs, registered.cfg) {
+			continue
+		}
+		cfgs = append(cfgs, registered.cfg)
+	}
+	return cfgs, nil
+}
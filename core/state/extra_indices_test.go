@@ -0,0 +1,55 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon/core/types"
+)
+
+func TestExtraIndexers(t *testing.T) {
+	from, to, token := libcommon.HexToAddress("0x01"), libcommon.HexToAddress("0x02"), libcommon.HexToAddress("0x03")
+	var tokenID libcommon.Hash
+	tokenID[31] = 7
+
+	txTask := &TxTask{
+		Tx:     types.NewContractCreation(0, uint256.NewInt(0), 100000, uint256.NewInt(1), nil),
+		Sender: &from,
+		Logs: []*types.Log{
+			{Address: token, Topics: []libcommon.Hash{transferEventTopic, libcommon.BytesToHash(from[:]), libcommon.BytesToHash(to[:])}},          // ERC-20
+			{Address: token, Topics: []libcommon.Hash{transferEventTopic, libcommon.BytesToHash(to[:]), libcommon.BytesToHash(from[:]), tokenID}}, // ERC-721
+			{Address: token, Topics: []libcommon.Hash{transferEventTopic}},                                                                        // not indexed transfer
+			{Address: token, Topics: []libcommon.Hash{libcommon.HexToHash("0x04"), libcommon.BytesToHash(from[:]), libcommon.BytesToHash(to[:])}},
+		},
+	}
+
+	added := map[kv.InvertedIdx][]libcommon.Address{}
+	add := func(name kv.InvertedIdx, key []byte) error {
+		added[name] = append(added[name], libcommon.BytesToAddress(key))
+		return nil
+	}
+	require.NoError(t, IndexTokenTransfers(txTask, add))
+	require.NoError(t, IndexContractCreators(txTask, add))
+	require.Equal(t, []libcommon.Address{from, to, to, from}, added[TokenTransfersIdx])
+	require.Equal(t, []libcommon.Address{from}, added[ContractCreatorsIdx])
+
+	added = map[kv.InvertedIdx][]libcommon.Address{}
+	txTask.Tx = types.NewTransaction(0, to, uint256.NewInt(0), 100000, uint256.NewInt(1), nil)
+	require.NoError(t, IndexContractCreators(txTask, add))
+	require.Empty(t, added)
+
+	cfgs, err := ExtraIndices([]string{"contract-creators", "token-transfers", "contract-creators"})
+	require.NoError(t, err)
+	require.Equal(t, []kv.ExtraInvertedIdxCfg{ContractCreatorsIdxCfg, TokenTransfersIdxCfg}, cfgs)
+	_, err = ExtraIndices([]string{"unknown"})
+	require.Error(t, err)
+
+	// registered indices are accepted by the Aggregator and their tables are tables of the chain db
+	require.Contains(t, kv.ExtraInvertedIdxs(), TokenTransfersIdxCfg)
+	require.Equal(t, kv.TableCfgItem{Flags: kv.DupSort}, kv.ChaindataTablesCfg[TokenTransfersIdxCfg.IndexTable])
+	require.Panics(t, func() { RegisterExtraIndexer("token-transfers", TokenTransfersIdxCfg, IndexTokenTransfers) })
+}
@@ -234,6 +234,11 @@ func (sdb *IntraBlockState) TxIndex() int {
 	return sdb.txIndex
 }
 
+// BlockHash returns the current block hash set by SetTxContext.
+func (sdb *IntraBlockState) BlockHash() libcommon.Hash {
+	return sdb.bhash
+}
+
 // DESCRIBED: docs/programmers_guide/guide.md#address---identifier-of-an-account
 func (sdb *IntraBlockState) GetCode(addr libcommon.Address) []byte {
 	stateObject := sdb.getStateObject(addr)
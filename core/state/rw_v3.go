@@ -16,9 +16,11 @@ import (
 	"github.com/ledgerwatch/erigon-lib/etl"
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/order"
+	"github.com/ledgerwatch/erigon-lib/kv/rawdbv3"
 	"github.com/ledgerwatch/erigon-lib/metrics"
 	libstate "github.com/ledgerwatch/erigon-lib/state"
 	"github.com/ledgerwatch/erigon/core/types/accounts"
+	"github.com/ledgerwatch/erigon/rlp"
 	"github.com/ledgerwatch/erigon/turbo/shards"
 )
 
@@ -173,6 +175,9 @@ func (rs *StateV3) ApplyState4(ctx context.Context, txTask *TxTask) error {
 	returnReadList(txTask.ReadLists)
 	returnWriteList(txTask.WriteLists)
 
+	if err := rs.applyReceipt(txTask, rs.domains); err != nil {
+		return fmt.Errorf("StateV3.ApplyReceipt: %w", err)
+	}
 	if err := rs.ApplyLogsAndTraces4(txTask, rs.domains); err != nil {
 		return fmt.Errorf("StateV3.ApplyLogsAndTraces: %w", err)
 	}
@@ -192,6 +197,37 @@ func (rs *StateV3) ApplyState4(ctx context.Context, txTask *TxTask) error {
 	return nil
 }
 
+// applyReceipt - updates the cumulative counters of the block and writes the status and logs of the transaction
+// to the receipt domain, see rawdbv3.ReceiptKey
+func (rs *StateV3) applyReceipt(txTask *TxTask, domains *libstate.SharedDomains) error {
+	if txTask.Final {
+		return nil
+	}
+	prev, prevStep, err := domains.DomainGet(kv.ReceiptDomain, rawdbv3.ReceiptKey, nil)
+	if err != nil {
+		return err
+	}
+	var receipt rawdbv3.ReceiptValue
+	if txTask.TxIndex >= 0 && len(prev) > 0 {
+		if receipt, err = rawdbv3.DecodeReceiptValue(prev); err != nil {
+			return err
+		}
+	}
+	if txTask.TxIndex >= 0 {
+		receipt.CumulativeGasUsed += txTask.UsedGas
+		receipt.CumulativeBlobGasUsed += txTask.Tx.GetBlobGas()
+		receipt.LogIndex += uint64(len(txTask.Logs))
+	}
+	receipt.Failed = txTask.Failed
+	if receipt.Logs, err = rlp.EncodeToBytes(txTask.Logs); err != nil {
+		return err
+	}
+	if prev == nil {
+		prev = []byte{} // domain must not read the previous value again
+	}
+	return domains.DomainPut(kv.ReceiptDomain, rawdbv3.ReceiptKey, nil, receipt.Encode(), prev, prevStep)
+}
+
 func (rs *StateV3) ApplyLogsAndTraces4(txTask *TxTask, domains *libstate.SharedDomains) error {
 	if dbg.DiscardHistory() {
 		return nil
@@ -217,7 +253,7 @@ func (rs *StateV3) ApplyLogsAndTraces4(txTask *TxTask, domains *libstate.SharedD
 			}
 		}
 	}
-	return nil
+	return applyExtraIndexers(txTask, domains.ExtraIndices(), domains.IndexAdd)
 }
 
 var (
@@ -18,16 +18,19 @@ package core
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/holiman/uint256"
 
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/fixedgas"
 	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
 	types2 "github.com/ledgerwatch/erigon-lib/types"
 
 	cmath "github.com/ledgerwatch/erigon/common/math"
 	"github.com/ledgerwatch/erigon/common/u256"
 	"github.com/ledgerwatch/erigon/consensus/misc"
+	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/vm"
 	"github.com/ledgerwatch/erigon/core/vm/evmtypes"
 	"github.com/ledgerwatch/erigon/crypto"
@@ -93,6 +96,7 @@ type Message interface {
 	Data() []byte
 	AccessList() types2.AccessList
 	BlobHashes() []libcommon.Hash
+	Authorizations() []types.Authorization
 
 	IsFree() bool
 }
@@ -133,7 +137,7 @@ func (result *ExecutionResult) Revert() []byte {
 }
 
 // IntrinsicGas computes the 'intrinsic gas' for a message with the given data.
-func IntrinsicGas(data []byte, accessList types2.AccessList, isContractCreation bool, isHomestead, isEIP2028, isEIP3860 bool) (uint64, error) {
+func IntrinsicGas(data []byte, accessList types2.AccessList, authorizationsLen uint64, isContractCreation bool, isHomestead, isEIP2028, isEIP3860 bool) (uint64, error) {
 	// Zero and non-zero bytes are priced differently
 	dataLen := uint64(len(data))
 	dataNonZeroLen := uint64(0)
@@ -143,7 +147,7 @@ func IntrinsicGas(data []byte, accessList types2.AccessList, isContractCreation
 		}
 	}
 
-	gas, status := txpoolcfg.CalcIntrinsicGas(dataLen, dataNonZeroLen, accessList, isContractCreation, isHomestead, isEIP2028, isEIP3860)
+	gas, status := txpoolcfg.CalcIntrinsicGas(dataLen, dataNonZeroLen, authorizationsLen, accessList, isContractCreation, isHomestead, isEIP2028, isEIP3860)
 	if status != txpoolcfg.Success {
 		return 0, ErrGasUintOverflow
 	}
@@ -298,8 +302,19 @@ func (st *StateTransition) preCheck(gasBailout bool) error {
 			// libcommon.Hash{} means that the sender is not in the state.
 			// Historically there were transactions with 0 gas price and non-existing sender,
 			// so we have to allow that.
-			return fmt.Errorf("%w: address %v, codehash: %s", ErrSenderNoEOA,
-				st.msg.From().Hex(), codeHash)
+			// Accounts which delegate their code (EIP-7702) are still EOAs.
+			if _, delegated := types.ParseDelegation(st.state.GetCode(st.msg.From())); !delegated || !st.evm.ChainRules().IsPrague {
+				return fmt.Errorf("%w: address %v, codehash: %s", ErrSenderNoEOA,
+					st.msg.From().Hex(), codeHash)
+			}
+		}
+	}
+	if len(st.msg.Authorizations()) > 0 {
+		if !st.evm.ChainRules().IsPrague {
+			return fmt.Errorf("%w: set code transaction before Prague", ErrTxTypeNotSupported)
+		}
+		if st.msg.To() == nil {
+			return fmt.Errorf("%w: address %v", ErrSetCodeTxCreate, st.msg.From().Hex())
 		}
 	}
 
@@ -383,7 +398,7 @@ func (st *StateTransition) TransitionDb(refunds bool, gasBailout bool) (*Executi
 	isEIP3860 := vmConfig.HasEip3860(rules)
 
 	// Check clauses 4-5, subtract intrinsic gas if everything is correct
-	gas, err := IntrinsicGas(st.data, st.msg.AccessList(), contractCreation, rules.IsHomestead, rules.IsIstanbul, isEIP3860)
+	gas, err := IntrinsicGas(st.data, st.msg.AccessList(), uint64(len(st.msg.Authorizations())), contractCreation, rules.IsHomestead, rules.IsIstanbul, isEIP3860)
 	if err != nil {
 		return nil, err
 	}
@@ -423,6 +438,16 @@ func (st *StateTransition) TransitionDb(refunds bool, gasBailout bool) (*Executi
 	} else {
 		// Increment the nonce for the next transaction
 		st.state.SetNonce(msg.From(), st.state.GetNonce(sender.Address())+1)
+		// Authorities are delegated after the sender nonce is bumped, so that the sender can authorize itself (EIP-7702)
+		for i := range msg.Authorizations() {
+			st.applyAuthorization(&msg.Authorizations()[i])
+		}
+		// The delegate of the destination is warm, like the destination itself
+		if rules.IsPrague {
+			if delegate, ok := types.ParseDelegation(st.state.GetCode(*msg.To())); ok {
+				st.state.AddAddressToAccessList(delegate)
+			}
+		}
 		ret, st.gasRemaining, vmerr = st.evm.Call(sender, st.to(), st.data, st.gasRemaining, st.value, bailout)
 	}
 	if refunds {
@@ -478,6 +503,42 @@ func (st *StateTransition) TransitionDb(refunds bool, gasBailout bool) (*Executi
 	}, nil
 }
 
+// applyAuthorization sets the code of the authority to the delegation designator of auth.Address (EIP-7702).
+// Invalid authorizations are skipped, they don't invalidate the transaction.
+func (st *StateTransition) applyAuthorization(auth *types.Authorization) {
+	if !auth.ChainID.IsZero() {
+		chainID, overflow := uint256.FromBig(st.evm.ChainConfig().ChainID)
+		if overflow || !auth.ChainID.Eq(chainID) {
+			return
+		}
+	}
+	if auth.Nonce == math.MaxUint64 {
+		return
+	}
+	authority, err := auth.RecoverSigner()
+	if err != nil {
+		return
+	}
+	st.state.AddAddressToAccessList(authority)
+	if code := st.state.GetCode(authority); len(code) > 0 {
+		if _, delegated := types.ParseDelegation(code); !delegated {
+			return
+		}
+	}
+	if st.state.GetNonce(authority) != auth.Nonce {
+		return
+	}
+	if st.state.Exist(authority) {
+		st.state.AddRefund(fixedgas.PerEmptyAccountCost - fixedgas.PerAuthBaseCost)
+	}
+	if auth.Address == (libcommon.Address{}) {
+		st.state.SetCode(authority, nil)
+	} else {
+		st.state.SetCode(authority, types.AddressToDelegation(auth.Address))
+	}
+	st.state.SetNonce(authority, auth.Nonce+1)
+}
+
 func (st *StateTransition) refundGas(refundQuotient uint64) {
 	// Apply refund counter, capped to half of the used gas.
 	refund := st.gasUsed() / refundQuotient
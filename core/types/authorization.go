@@ -0,0 +1,242 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/holiman/uint256"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	rlp2 "github.com/ledgerwatch/erigon-lib/rlp"
+
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/rlp"
+)
+
+// AuthorizationMagic is prepended to the RLP of [chain_id, address, nonce] to get the hash signed by an authorization
+const AuthorizationMagic = 0x05
+
+// DelegationPrefix marks the code of an account which delegates its execution to another address (EIP-7702).
+// The delegation designator is DelegationPrefix followed by the 20 bytes of the delegate address.
+var DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// DelegationDesignatorLen is the length of the code of a delegated account
+const DelegationDesignatorLen = 23
+
+// Authorization is an entry of the authorization list of a set code transaction (EIP-7702).
+// Its signer, called the authority, delegates the code of its account to Address.
+type Authorization struct {
+	ChainID uint256.Int
+	Address libcommon.Address
+	Nonce   uint64
+	YParity uint8
+	R       uint256.Int
+	S       uint256.Int
+}
+
+type authorizationJSON struct {
+	ChainID *hexutil.Big      `json:"chainId"`
+	Address libcommon.Address `json:"address"`
+	Nonce   hexutil.Uint64    `json:"nonce"`
+	YParity hexutil.Uint64    `json:"yParity"`
+	R       *hexutil.Big      `json:"r"`
+	S       *hexutil.Big      `json:"s"`
+}
+
+func (ath Authorization) MarshalJSON() ([]byte, error) {
+	return json.Marshal(authorizationJSON{
+		ChainID: (*hexutil.Big)(ath.ChainID.ToBig()),
+		Address: ath.Address,
+		Nonce:   hexutil.Uint64(ath.Nonce),
+		YParity: hexutil.Uint64(ath.YParity),
+		R:       (*hexutil.Big)(ath.R.ToBig()),
+		S:       (*hexutil.Big)(ath.S.ToBig()),
+	})
+}
+
+func (ath *Authorization) UnmarshalJSON(input []byte) error {
+	var dec authorizationJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	if dec.ChainID == nil || dec.R == nil || dec.S == nil {
+		return errors.New("missing required field in authorization")
+	}
+	if ath.ChainID.SetFromBig(dec.ChainID.ToInt()) {
+		return errors.New("'chainId' in authorization does not fit in 256 bits")
+	}
+	if dec.YParity > 255 {
+		return fmt.Errorf("authorization y parity is too large: %d", dec.YParity)
+	}
+	if ath.R.SetFromBig(dec.R.ToInt()) || ath.S.SetFromBig(dec.S.ToInt()) {
+		return errors.New("authorization signature does not fit in 256 bits")
+	}
+	ath.Address = dec.Address
+	ath.Nonce = uint64(dec.Nonce)
+	ath.YParity = uint8(dec.YParity)
+	return nil
+}
+
+// AddressToDelegation returns the delegation designator which makes an account execute the code of addr
+func AddressToDelegation(addr libcommon.Address) []byte {
+	return append(libcommon.CopyBytes(DelegationPrefix), addr.Bytes()...)
+}
+
+// ParseDelegation returns the delegate address if code is a delegation designator
+func ParseDelegation(code []byte) (libcommon.Address, bool) {
+	if len(code) != DelegationDesignatorLen || !bytes.HasPrefix(code, DelegationPrefix) {
+		return libcommon.Address{}, false
+	}
+	return libcommon.BytesToAddress(code[len(DelegationPrefix):]), true
+}
+
+// SigningHash returns keccak256(MAGIC || rlp([chain_id, address, nonce])), the hash signed by the authority
+func (ath *Authorization) SigningHash() libcommon.Hash {
+	return prefixedRlpHash(AuthorizationMagic, []interface{}{&ath.ChainID, ath.Address, ath.Nonce})
+}
+
+// RecoverSigner returns the authority of the authorization
+func (ath *Authorization) RecoverSigner() (libcommon.Address, error) {
+	if !crypto.ValidateSignatureValues(ath.YParity, &ath.R, &ath.S, true) {
+		return libcommon.Address{}, ErrInvalidSig
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	ath.R.WriteToSlice(sig[:32])
+	ath.S.WriteToSlice(sig[32:64])
+	sig[64] = ath.YParity
+	hash := ath.SigningHash()
+	pub, err := crypto.Ecrecover(hash[:], sig)
+	if err != nil {
+		return libcommon.Address{}, err
+	}
+	if len(pub) == 0 || pub[0] != 4 {
+		return libcommon.Address{}, errors.New("invalid public key")
+	}
+	var addr libcommon.Address
+	copy(addr[:], crypto.Keccak256(pub[1:])[12:])
+	return addr, nil
+}
+
+// SignAuthorization returns a copy of auth signed with prv
+func SignAuthorization(auth Authorization, prv *ecdsa.PrivateKey) (Authorization, error) {
+	hash := auth.SigningHash()
+	sig, err := crypto.Sign(hash[:], prv)
+	if err != nil {
+		return Authorization{}, err
+	}
+	auth.R.SetBytes(sig[:32])
+	auth.S.SetBytes(sig[32:64])
+	auth.YParity = sig[64]
+	return auth, nil
+}
+
+func authorizationSize(auth *Authorization) int {
+	size := 1 + rlp.Uint256LenExcludingHead(&auth.ChainID)
+	size += 21 // address
+	size += 1 + rlp.IntLenExcludingHead(auth.Nonce)
+	size += 1 + rlp.IntLenExcludingHead(uint64(auth.YParity))
+	size += 1 + rlp.Uint256LenExcludingHead(&auth.R)
+	size += 1 + rlp.Uint256LenExcludingHead(&auth.S)
+	return size
+}
+
+func authorizationsSize(auths []Authorization) int {
+	var size int
+	for i := range auths {
+		authLen := authorizationSize(&auths[i])
+		size += rlp2.ListPrefixLen(authLen) + authLen
+	}
+	return size
+}
+
+func encodeAuthorizations(auths []Authorization, w io.Writer, b []byte) error {
+	for i := range auths {
+		auth := &auths[i]
+		if err := EncodeStructSizePrefix(authorizationSize(auth), w, b); err != nil {
+			return err
+		}
+		if err := auth.ChainID.EncodeRLP(w); err != nil {
+			return err
+		}
+		b[0] = 128 + 20
+		if _, err := w.Write(b[:1]); err != nil {
+			return err
+		}
+		if _, err := w.Write(auth.Address.Bytes()); err != nil {
+			return err
+		}
+		if err := rlp.EncodeInt(auth.Nonce, w, b); err != nil {
+			return err
+		}
+		if err := rlp.EncodeInt(uint64(auth.YParity), w, b); err != nil {
+			return err
+		}
+		if err := auth.R.EncodeRLP(w); err != nil {
+			return err
+		}
+		if err := auth.S.EncodeRLP(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeAuthorizations(auths *[]Authorization, s *rlp.Stream) error {
+	_, err := s.List()
+	if err != nil {
+		return fmt.Errorf("open authorizations: %w", err)
+	}
+	var b []byte
+	i := 0
+	for _, err = s.List(); err == nil; _, err = s.List() {
+		auth := Authorization{}
+		if b, err = s.Uint256Bytes(); err != nil {
+			return fmt.Errorf("read ChainID: %w", err)
+		}
+		auth.ChainID.SetBytes(b)
+		if b, err = s.Bytes(); err != nil {
+			return fmt.Errorf("read Address: %w", err)
+		}
+		if len(b) != 20 {
+			return fmt.Errorf("wrong size for Authorization address: %d", len(b))
+		}
+		copy(auth.Address[:], b)
+		if auth.Nonce, err = s.Uint(); err != nil {
+			return fmt.Errorf("read Nonce: %w", err)
+		}
+		var yParity uint64
+		if yParity, err = s.Uint(); err != nil {
+			return fmt.Errorf("read YParity: %w", err)
+		}
+		if yParity > 255 {
+			return fmt.Errorf("authorization y parity is too large: %d", yParity)
+		}
+		auth.YParity = uint8(yParity)
+		if b, err = s.Uint256Bytes(); err != nil {
+			return fmt.Errorf("read R: %w", err)
+		}
+		auth.R.SetBytes(b)
+		if b, err = s.Uint256Bytes(); err != nil {
+			return fmt.Errorf("read S: %w", err)
+		}
+		auth.S.SetBytes(b)
+		// end of authorization
+		if err = s.ListEnd(); err != nil {
+			return fmt.Errorf("close Authorization: %w", err)
+		}
+		*auths = append(*auths, auth)
+		i++
+	}
+	if !errors.Is(err, rlp.EOL) {
+		return fmt.Errorf("open authorization: %d %w", i, err)
+	}
+	if err = s.ListEnd(); err != nil {
+		return fmt.Errorf("close authorizations: %w", err)
+	}
+	return nil
+}
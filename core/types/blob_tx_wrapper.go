@@ -299,6 +299,8 @@ func (txw *BlobTxWrapper) GetFeeCap() *uint256.Int { return txw.Tx.GetFeeCap() }
 
 func (txw *BlobTxWrapper) GetBlobHashes() []libcommon.Hash { return txw.Tx.GetBlobHashes() }
 
+func (txw *BlobTxWrapper) GetAuthorizations() []Authorization { return nil }
+
 func (txw *BlobTxWrapper) GetGas() uint64            { return txw.Tx.GetGas() }
 func (txw *BlobTxWrapper) GetBlobGas() uint64        { return txw.Tx.GetBlobGas() }
 func (txw *BlobTxWrapper) GetValue() *uint256.Int    { return txw.Tx.GetValue() }
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon/rlp"
+)
+
+// ConsolidationRequest is an EIP-7251 request to move the balance of the source
+// validator onto the target one, dequeued from the consolidation request predeploy.
+type ConsolidationRequest struct {
+	SourceAddress libcommon.Address `json:"sourceAddress"`
+	SourcePubKey  [pLen]byte        `json:"sourcePubkey"`
+	TargetPubKey  [pLen]byte        `json:"targetPubkey"`
+}
+
+func (c *ConsolidationRequest) requestType() byte               { return ConsolidationRequestType }
+func (c *ConsolidationRequest) encodeRLP(b *bytes.Buffer) error { return rlp.Encode(b, c) }
+func (c *ConsolidationRequest) decodeRLP(data []byte) error     { return rlp.DecodeBytes(data, c) }
+func (c *ConsolidationRequest) copy() RequestData {
+	return &ConsolidationRequest{
+		SourceAddress: c.SourceAddress,
+		SourcePubKey:  c.SourcePubKey,
+		TargetPubKey:  c.TargetPubKey,
+	}
+}
+
+func (c *ConsolidationRequest) encodingSize() (encodingSize int) {
+	return 119 // 1 + 20 + 1 + 48 + 1 + 48 (0x80 + addrLen, 0x80 + pLen, 0x80 + pLen)
+}
+
+type consolidationRequestJSON struct {
+	SourceAddress libcommon.Address `json:"sourceAddress"`
+	SourcePubKey  hexutility.Bytes  `json:"sourcePubkey"`
+	TargetPubKey  hexutility.Bytes  `json:"targetPubkey"`
+}
+
+func (c ConsolidationRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(consolidationRequestJSON{
+		SourceAddress: c.SourceAddress,
+		SourcePubKey:  c.SourcePubKey[:],
+		TargetPubKey:  c.TargetPubKey[:],
+	})
+}
+
+func (c *ConsolidationRequest) UnmarshalJSON(input []byte) error {
+	var dec consolidationRequestJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	if len(dec.SourcePubKey) != pLen || len(dec.TargetPubKey) != pLen {
+		return fmt.Errorf("consolidation request pubkeys must be %d bytes", pLen)
+	}
+	c.SourceAddress = dec.SourceAddress
+	copy(c.SourcePubKey[:], dec.SourcePubKey)
+	copy(c.TargetPubKey[:], dec.TargetPubKey)
+	return nil
+}
+
+type ConsolidationRequests []*ConsolidationRequest
+
+func (cs ConsolidationRequests) ToRequests() (reqs Requests) {
+	for _, c := range cs {
+		reqs = append(reqs, NewRequest(c))
+	}
+	return
+}
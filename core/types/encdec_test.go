@@ -11,6 +11,7 @@ import (
 
 	"github.com/holiman/uint256"
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	rlp2 "github.com/ledgerwatch/erigon-lib/rlp"
 	types2 "github.com/ledgerwatch/erigon-lib/types"
 	"github.com/ledgerwatch/erigon/rlp"
 )
@@ -79,10 +80,32 @@ func (tr *TRand) RandDeposit() *Deposit {
 	}
 }
 
+func (tr *TRand) RandWithdrawalRequest() *WithdrawalRequest {
+	return &WithdrawalRequest{
+		SourceAddress:   tr.RandAddress(),
+		ValidatorPubkey: [48]byte(tr.RandBytes(48)),
+		Amount:          *tr.RandUint64(),
+	}
+}
+
+func (tr *TRand) RandConsolidationRequest() *ConsolidationRequest {
+	return &ConsolidationRequest{
+		SourceAddress: tr.RandAddress(),
+		SourcePubKey:  [48]byte(tr.RandBytes(48)),
+		TargetPubKey:  [48]byte(tr.RandBytes(48)),
+	}
+}
+
 func (tr *TRand) RandRequest() *Request {
-	d := tr.RandDeposit()
 	var r Request
-	r.inner = d.copy()
+	switch tr.rnd.Intn(3) {
+	case 0:
+		r.inner = tr.RandDeposit()
+	case 1:
+		r.inner = tr.RandWithdrawalRequest()
+	default:
+		r.inner = tr.RandConsolidationRequest()
+	}
 	return &r
 }
 
@@ -357,6 +380,18 @@ func checkRequests(t *testing.T, a, b *Request) {
 		c := a.inner.(*Deposit)
 		d := b.inner.(*Deposit)
 		compareDeposits(t, c, d)
+	case WithdrawalRequestType:
+		c := a.inner.(*WithdrawalRequest)
+		d := b.inner.(*WithdrawalRequest)
+		check(t, "WithdrawalRequest.SourceAddress", c.SourceAddress, d.SourceAddress)
+		check(t, "WithdrawalRequest.ValidatorPubkey", c.ValidatorPubkey, d.ValidatorPubkey)
+		check(t, "WithdrawalRequest.Amount", c.Amount, d.Amount)
+	case ConsolidationRequestType:
+		c := a.inner.(*ConsolidationRequest)
+		d := b.inner.(*ConsolidationRequest)
+		check(t, "ConsolidationRequest.SourceAddress", c.SourceAddress, d.SourceAddress)
+		check(t, "ConsolidationRequest.SourcePubKey", c.SourcePubKey, d.SourcePubKey)
+		check(t, "ConsolidationRequest.TargetPubKey", c.TargetPubKey, d.TargetPubKey)
 	default:
 		t.Errorf("unknown request type: %v", a.Type())
 	}
@@ -487,7 +522,7 @@ func TestDepositEncodeDecode(t *testing.T) {
 	tr := NewTRand()
 	var buf bytes.Buffer
 	for i := 0; i < RUNS; i++ {
-		enc := tr.RandRequest()
+		enc := &Request{inner: tr.RandDeposit()}
 		buf.Reset()
 		if err := enc.EncodeRLP(&buf); err != nil {
 			t.Errorf("error: deposit.EncodeRLP(): %v", err)
@@ -502,3 +537,24 @@ func TestDepositEncodeDecode(t *testing.T) {
 		compareDeposits(t, a, b)
 	}
 }
+
+func TestRequestsEncodeDecode(t *testing.T) {
+	tr := NewTRand()
+	var buf bytes.Buffer
+	for i := 0; i < RUNS; i++ {
+		enc := tr.RandRequest()
+		buf.Reset()
+		if err := enc.EncodeRLP(&buf); err != nil {
+			t.Errorf("error: request.EncodeRLP(): %v", err)
+		}
+		if buf.Len() != rlp2.ListPrefixLen(enc.EncodingSize())+enc.EncodingSize() {
+			t.Errorf("error: request type %d encoded into %d bytes, EncodingSize() is %d", enc.Type(), buf.Len(), enc.EncodingSize())
+		}
+		s := rlp.NewStream(bytes.NewReader(buf.Bytes()), 0)
+		dec := &Request{}
+		if err := dec.DecodeRLP(s); err != nil {
+			t.Errorf("error: request.DecodeRLP(): %v", err)
+		}
+		checkRequests(t, enc, dec)
+	}
+}
@@ -91,6 +91,11 @@ func (ct *CommonTx) GetBlobHashes() []libcommon.Hash {
 	return []libcommon.Hash{}
 }
 
+func (ct *CommonTx) GetAuthorizations() []Authorization {
+	// Only set code txs have authorizations
+	return nil
+}
+
 // LegacyTx is the transaction data of regular Ethereum transactions.
 type LegacyTx struct {
 	CommonTx
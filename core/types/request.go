@@ -10,7 +10,9 @@ import (
 )
 
 const (
-	DepositRequestType byte = 0x00
+	DepositRequestType       byte = 0x00
+	WithdrawalRequestType    byte = 0x01
+	ConsolidationRequestType byte = 0x02
 )
 
 type Request struct {
@@ -37,7 +39,7 @@ func NewRequest(inner RequestData) *Request {
 
 func (r *Request) EncodingSize() int {
 	switch r.Type() {
-	case DepositRequestType:
+	case DepositRequestType, WithdrawalRequestType, ConsolidationRequestType:
 		total := r.inner.encodingSize() + 1 // +1 byte for requset type
 		return rlp2.ListPrefixLen(total) + total
 	default:
@@ -78,6 +80,10 @@ func (r *Request) decode(data []byte) error {
 	switch data[0] {
 	case DepositRequestType:
 		inner = new(Deposit)
+	case WithdrawalRequestType:
+		inner = new(WithdrawalRequest)
+	case ConsolidationRequestType:
+		inner = new(ConsolidationRequest)
 	default:
 		return fmt.Errorf("unknown request type - %d", data[0])
 	}
@@ -99,6 +105,26 @@ func (r Requests) Deposits() Deposits {
 	return deposits
 }
 
+func (r Requests) Withdrawals() WithdrawalRequests {
+	withdrawals := make(WithdrawalRequests, 0, len(r))
+	for _, req := range r {
+		if req.Type() == WithdrawalRequestType {
+			withdrawals = append(withdrawals, req.inner.(*WithdrawalRequest))
+		}
+	}
+	return withdrawals
+}
+
+func (r Requests) Consolidations() ConsolidationRequests {
+	consolidations := make(ConsolidationRequests, 0, len(r))
+	for _, req := range r {
+		if req.Type() == ConsolidationRequestType {
+			consolidations = append(consolidations, req.inner.(*ConsolidationRequest))
+		}
+	}
+	return consolidations
+}
+
 type Requests []*Request
 
 func (r Requests) Len() int { return len(r) }
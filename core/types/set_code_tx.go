@@ -0,0 +1,347 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/holiman/uint256"
+
+	"github.com/ledgerwatch/erigon-lib/chain"
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	rlp2 "github.com/ledgerwatch/erigon-lib/rlp"
+	types2 "github.com/ledgerwatch/erigon-lib/types"
+
+	"github.com/ledgerwatch/erigon/common/u256"
+	"github.com/ledgerwatch/erigon/rlp"
+)
+
+// SetCodeTransaction is an EIP-7702 transaction, which sets the code of the authorities of its authorization list
+// to delegation designators before the transaction is executed.
+type SetCodeTransaction struct {
+	DynamicFeeTransaction
+	Authorizations []Authorization
+}
+
+// copy creates a deep copy of the transaction data and initializes all fields.
+func (tx *SetCodeTransaction) copy() *SetCodeTransaction {
+	cpy := &SetCodeTransaction{
+		DynamicFeeTransaction: *tx.DynamicFeeTransaction.copy(),
+		Authorizations:        make([]Authorization, len(tx.Authorizations)),
+	}
+	copy(cpy.Authorizations, tx.Authorizations)
+	return cpy
+}
+
+func (tx *SetCodeTransaction) Type() byte { return SetCodeTxType }
+
+func (tx *SetCodeTransaction) Unwrap() Transaction { return tx }
+
+func (tx *SetCodeTransaction) GetAuthorizations() []Authorization { return tx.Authorizations }
+
+func (tx *SetCodeTransaction) WithSignature(signer Signer, sig []byte) (Transaction, error) {
+	cpy := tx.copy()
+	r, s, v, err := signer.SignatureValues(tx, sig)
+	if err != nil {
+		return nil, err
+	}
+	cpy.R.Set(r)
+	cpy.S.Set(s)
+	cpy.V.Set(v)
+	cpy.ChainID = signer.ChainID()
+	return cpy, nil
+}
+
+func (tx *SetCodeTransaction) FakeSign(address libcommon.Address) (Transaction, error) {
+	cpy := tx.copy()
+	cpy.R.Set(u256.Num1)
+	cpy.S.Set(u256.Num1)
+	cpy.V.Set(u256.Num4)
+	cpy.from.Store(address)
+	return cpy, nil
+}
+
+func (tx *SetCodeTransaction) AsMessage(s Signer, baseFee *big.Int, rules *chain.Rules) (Message, error) {
+	msg := Message{
+		nonce:          tx.Nonce,
+		gasLimit:       tx.Gas,
+		gasPrice:       *tx.FeeCap,
+		tip:            *tx.Tip,
+		feeCap:         *tx.FeeCap,
+		to:             tx.To,
+		amount:         *tx.Value,
+		data:           tx.Data,
+		accessList:     tx.AccessList,
+		checkNonce:     true,
+		authorizations: tx.Authorizations,
+	}
+	if !rules.IsPrague {
+		return msg, errors.New("SetCodeTransaction is only supported in Prague")
+	}
+	if baseFee != nil {
+		overflow := msg.gasPrice.SetFromBig(baseFee)
+		if overflow {
+			return msg, fmt.Errorf("gasPrice higher than 2^256-1")
+		}
+	}
+	msg.gasPrice.Add(&msg.gasPrice, tx.Tip)
+	if msg.gasPrice.Gt(tx.FeeCap) {
+		msg.gasPrice.Set(tx.FeeCap)
+	}
+	var err error
+	msg.from, err = tx.Sender(s)
+	return msg, err
+}
+
+func (tx *SetCodeTransaction) cashedSender() (sender libcommon.Address, ok bool) {
+	s := tx.from.Load()
+	if s == nil {
+		return sender, false
+	}
+	return s.(libcommon.Address), true
+}
+
+func (tx *SetCodeTransaction) Sender(signer Signer) (libcommon.Address, error) {
+	if sc := tx.from.Load(); sc != nil {
+		return sc.(libcommon.Address), nil
+	}
+	addr, err := signer.Sender(tx)
+	if err != nil {
+		return libcommon.Address{}, err
+	}
+	tx.from.Store(addr)
+	return addr, nil
+}
+
+// authorizationsForHashing turns the authorization list into something the reflection-based rlp encoder understands
+func authorizationsForHashing(auths []Authorization) []interface{} {
+	res := make([]interface{}, len(auths))
+	for i := range auths {
+		auth := &auths[i]
+		res[i] = []interface{}{&auth.ChainID, auth.Address, auth.Nonce, auth.YParity, &auth.R, &auth.S}
+	}
+	return res
+}
+
+func (tx *SetCodeTransaction) Hash() libcommon.Hash {
+	if hash := tx.hash.Load(); hash != nil {
+		return *hash.(*libcommon.Hash)
+	}
+	hash := prefixedRlpHash(SetCodeTxType, []interface{}{
+		tx.ChainID,
+		tx.Nonce,
+		tx.Tip,
+		tx.FeeCap,
+		tx.Gas,
+		tx.To,
+		tx.Value,
+		tx.Data,
+		tx.AccessList,
+		authorizationsForHashing(tx.Authorizations),
+		tx.V, tx.R, tx.S,
+	})
+	tx.hash.Store(&hash)
+	return hash
+}
+
+func (tx *SetCodeTransaction) SigningHash(chainID *big.Int) libcommon.Hash {
+	return prefixedRlpHash(
+		SetCodeTxType,
+		[]interface{}{
+			chainID,
+			tx.Nonce,
+			tx.Tip,
+			tx.FeeCap,
+			tx.Gas,
+			tx.To,
+			tx.Value,
+			tx.Data,
+			tx.AccessList,
+			authorizationsForHashing(tx.Authorizations),
+		})
+}
+
+func (tx *SetCodeTransaction) EncodingSize() int {
+	payloadSize, _, _, _, _ := tx.payloadSize()
+	// Add envelope size and type size
+	return 1 + rlp2.ListPrefixLen(payloadSize) + payloadSize
+}
+
+func (tx *SetCodeTransaction) payloadSize() (payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen int) {
+	payloadSize, nonceLen, gasLen, accessListLen = tx.DynamicFeeTransaction.payloadSize()
+	// size of Authorizations
+	authorizationsLen = authorizationsSize(tx.Authorizations)
+	payloadSize += rlp2.ListPrefixLen(authorizationsLen) + authorizationsLen
+	return
+}
+
+func (tx *SetCodeTransaction) encodePayload(w io.Writer, b []byte, payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen int) error {
+	// prefix
+	if err := EncodeStructSizePrefix(payloadSize, w, b); err != nil {
+		return err
+	}
+	// encode ChainID
+	if err := tx.ChainID.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode Nonce
+	if err := rlp.EncodeInt(tx.Nonce, w, b); err != nil {
+		return err
+	}
+	// encode MaxPriorityFeePerGas
+	if err := tx.Tip.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode MaxFeePerGas
+	if err := tx.FeeCap.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode Gas
+	if err := rlp.EncodeInt(tx.Gas, w, b); err != nil {
+		return err
+	}
+	// encode To
+	b[0] = 128 + 20
+	if _, err := w.Write(b[:1]); err != nil {
+		return err
+	}
+	if _, err := w.Write(tx.To.Bytes()); err != nil {
+		return err
+	}
+	// encode Value
+	if err := tx.Value.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode Data
+	if err := rlp.EncodeString(tx.Data, w, b); err != nil {
+		return err
+	}
+	// prefix
+	if err := EncodeStructSizePrefix(accessListLen, w, b); err != nil {
+		return err
+	}
+	// encode AccessList
+	if err := encodeAccessList(tx.AccessList, w, b); err != nil {
+		return err
+	}
+	// prefix
+	if err := EncodeStructSizePrefix(authorizationsLen, w, b); err != nil {
+		return err
+	}
+	// encode Authorizations
+	if err := encodeAuthorizations(tx.Authorizations, w, b); err != nil {
+		return err
+	}
+	// encode V
+	if err := tx.V.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode R
+	if err := tx.R.EncodeRLP(w); err != nil {
+		return err
+	}
+	// encode S
+	if err := tx.S.EncodeRLP(w); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (tx *SetCodeTransaction) EncodeRLP(w io.Writer) error {
+	payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen := tx.payloadSize()
+	// size of struct prefix and TxType
+	envelopeSize := 1 + rlp2.ListPrefixLen(payloadSize) + payloadSize
+	var b [33]byte
+	// envelope
+	if err := rlp.EncodeStringSizePrefix(envelopeSize, w, b[:]); err != nil {
+		return err
+	}
+	// encode TxType
+	b[0] = SetCodeTxType
+	if _, err := w.Write(b[:1]); err != nil {
+		return err
+	}
+	return tx.encodePayload(w, b[:], payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen)
+}
+
+func (tx *SetCodeTransaction) MarshalBinary(w io.Writer) error {
+	payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen := tx.payloadSize()
+	var b [33]byte
+	// encode TxType
+	b[0] = SetCodeTxType
+	if _, err := w.Write(b[:1]); err != nil {
+		return err
+	}
+	return tx.encodePayload(w, b[:], payloadSize, nonceLen, gasLen, accessListLen, authorizationsLen)
+}
+
+func (tx *SetCodeTransaction) DecodeRLP(s *rlp.Stream) error {
+	_, err := s.List()
+	if err != nil {
+		return err
+	}
+	var b []byte
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.ChainID = new(uint256.Int).SetBytes(b)
+	if tx.Nonce, err = s.Uint(); err != nil {
+		return err
+	}
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.Tip = new(uint256.Int).SetBytes(b)
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.FeeCap = new(uint256.Int).SetBytes(b)
+	if tx.Gas, err = s.Uint(); err != nil {
+		return err
+	}
+	if b, err = s.Bytes(); err != nil {
+		return err
+	}
+	if len(b) != 20 {
+		return fmt.Errorf("wrong size for To: %d", len(b))
+	}
+	tx.To = &libcommon.Address{}
+	copy((*tx.To)[:], b)
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.Value = new(uint256.Int).SetBytes(b)
+	if tx.Data, err = s.Bytes(); err != nil {
+		return err
+	}
+	// decode AccessList
+	tx.AccessList = types2.AccessList{}
+	if err = decodeAccessList(&tx.AccessList, s); err != nil {
+		return err
+	}
+	// decode Authorizations
+	tx.Authorizations = make([]Authorization, 0)
+	if err = decodeAuthorizations(&tx.Authorizations, s); err != nil {
+		return err
+	}
+	if len(tx.Authorizations) == 0 {
+		return fmt.Errorf("a set code transaction must contain at least one authorization")
+	}
+	// decode V
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.V.SetBytes(b)
+	// decode R
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.R.SetBytes(b)
+	// decode S
+	if b, err = s.Uint256Bytes(); err != nil {
+		return err
+	}
+	tx.S.SetBytes(b)
+	return s.ListEnd()
+}
@@ -51,6 +51,7 @@ const (
 	AccessListTxType
 	DynamicFeeTxType
 	BlobTxType
+	SetCodeTxType
 )
 
 // Transaction is an Ethereum transaction.
@@ -74,6 +75,7 @@ type Transaction interface {
 	SigningHash(chainID *big.Int) libcommon.Hash
 	GetData() []byte
 	GetAccessList() types2.AccessList
+	GetAuthorizations() []Authorization
 	Protected() bool
 	RawSignatureValues() (*uint256.Int, *uint256.Int, *uint256.Int)
 	EncodingSize() int
@@ -193,6 +195,8 @@ func UnmarshalTransactionFromBinary(data []byte, blobTxnsAreWrappedWithBlobs boo
 		} else {
 			t = &BlobTx{}
 		}
+	case SetCodeTxType:
+		t = &SetCodeTransaction{}
 	default:
 		if data[0] >= 0x80 {
 			// Tx is type legacy which is RLP encoded
@@ -407,6 +411,7 @@ type Message struct {
 	checkNonce       bool
 	isFree           bool
 	blobHashes       []libcommon.Hash
+	authorizations   []Authorization
 }
 
 func NewMessage(from libcommon.Address, to *libcommon.Address, nonce uint64, amount *uint256.Int, gasLimit uint64,
@@ -482,6 +487,8 @@ func (m Message) MaxFeePerBlobGas() *uint256.Int {
 
 func (m Message) BlobHashes() []libcommon.Hash { return m.blobHashes }
 
+func (m Message) Authorizations() []Authorization { return m.authorizations }
+
 func DecodeSSZ(data []byte, dest codec.Deserializable) error {
 	err := dest.Deserialize(codec.NewDecodingReader(bytes.NewReader(data), uint64(len(data))))
 	return err
@@ -44,6 +44,9 @@ type txJSON struct {
 	Commitments BlobKzgs  `json:"commitments,omitempty"`
 	Proofs      KZGProofs `json:"proofs,omitempty"`
 
+	// Set code transaction fields:
+	AuthorizationList []Authorization `json:"authorizationList,omitempty"`
+
 	// Only used for encoding:
 	Hash libcommon.Hash `json:"hash"`
 }
@@ -143,6 +146,27 @@ func (tx *BlobTxWrapper) MarshalJSON() ([]byte, error) {
 	return json.Marshal(enc)
 }
 
+func (tx *SetCodeTransaction) MarshalJSON() ([]byte, error) {
+	var enc txJSON
+	// These are set for all tx types.
+	enc.Hash = tx.Hash()
+	enc.Type = hexutil.Uint64(tx.Type())
+	enc.ChainID = (*hexutil.Big)(tx.ChainID.ToBig())
+	enc.AccessList = &tx.AccessList
+	enc.Nonce = (*hexutil.Uint64)(&tx.Nonce)
+	enc.Gas = (*hexutil.Uint64)(&tx.Gas)
+	enc.FeeCap = (*hexutil.Big)(tx.FeeCap.ToBig())
+	enc.Tip = (*hexutil.Big)(tx.Tip.ToBig())
+	enc.Value = (*hexutil.Big)(tx.Value.ToBig())
+	enc.Data = (*hexutility.Bytes)(&tx.Data)
+	enc.To = tx.To
+	enc.V = (*hexutil.Big)(tx.V.ToBig())
+	enc.R = (*hexutil.Big)(tx.R.ToBig())
+	enc.S = (*hexutil.Big)(tx.S.ToBig())
+	enc.AuthorizationList = tx.Authorizations
+	return json.Marshal(&enc)
+}
+
 func UnmarshalTransactionFromJSON(input []byte) (Transaction, error) {
 	var p fastjson.Parser
 	v, err := p.ParseBytes(input)
@@ -182,6 +206,12 @@ func UnmarshalTransactionFromJSON(input []byte) (Transaction, error) {
 			return nil, err
 		}
 		return tx, nil
+	case SetCodeTxType:
+		tx := &SetCodeTransaction{}
+		if err = tx.UnmarshalJSON(input); err != nil {
+			return nil, err
+		}
+		return tx, nil
 	default:
 		return nil, fmt.Errorf("unknown transaction type: %v", txType)
 	}
@@ -414,6 +444,95 @@ func (tx *DynamicFeeTransaction) UnmarshalJSON(input []byte) error {
 	return nil
 }
 
+func (tx *SetCodeTransaction) UnmarshalJSON(input []byte) error {
+	var dec txJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	if dec.To == nil {
+		return errors.New("missing required field 'to' in transaction")
+	}
+	tx.To = dec.To
+	if len(dec.AuthorizationList) == 0 {
+		return errors.New("missing required field 'authorizationList' in transaction")
+	}
+	tx.Authorizations = dec.AuthorizationList
+	if dec.AccessList != nil {
+		tx.AccessList = *dec.AccessList
+	} else {
+		tx.AccessList = types2.AccessList{}
+	}
+	if dec.ChainID == nil {
+		return errors.New("missing required field 'chainId' in transaction")
+	}
+	var overflow bool
+	tx.ChainID, overflow = uint256.FromBig(dec.ChainID.ToInt())
+	if overflow {
+		return errors.New("'chainId' in transaction does not fit in 256 bits")
+	}
+	if dec.Nonce == nil {
+		return errors.New("missing required field 'nonce' in transaction")
+	}
+	tx.Nonce = uint64(*dec.Nonce)
+	if dec.Tip == nil {
+		return errors.New("missing required field 'maxPriorityFeePerGas' in transaction")
+	}
+	tx.Tip, overflow = uint256.FromBig(dec.Tip.ToInt())
+	if overflow {
+		return errors.New("'tip' in transaction does not fit in 256 bits")
+	}
+	if dec.FeeCap == nil {
+		return errors.New("missing required field 'maxFeePerGas' in transaction")
+	}
+	tx.FeeCap, overflow = uint256.FromBig(dec.FeeCap.ToInt())
+	if overflow {
+		return errors.New("'feeCap' in transaction does not fit in 256 bits")
+	}
+	if dec.Gas == nil {
+		return errors.New("missing required field 'gas' in transaction")
+	}
+	tx.Gas = uint64(*dec.Gas)
+	if dec.Value == nil {
+		return errors.New("missing required field 'value' in transaction")
+	}
+	tx.Value, overflow = uint256.FromBig(dec.Value.ToInt())
+	if overflow {
+		return errors.New("'value' in transaction does not fit in 256 bits")
+	}
+	if dec.Data == nil {
+		return errors.New("missing required field 'input' in transaction")
+	}
+	tx.Data = *dec.Data
+	if dec.V == nil {
+		return errors.New("missing required field 'v' in transaction")
+	}
+	overflow = tx.V.SetFromBig(dec.V.ToInt())
+	if overflow {
+		return fmt.Errorf("dec.V higher than 2^256-1")
+	}
+	if dec.R == nil {
+		return errors.New("missing required field 'r' in transaction")
+	}
+	overflow = tx.R.SetFromBig(dec.R.ToInt())
+	if overflow {
+		return fmt.Errorf("dec.R higher than 2^256-1")
+	}
+	if dec.S == nil {
+		return errors.New("missing required field 's' in transaction")
+	}
+	overflow = tx.S.SetFromBig(dec.S.ToInt())
+	if overflow {
+		return fmt.Errorf("dec.S higher than 2^256-1")
+	}
+	withSignature := !tx.V.IsZero() || !tx.R.IsZero() || !tx.S.IsZero()
+	if withSignature {
+		if err := sanityCheckSignature(&tx.V, &tx.R, &tx.S, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func UnmarshalBlobTxJSON(input []byte) (Transaction, error) {
 	var dec txJSON
 	if err := json.Unmarshal(input, &dec); err != nil {
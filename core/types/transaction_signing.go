@@ -45,6 +45,15 @@ func MakeSigner(config *chain.Config, blockNumber uint64, blockTime uint64) *Sig
 	}
 	signer.unprotected = true
 	switch {
+	case config.IsPrague(blockTime):
+		// All transaction types are still supported
+		signer.protected = true
+		signer.accessList = true
+		signer.dynamicFee = true
+		signer.blob = true
+		signer.setCode = true
+		signer.chainID.Set(&chainId)
+		signer.chainIDMul.Mul(&chainId, u256.Num2)
 	case config.IsCancun(blockTime):
 		// All transaction types are still supported
 		signer.protected = true
@@ -100,6 +109,9 @@ func LatestSigner(config *chain.Config) *Signer {
 	signer.chainID.Set(chainId)
 	signer.chainIDMul.Mul(chainId, u256.Num2)
 	if config.ChainID != nil {
+		if config.PragueTime != nil {
+			signer.setCode = true
+		}
 		if config.CancunTime != nil {
 			signer.blob = true
 		}
@@ -139,6 +151,7 @@ func LatestSignerForChainID(chainID *big.Int) *Signer {
 	signer.accessList = true
 	signer.dynamicFee = true
 	signer.blob = true
+	signer.setCode = true
 	return &signer
 }
 
@@ -186,11 +199,12 @@ type Signer struct {
 	accessList          bool // Whether this signer should allow transactions with access list, supersedes protected
 	dynamicFee          bool // Whether this signer should allow transactions with base fee and tip (instead of gasprice), supersedes accessList
 	blob                bool // Whether this signer should allow blob transactions
+	setCode             bool // Whether this signer should allow set code transactions
 }
 
 func (sg Signer) String() string {
-	return fmt.Sprintf("Signer[chainId=%s,malleable=%t,unprotected=%t,protected=%t,accessList=%t,dynamicFee=%t,blob=%t",
-		&sg.chainID, sg.malleable, sg.unprotected, sg.protected, sg.accessList, sg.dynamicFee, sg.blob)
+	return fmt.Sprintf("Signer[chainId=%s,malleable=%t,unprotected=%t,protected=%t,accessList=%t,dynamicFee=%t,blob=%t,setCode=%t",
+		&sg.chainID, sg.malleable, sg.unprotected, sg.protected, sg.accessList, sg.dynamicFee, sg.blob, sg.setCode)
 }
 
 // Sender returns the sender address of the transaction.
@@ -268,6 +282,19 @@ func (sg Signer) SenderWithContext(context *secp256k1.Context, tx Transaction) (
 		// id, add 27 to become equivalent to unprotected Homestead signatures.
 		V.Add(&t.V, u256.Num27)
 		R, S = &t.R, &t.S
+	case *SetCodeTransaction:
+		if !sg.setCode {
+			return libcommon.Address{}, fmt.Errorf("setCode tx is not supported by signer %s", sg)
+		}
+		if t.ChainID == nil {
+			if !sg.chainID.IsZero() {
+				return libcommon.Address{}, ErrInvalidChainId
+			}
+		} else if !t.ChainID.Eq(&sg.chainID) {
+			return libcommon.Address{}, ErrInvalidChainId
+		}
+		V.Add(&t.V, u256.Num27)
+		R, S = &t.R, &t.S
 	default:
 		return libcommon.Address{}, ErrTxTypeNotSupported
 	}
@@ -307,6 +334,11 @@ func (sg Signer) SignatureValues(tx Transaction, sig []byte) (R, S, V *uint256.I
 			return nil, nil, nil, ErrInvalidChainId
 		}
 		R, S, V = decodeSignature(sig)
+	case *SetCodeTransaction:
+		if t.ChainID != nil && !t.ChainID.IsZero() && !t.ChainID.Eq(&sg.chainID) {
+			return nil, nil, nil, ErrInvalidChainId
+		}
+		R, S, V = decodeSignature(sig)
 	default:
 		return nil, nil, nil, ErrTxTypeNotSupported
 	}
@@ -325,7 +357,8 @@ func (sg Signer) Equal(other Signer) bool {
 		sg.protected == other.protected &&
 		sg.accessList == other.accessList &&
 		sg.dynamicFee == other.dynamicFee &&
-		sg.blob == other.blob
+		sg.blob == other.blob &&
+		sg.setCode == other.setCode
 }
 
 func decodeSignature(sig []byte) (r, s, v *uint256.Int) {
@@ -737,6 +737,128 @@ func TestBlobTxEncodeDecode(t *testing.T) {
 	}
 }
 
+func TestSetCodeTxEncodeDecode(t *testing.T) {
+	t.Parallel()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("could not generate key: %v", err)
+	}
+	authorityKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("could not generate key: %v", err)
+	}
+	var (
+		signer    = LatestSignerForChainID(libcommon.Big1)
+		delegate  = libcommon.HexToAddress("0x0000000000000000000000000000000000000aaa")
+		recipient = crypto.PubkeyToAddress(authorityKey.PublicKey)
+		accesses  = types2.AccessList{{Address: delegate, StorageKeys: []libcommon.Hash{{0}}}}
+	)
+	auth, err := SignAuthorization(Authorization{ChainID: *uint256.NewInt(1), Address: delegate, Nonce: 7}, authorityKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a chain id of zero makes the authorization valid on every chain
+	anyChainAuth, err := SignAuthorization(Authorization{Address: delegate, Nonce: 8}, authorityKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txdata := &SetCodeTransaction{
+		DynamicFeeTransaction: DynamicFeeTransaction{
+			CommonTx: CommonTx{
+				Nonce: 3,
+				To:    &recipient,
+				Value: uint256.NewInt(0),
+				Gas:   123457,
+				Data:  []byte("abcdef"),
+			},
+			ChainID:    uint256.NewInt(1),
+			Tip:        uint256.NewInt(1),
+			FeeCap:     uint256.NewInt(10),
+			AccessList: accesses,
+		},
+		Authorizations: []Authorization{auth, anyChainAuth},
+	}
+	tx, err := SignNewTx(key, *signer, txdata)
+	if err != nil {
+		t.Fatalf("could not sign transaction: %v", err)
+	}
+	var buf bytes.Buffer
+	if err = tx.MarshalBinary(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if want, got := crypto.Keccak256Hash(buf.Bytes()), tx.Hash(); want != got {
+		t.Fatalf("hash is not the hash of the binary encoding, want %v, got %v", want, got)
+	}
+	if len(buf.Bytes()) != tx.EncodingSize() {
+		t.Fatalf("wrong encoding size, want %d, got %d", len(buf.Bytes()), tx.EncodingSize())
+	}
+
+	for _, codec := range []func(Transaction) (Transaction, error){encodeDecodeBinary, encodeDecodeJSON} {
+		parsedTx, err := codec(tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = assertEqual(tx, parsedTx); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(tx.GetAuthorizations(), parsedTx.GetAuthorizations()) {
+			t.Fatalf("authorizations differ, want %v, got %v", tx.GetAuthorizations(), parsedTx.GetAuthorizations())
+		}
+		from, err := parsedTx.Sender(*signer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if from != crypto.PubkeyToAddress(key.PublicKey) {
+			t.Fatalf("wrong sender %v", from)
+		}
+		for _, parsedAuth := range parsedTx.GetAuthorizations() {
+			authority, err := parsedAuth.RecoverSigner()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if authority != recipient {
+				t.Fatalf("wrong authority, want %v, got %v", recipient, authority)
+			}
+		}
+	}
+
+	// set code transactions are typed, so pre-Prague signers reject them
+	cancunSigner := *signer
+	cancunSigner.setCode = false
+	if _, err := cancunSigner.Sender(tx); err == nil {
+		t.Fatal("expected an error from a signer without set code support")
+	}
+
+	// set code transactions can't create contracts and need at least one authorization
+	txdata.To = nil
+	if _, err := encodeDecodeJSON(txdata); err == nil {
+		t.Fatal("expected an error for a set code transaction without destination")
+	}
+	txdata.To = &recipient
+	txdata.Authorizations = nil
+	if _, err := encodeDecodeBinary(txdata); err == nil {
+		t.Fatal("expected an error for a set code transaction without authorizations")
+	}
+}
+
+func TestDelegation(t *testing.T) {
+	t.Parallel()
+	delegate := libcommon.HexToAddress("0x0000000000000000000000000000000000000aaa")
+	code := AddressToDelegation(delegate)
+	if len(code) != DelegationDesignatorLen {
+		t.Fatalf("wrong delegation length %d", len(code))
+	}
+	if got, ok := ParseDelegation(code); !ok || got != delegate {
+		t.Fatalf("could not parse delegation %x", code)
+	}
+	if _, ok := ParseDelegation(append(code, 0)); ok {
+		t.Fatal("code longer than a delegation designator is not a delegation")
+	}
+	if _, ok := ParseDelegation(append([]byte{0xef, 0x01, 0x01}, delegate.Bytes()...)); ok {
+		t.Fatal("wrong delegation prefix")
+	}
+}
+
 func makeBlobTxRlp() []byte {
 	bodyRlp := hexutility.MustDecodeHex(txpool.BodyRlpHex)
 
@@ -1,11 +1,76 @@
 package types
 
-import libcommon "github.com/ledgerwatch/erigon-lib/common"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon/rlp"
+)
+
+// WithdrawalRequest is an EIP-7002 execution layer triggerable withdrawal,
+// dequeued from the withdrawal request predeploy at the end of the block.
 type WithdrawalRequest struct {
-	SourceAddress   libcommon.Address
-	ValidatorPubkey [pLen]byte // bls
-	Amount          uint64
+	SourceAddress   libcommon.Address `json:"sourceAddress"`
+	ValidatorPubkey [pLen]byte        `json:"validatorPubkey"` // bls
+	Amount          uint64            `json:"amount"`          // in Gwei, 0 means a full exit
+}
+
+func (w *WithdrawalRequest) requestType() byte               { return WithdrawalRequestType }
+func (w *WithdrawalRequest) encodeRLP(b *bytes.Buffer) error { return rlp.Encode(b, w) }
+func (w *WithdrawalRequest) decodeRLP(data []byte) error     { return rlp.DecodeBytes(data, w) }
+func (w *WithdrawalRequest) copy() RequestData {
+	return &WithdrawalRequest{
+		SourceAddress:   w.SourceAddress,
+		ValidatorPubkey: w.ValidatorPubkey,
+		Amount:          w.Amount,
+	}
+}
+
+func (w *WithdrawalRequest) encodingSize() (encodingSize int) {
+	encodingSize++
+	encodingSize += rlp.IntLenExcludingHead(w.Amount)
+
+	encodingSize += 70 // 1 + 20 + 1 + 48 (0x80 + addrLen, 0x80 + pLen)
+	return encodingSize
+}
+
+type withdrawalRequestJSON struct {
+	SourceAddress   libcommon.Address `json:"sourceAddress"`
+	ValidatorPubkey hexutility.Bytes  `json:"validatorPubkey"`
+	Amount          hexutil.Uint64    `json:"amount"`
+}
+
+func (w WithdrawalRequest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(withdrawalRequestJSON{
+		SourceAddress:   w.SourceAddress,
+		ValidatorPubkey: w.ValidatorPubkey[:],
+		Amount:          hexutil.Uint64(w.Amount),
+	})
+}
+
+func (w *WithdrawalRequest) UnmarshalJSON(input []byte) error {
+	var dec withdrawalRequestJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	if len(dec.ValidatorPubkey) != pLen {
+		return fmt.Errorf("validatorPubkey: expected %d bytes, got %d", pLen, len(dec.ValidatorPubkey))
+	}
+	w.SourceAddress = dec.SourceAddress
+	copy(w.ValidatorPubkey[:], dec.ValidatorPubkey)
+	w.Amount = uint64(dec.Amount)
+	return nil
 }
 
 type WithdrawalRequests []*WithdrawalRequest
+
+func (ws WithdrawalRequests) ToRequests() (reqs Requests) {
+	for _, w := range ws {
+		reqs = append(reqs, NewRequest(w))
+	}
+	return
+}
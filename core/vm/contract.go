@@ -56,6 +56,11 @@ type Contract struct {
 	CodeAddr *libcommon.Address
 	Input    []byte
 
+	// Container is the parsed EOF code, nil for legacy code. Code is then the code section being executed.
+	Container   *Container
+	codeSection int              // EOF code section being executed
+	returnStack []eofReturnFrame // EOF frames RETF returns to
+
 	Gas   uint64
 	value *uint256.Int
 }
@@ -29,6 +29,7 @@ import (
 )
 
 var activators = map[int]func(*JumpTable){
+	7702: enable7702,
 	2935: enable2935,
 	7516: enable7516,
 	6780: enable6780,
@@ -339,3 +340,164 @@ func enable2935(jt *JumpTable) {
 		numPush:     1,
 	}
 }
+
+// enable7702 applies EIP-7702 (Set EOA account code): calls to a delegated account
+// also pay for the access of its delegate
+func enable7702(jt *JumpTable) {
+	jt[CALL].dynamicGas = gasCallEIP7702
+	jt[CALLCODE].dynamicGas = gasCallCodeEIP7702
+	jt[STATICCALL].dynamicGas = gasStaticCallEIP7702
+	jt[DELEGATECALL].dynamicGas = gasDelegateCallEIP7702
+}
+
+// enableLegacyEOFAccess applies the EIP-3540 changes to legacy code: EXTCODESIZE, EXTCODECOPY
+// and EXTCODEHASH of an EOF account only see its magic 0xEF00
+func enableLegacyEOFAccess(jt *JumpTable) {
+	jt[EXTCODESIZE].execute = opExtCodeSizeEOF
+	jt[EXTCODECOPY].execute = opExtCodeCopyEOF
+	jt[EXTCODEHASH].execute = opExtCodeHashEOF
+}
+
+// enableEOF turns a legacy jump table into the one of EOF code:
+// - EIP-3540 and EIP-3670 remove the instructions which observe code or gas or jump dynamically
+// - EIP-4200 RJUMP, RJUMPI and RJUMPV static relative jumps
+// - EIP-4750 CALLF and RETF functions, EIP-6206 JUMPF
+// - EIP-7480 DATALOAD, DATALOADN, DATASIZE and DATACOPY
+// - EIP-663 DUPN, SWAPN and EXCHANGE
+// - EIP-7069 EXTCALL, EXTDELEGATECALL, EXTSTATICCALL and RETURNDATALOAD
+// - EIP-7620 EOFCREATE and RETURNCONTRACT
+func enableEOF(jt *JumpTable) {
+	for _, op := range []OpCode{
+		CALLCODE, SELFDESTRUCT, JUMP, JUMPI, PC, CREATE, CREATE2, CODESIZE, CODECOPY,
+		EXTCODESIZE, EXTCODECOPY, EXTCODEHASH, GAS, CALL, STATICCALL, DELEGATECALL,
+	} {
+		jt[op] = &operation{execute: opUndefined, undefined: true}
+	}
+	jt[RETURNDATACOPY].execute = opReturnDataCopyEOF
+
+	jt[RJUMP] = &operation{
+		execute:     opRjump,
+		constantGas: GasQuickStep,
+		numPop:      0,
+		numPush:     0,
+	}
+	jt[RJUMPI] = &operation{
+		execute:     opRjumpi,
+		constantGas: GasFastishStep,
+		numPop:      1,
+		numPush:     0,
+	}
+	jt[RJUMPV] = &operation{
+		execute:     opRjumpv,
+		constantGas: GasFastishStep,
+		numPop:      1,
+		numPush:     0,
+	}
+	jt[CALLF] = &operation{
+		execute:     opCallf,
+		constantGas: GasFastStep,
+		numPop:      0,
+		numPush:     0,
+	}
+	jt[RETF] = &operation{
+		execute:     opRetf,
+		constantGas: GasFastestStep,
+		numPop:      0,
+		numPush:     0,
+	}
+	jt[JUMPF] = &operation{
+		execute:     opJumpf,
+		constantGas: GasFastStep,
+		numPop:      0,
+		numPush:     0,
+	}
+	jt[DATALOAD] = &operation{
+		execute:     opDataLoad,
+		constantGas: GasFastishStep,
+		numPop:      1,
+		numPush:     1,
+	}
+	jt[DATALOADN] = &operation{
+		execute:     opDataLoadN,
+		constantGas: GasFastestStep,
+		numPop:      0,
+		numPush:     1,
+	}
+	jt[DATASIZE] = &operation{
+		execute:     opDataSize,
+		constantGas: GasQuickStep,
+		numPop:      0,
+		numPush:     1,
+	}
+	jt[DATACOPY] = &operation{
+		execute:     opDataCopy,
+		constantGas: GasFastestStep,
+		dynamicGas:  gasDataCopy,
+		numPop:      3,
+		numPush:     0,
+		memorySize:  memoryDataCopy,
+	}
+	jt[DUPN] = &operation{
+		execute:     opDupN,
+		constantGas: GasFastestStep,
+		numPop:      0,
+		numPush:     1,
+	}
+	jt[SWAPN] = &operation{
+		execute:     opSwapN,
+		constantGas: GasFastestStep,
+		numPop:      0,
+		numPush:     0,
+	}
+	jt[EXCHANGE] = &operation{
+		execute:     opExchange,
+		constantGas: GasFastestStep,
+		numPop:      0,
+		numPush:     0,
+	}
+	jt[RETURNDATALOAD] = &operation{
+		execute:     opReturnDataLoad,
+		constantGas: GasFastestStep,
+		numPop:      1,
+		numPush:     1,
+	}
+	jt[EXTCALL] = &operation{
+		execute:     opExtCall,
+		constantGas: params.WarmStorageReadCostEIP2929,
+		dynamicGas:  gasExtCall,
+		numPop:      4,
+		numPush:     1,
+		memorySize:  memoryExtCall,
+	}
+	jt[EXTDELEGATECALL] = &operation{
+		execute:     opExtDelegateCall,
+		constantGas: params.WarmStorageReadCostEIP2929,
+		dynamicGas:  gasExtDelegateCall,
+		numPop:      3,
+		numPush:     1,
+		memorySize:  memoryExtCall,
+	}
+	jt[EXTSTATICCALL] = &operation{
+		execute:     opExtStaticCall,
+		constantGas: params.WarmStorageReadCostEIP2929,
+		dynamicGas:  gasExtStaticCall,
+		numPop:      3,
+		numPush:     1,
+		memorySize:  memoryExtCall,
+	}
+	jt[EOFCREATE] = &operation{
+		execute:     opEOFCreate,
+		constantGas: params.CreateGas,
+		dynamicGas:  gasEOFCreate,
+		numPop:      4,
+		numPush:     1,
+		memorySize:  memoryEOFCreate,
+	}
+	jt[RETURNCONTRACT] = &operation{
+		execute:    opReturnContract,
+		dynamicGas: gasReturnContract,
+		numPop:     2,
+		numPush:    0,
+		memorySize: memoryReturnContract,
+	}
+}
@@ -0,0 +1,354 @@
+package vm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// EOF v1 container layout (EIP-3540):
+//
+//	magic(2) version(1)
+//	kind_types(1) types_size(2)
+//	kind_code(1) num_code_sections(2) code_size(2)+
+//	[kind_container(1) num_container_sections(2) container_size(4)+]
+//	kind_data(1) data_size(2)
+//	terminator(1)
+//	types_section code_section+ [container_section+] data_section
+const (
+	eofFormatByte = 0xef
+	eofMagicByte  = 0x00
+	eof1Version   = 1
+
+	kindTypes     = 0x01
+	kindCode      = 0x02
+	kindContainer = 0x03
+	kindData      = 0xff
+	terminator    = 0x00
+
+	eofTypeEntrySize        = 4
+	eofNonReturningFunction = 0x80
+
+	eofMaxCodeSections      = 1024
+	eofMaxContainerSections = 256
+	eofMaxInputs            = 0x7f
+	eofMaxOutputs           = 0x7f
+	eofMaxStackHeight       = 1023
+	eofMaxDataSize          = 0xffff
+	eofMaxReturnStackHeight = 1024
+)
+
+// EOF container parsing errors
+var (
+	ErrInvalidMagic            = errors.New("invalid magic")
+	ErrInvalidVersion          = errors.New("invalid version")
+	ErrMissingTypeHeader       = errors.New("missing type header")
+	ErrInvalidTypeSize         = errors.New("invalid type section size")
+	ErrMissingCodeHeader       = errors.New("missing code header")
+	ErrInvalidCodeHeader       = errors.New("invalid code header")
+	ErrInvalidCodeSize         = errors.New("invalid code size")
+	ErrInvalidContainerHeader  = errors.New("invalid container header")
+	ErrInvalidContainerSize    = errors.New("invalid container section size")
+	ErrMissingDataHeader       = errors.New("missing data header")
+	ErrMissingTerminator       = errors.New("missing header terminator")
+	ErrInvalidSectionsSize     = errors.New("invalid section bodies size")
+	ErrTooManyInputs           = errors.New("invalid type content, too many inputs")
+	ErrTooManyOutputs          = errors.New("invalid type content, too many outputs")
+	ErrInvalidFirstSectionType = errors.New("invalid section 0 type, input and output should be zero and non-returning (0x80)")
+	ErrTooLargeMaxStackHeight  = errors.New("invalid type content, max stack height exceeds limit")
+	ErrTruncatedData           = errors.New("data section is truncated")
+)
+
+// HasEOFMagic returns whether the code starts with the EOF magic (0xEF00)
+func HasEOFMagic(code []byte) bool {
+	return len(code) >= 2 && code[0] == eofFormatByte && code[1] == eofMagicByte
+}
+
+// eofMagicCode is what legacy EXTCODE* instructions observe of an EOF account
+var eofMagicCode = []byte{eofFormatByte, eofMagicByte}
+
+// functionMetadata is an entry of the types section: the signature of a code section
+type functionMetadata struct {
+	inputs         uint8
+	outputs        uint8
+	maxStackHeight uint16
+}
+
+func (meta *functionMetadata) isReturning() bool { return meta.outputs != eofNonReturningFunction }
+
+// Container is a parsed EOF v1 container
+type Container struct {
+	types         []*functionMetadata
+	codeSections  [][]byte
+	subContainers []*Container
+	// subContainerCodes keeps the raw bytes of the subcontainers: EOFCREATE hashes them for the address
+	subContainerCodes [][]byte
+	data              []byte
+	dataSize          int // declared size, len(data) is smaller for truncated containers
+}
+
+// IsTruncated returns whether the data section is shorter than declared in the header.
+// Only containers deployed by RETURNCONTRACT may be truncated, the missing data is appended as aux data.
+func (c *Container) IsTruncated() bool { return len(c.data) < c.dataSize }
+
+// MarshalBinary encodes the container, the declared data size is written as is.
+func (c *Container) MarshalBinary() []byte {
+	b := make([]byte, 0, c.size())
+	b = append(b, eofFormatByte, eofMagicByte, eof1Version)
+	b = append(b, kindTypes)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(c.types)*eofTypeEntrySize))
+	b = append(b, kindCode)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(c.codeSections)))
+	for _, code := range c.codeSections {
+		b = binary.BigEndian.AppendUint16(b, uint16(len(code)))
+	}
+	if len(c.subContainerCodes) > 0 {
+		b = append(b, kindContainer)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(c.subContainerCodes)))
+		for _, sub := range c.subContainerCodes {
+			b = binary.BigEndian.AppendUint32(b, uint32(len(sub)))
+		}
+	}
+	b = append(b, kindData)
+	b = binary.BigEndian.AppendUint16(b, uint16(c.dataSize))
+	b = append(b, terminator)
+	for _, meta := range c.types {
+		b = append(b, meta.inputs, meta.outputs)
+		b = binary.BigEndian.AppendUint16(b, meta.maxStackHeight)
+	}
+	for _, code := range c.codeSections {
+		b = append(b, code...)
+	}
+	for _, sub := range c.subContainerCodes {
+		b = append(b, sub...)
+	}
+	return append(b, c.data...)
+}
+
+// size returns the length of the encoded container
+func (c *Container) size() int {
+	n := c.headerSize() + len(c.types)*eofTypeEntrySize + len(c.data)
+	for _, code := range c.codeSections {
+		n += len(code)
+	}
+	for _, sub := range c.subContainerCodes {
+		n += len(sub)
+	}
+	return n
+}
+
+func (c *Container) headerSize() int {
+	// magic, version, types header, code header, data header and terminator
+	n := 3 + 3 + 3 + 2*len(c.codeSections) + 3 + 1
+	if len(c.subContainerCodes) > 0 {
+		n += 3 + 4*len(c.subContainerCodes)
+	}
+	return n
+}
+
+// withAuxData returns a copy of the container with the aux data appended to its data section,
+// as RETURNCONTRACT deploys it. The result has to fill at least the declared data size.
+func (c *Container) withAuxData(aux []byte) (*Container, error) {
+	dataLen := len(c.data) + len(aux)
+	if dataLen < c.dataSize {
+		return nil, ErrTruncatedData
+	}
+	if dataLen > eofMaxDataSize {
+		return nil, fmt.Errorf("%w: data size %d exceeds limit", ErrInvalidSectionsSize, dataLen)
+	}
+	deployed := *c
+	deployed.data = make([]byte, 0, dataLen)
+	deployed.data = append(append(deployed.data, c.data...), aux...)
+	deployed.dataSize = dataLen
+	return &deployed, nil
+}
+
+// UnmarshalBinary decodes an EOF container, the data section may be truncated.
+func (c *Container) UnmarshalBinary(b []byte) error {
+	n, err := c.unmarshal(b, false)
+	if err != nil {
+		return err
+	}
+	if n != len(b) {
+		return fmt.Errorf("%w: %d trailing bytes", ErrInvalidSectionsSize, len(b)-n)
+	}
+	return nil
+}
+
+// ParseEOFInitcode splits the data of an EOF creation transaction (EIP-7698) into
+// the initcontainer and the calldata following it.
+func ParseEOFInitcode(b []byte) (*Container, []byte, error) {
+	var c Container
+	n, err := c.unmarshal(b, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &c, b[n:], nil
+}
+
+// unmarshal decodes the container at the beginning of b and returns its encoded length.
+// If the data section has to be complete, the bytes after it are left to the caller,
+// otherwise a short data section is accepted as truncated.
+func (c *Container) unmarshal(b []byte, completeData bool) (int, error) {
+	if !HasEOFMagic(b) {
+		return 0, ErrInvalidMagic
+	}
+	if len(b) < 3 || b[2] != eof1Version {
+		return 0, ErrInvalidVersion
+	}
+	pos := 3
+
+	// Types header
+	kind, typesSize, err := parseSectionSize(b, pos)
+	if err != nil || kind != kindTypes {
+		return 0, ErrMissingTypeHeader
+	}
+	if typesSize < eofTypeEntrySize || typesSize%eofTypeEntrySize != 0 {
+		return 0, fmt.Errorf("%w: %d", ErrInvalidTypeSize, typesSize)
+	}
+	pos += 3
+
+	// Code header
+	kind, codeSizes, err := parseSectionSizes(b, pos, 2)
+	if err != nil || kind != kindCode {
+		return 0, ErrMissingCodeHeader
+	}
+	if len(codeSizes) == 0 || len(codeSizes) > eofMaxCodeSections {
+		return 0, fmt.Errorf("%w: %d code sections", ErrInvalidCodeHeader, len(codeSizes))
+	}
+	if len(codeSizes) != typesSize/eofTypeEntrySize {
+		return 0, fmt.Errorf("%w: %d code sections for %d types", ErrInvalidCodeHeader, len(codeSizes), typesSize/eofTypeEntrySize)
+	}
+	for i, size := range codeSizes {
+		if size == 0 {
+			return 0, fmt.Errorf("%w: code section %d is empty", ErrInvalidCodeSize, i)
+		}
+	}
+	pos += 3 + 2*len(codeSizes)
+
+	// Optional container header
+	var containerSizes []int
+	if pos < len(b) && b[pos] == kindContainer {
+		if _, containerSizes, err = parseSectionSizes(b, pos, 4); err != nil {
+			return 0, ErrInvalidContainerHeader
+		}
+		if len(containerSizes) == 0 || len(containerSizes) > eofMaxContainerSections {
+			return 0, fmt.Errorf("%w: %d container sections", ErrInvalidContainerHeader, len(containerSizes))
+		}
+		for i, size := range containerSizes {
+			if size == 0 {
+				return 0, fmt.Errorf("%w: container section %d is empty", ErrInvalidContainerSize, i)
+			}
+		}
+		pos += 3 + 4*len(containerSizes)
+	}
+
+	// Data header
+	kind, dataSize, err := parseSectionSize(b, pos)
+	if err != nil || kind != kindData {
+		return 0, ErrMissingDataHeader
+	}
+	pos += 3
+
+	if pos >= len(b) || b[pos] != terminator {
+		return 0, ErrMissingTerminator
+	}
+	pos++
+
+	// Section bodies, all but the data section have to be complete
+	bodiesSize := typesSize
+	for _, size := range codeSizes {
+		bodiesSize += size
+	}
+	for _, size := range containerSizes {
+		bodiesSize += size
+	}
+	if len(b) < pos+bodiesSize {
+		return 0, fmt.Errorf("%w: have %d, want at least %d", ErrInvalidSectionsSize, len(b), pos+bodiesSize)
+	}
+	if completeData && len(b) < pos+bodiesSize+dataSize {
+		return 0, fmt.Errorf("%w: have %d, want %d", ErrTruncatedData, len(b), pos+bodiesSize+dataSize)
+	}
+
+	c.types = make([]*functionMetadata, 0, len(codeSizes))
+	for i := 0; i < typesSize; i += eofTypeEntrySize {
+		meta := &functionMetadata{
+			inputs:         b[pos+i],
+			outputs:        b[pos+i+1],
+			maxStackHeight: binary.BigEndian.Uint16(b[pos+i+2:]),
+		}
+		section := len(c.types)
+		if meta.inputs > eofMaxInputs {
+			return 0, fmt.Errorf("%w: section %d has %d inputs", ErrTooManyInputs, section, meta.inputs)
+		}
+		if meta.outputs > eofMaxOutputs && meta.isReturning() {
+			return 0, fmt.Errorf("%w: section %d has %d outputs", ErrTooManyOutputs, section, meta.outputs)
+		}
+		if meta.maxStackHeight > eofMaxStackHeight {
+			return 0, fmt.Errorf("%w: section %d has max stack height %d", ErrTooLargeMaxStackHeight, section, meta.maxStackHeight)
+		}
+		c.types = append(c.types, meta)
+	}
+	if c.types[0].inputs != 0 || c.types[0].isReturning() {
+		return 0, ErrInvalidFirstSectionType
+	}
+	pos += typesSize
+
+	c.codeSections = make([][]byte, len(codeSizes))
+	for i, size := range codeSizes {
+		c.codeSections[i] = b[pos : pos+size]
+		pos += size
+	}
+
+	c.subContainers, c.subContainerCodes = nil, nil
+	if len(containerSizes) > 0 {
+		c.subContainers = make([]*Container, len(containerSizes))
+		c.subContainerCodes = make([][]byte, len(containerSizes))
+		for i, size := range containerSizes {
+			sub := new(Container)
+			if err := sub.UnmarshalBinary(b[pos : pos+size]); err != nil {
+				return 0, fmt.Errorf("container section %d: %w", i, err)
+			}
+			c.subContainers[i] = sub
+			c.subContainerCodes[i] = b[pos : pos+size]
+			pos += size
+		}
+	}
+
+	end := pos + dataSize
+	if end > len(b) {
+		end = len(b)
+	}
+	c.data = b[pos:end]
+	c.dataSize = dataSize
+	return end, nil
+}
+
+// parseSectionSize reads a section kind followed by a 2-byte size
+func parseSectionSize(b []byte, pos int) (kind byte, size int, err error) {
+	if pos+3 > len(b) {
+		return 0, 0, ErrInvalidSectionsSize
+	}
+	return b[pos], int(binary.BigEndian.Uint16(b[pos+1:])), nil
+}
+
+// parseSectionSizes reads a section kind followed by a 2-byte count and that many sizes of sizeLen bytes
+func parseSectionSizes(b []byte, pos int, sizeLen int) (kind byte, sizes []int, err error) {
+	kind, count, err := parseSectionSize(b, pos)
+	if err != nil {
+		return 0, nil, err
+	}
+	pos += 3
+	if pos+count*sizeLen > len(b) {
+		return 0, nil, ErrInvalidSectionsSize
+	}
+	sizes = make([]int, count)
+	for i := range sizes {
+		if sizeLen == 2 {
+			sizes[i] = int(binary.BigEndian.Uint16(b[pos+i*2:]))
+		} else {
+			sizes[i] = int(binary.BigEndian.Uint32(b[pos+i*4:]))
+		}
+	}
+	return kind, sizes, nil
+}
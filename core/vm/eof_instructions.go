@@ -0,0 +1,416 @@
+package vm
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/holiman/uint256"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+
+	"github.com/ledgerwatch/erigon/common/math"
+	"github.com/ledgerwatch/erigon/core/vm/stack"
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/params"
+)
+
+// errExtCallLightFailure is returned for EXT*CALLs which fail without consuming the gas passed to the callee
+var errExtCallLightFailure = errors.New("ext call light failure")
+
+// eofReturnFrame is where RETF continues the execution
+type eofReturnFrame struct {
+	section int
+	pc      uint64
+}
+
+// enterSection switches the executed code to the given EOF code section
+func (c *Contract) enterSection(section int) {
+	c.codeSection = section
+	c.Code = c.Container.codeSections[section]
+}
+
+// jumpTo sets the pc to dest, the interpreter loop increments pc after every operation
+// (dest 0 relies on the wrap-around)
+func jumpTo(pc *uint64, dest uint64) {
+	*pc = dest - 1
+}
+
+func opRjump(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	offset := int16(binary.BigEndian.Uint16(scope.Contract.Code[*pc+1:]))
+	jumpTo(pc, uint64(int64(*pc)+3+int64(offset)))
+	return nil, nil
+}
+
+func opRjumpi(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	cond := scope.Stack.Pop()
+	if cond.IsZero() {
+		*pc += 2
+		return nil, nil
+	}
+	return opRjump(pc, interpreter, scope)
+}
+
+func opRjumpv(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	var (
+		code     = scope.Contract.Code
+		maxIndex = uint64(code[*pc+1])
+		next     = *pc + 2 + (maxIndex+1)*2
+		idx      = scope.Stack.Pop()
+	)
+	if !idx.IsUint64() || idx.Uint64() > maxIndex {
+		jumpTo(pc, next)
+		return nil, nil
+	}
+	offset := int16(binary.BigEndian.Uint16(code[*pc+2+idx.Uint64()*2:]))
+	jumpTo(pc, uint64(int64(next)+int64(offset)))
+	return nil, nil
+}
+
+func opCallf(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	section := int(binary.BigEndian.Uint16(scope.Contract.Code[*pc+1:]))
+	meta := scope.Contract.Container.types[section]
+	if limit := int(params.StackLimit); scope.Stack.Len()+int(meta.maxStackHeight)-int(meta.inputs) > limit {
+		return nil, &ErrStackOverflow{stackLen: scope.Stack.Len(), limit: limit - int(meta.maxStackHeight) + int(meta.inputs)}
+	}
+	contract := scope.Contract
+	if len(contract.returnStack) >= eofMaxReturnStackHeight {
+		return nil, ErrReturnStackExceeded
+	}
+	contract.returnStack = append(contract.returnStack, eofReturnFrame{section: contract.codeSection, pc: *pc + 3})
+	contract.enterSection(section)
+	jumpTo(pc, 0)
+	return nil, nil
+}
+
+func opRetf(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	contract := scope.Contract
+	frame := contract.returnStack[len(contract.returnStack)-1]
+	contract.returnStack = contract.returnStack[:len(contract.returnStack)-1]
+	contract.enterSection(frame.section)
+	jumpTo(pc, frame.pc)
+	return nil, nil
+}
+
+func opJumpf(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	section := int(binary.BigEndian.Uint16(scope.Contract.Code[*pc+1:]))
+	meta := scope.Contract.Container.types[section]
+	if limit := int(params.StackLimit); scope.Stack.Len()+int(meta.maxStackHeight)-int(meta.inputs) > limit {
+		return nil, &ErrStackOverflow{stackLen: scope.Stack.Len(), limit: limit - int(meta.maxStackHeight) + int(meta.inputs)}
+	}
+	scope.Contract.enterSection(section)
+	jumpTo(pc, 0)
+	return nil, nil
+}
+
+func opDataLoad(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	x := scope.Stack.Peek()
+	offset, overflow := x.Uint64WithOverflow()
+	if overflow {
+		offset = math.MaxUint64
+	}
+	x.SetBytes(getData(scope.Contract.Container.data, offset, 32))
+	return nil, nil
+}
+
+func opDataLoadN(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	offset := uint64(binary.BigEndian.Uint16(scope.Contract.Code[*pc+1:]))
+	scope.Stack.Push(new(uint256.Int).SetBytes(getData(scope.Contract.Container.data, offset, 32)))
+	*pc += 2
+	return nil, nil
+}
+
+func opDataSize(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	scope.Stack.Push(new(uint256.Int).SetUint64(uint64(len(scope.Contract.Container.data))))
+	return nil, nil
+}
+
+func opDataCopy(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	var (
+		memOffset  = scope.Stack.Pop()
+		dataOffset = scope.Stack.Pop()
+		length     = scope.Stack.Pop()
+	)
+	dataOffset64, overflow := dataOffset.Uint64WithOverflow()
+	if overflow {
+		dataOffset64 = math.MaxUint64
+	}
+	// These values are checked for overflow during gas cost calculation
+	scope.Memory.Set(memOffset.Uint64(), length.Uint64(), getData(scope.Contract.Container.data, dataOffset64, length.Uint64()))
+	return nil, nil
+}
+
+func opDupN(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	n := int(scope.Contract.Code[*pc+1])
+	scope.Stack.Dup(n + 1)
+	*pc++
+	return nil, nil
+}
+
+func opSwapN(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	n := int(scope.Contract.Code[*pc+1])
+	scope.Stack.Swap(n + 2)
+	*pc++
+	return nil, nil
+}
+
+func opExchange(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	imm := scope.Contract.Code[*pc+1]
+	n, m := int(imm>>4)+1, int(imm&0x0f)+1
+	a, b := scope.Stack.Back(n), scope.Stack.Back(n+m)
+	*a, *b = *b, *a
+	*pc++
+	return nil, nil
+}
+
+// opReturnDataLoad implements RETURNDATALOAD, reading past the return data yields zeros
+func opReturnDataLoad(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	x := scope.Stack.Peek()
+	offset, overflow := x.Uint64WithOverflow()
+	if overflow {
+		offset = math.MaxUint64
+	}
+	x.SetBytes(getData(interpreter.returnData, offset, 32))
+	return nil, nil
+}
+
+// opReturnDataCopyEOF is RETURNDATACOPY of EOF code, which pads with zeros instead of failing out of bounds
+func opReturnDataCopyEOF(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	var (
+		memOffset  = scope.Stack.Pop()
+		dataOffset = scope.Stack.Pop()
+		length     = scope.Stack.Pop()
+	)
+	dataOffset64, overflow := dataOffset.Uint64WithOverflow()
+	if overflow {
+		dataOffset64 = math.MaxUint64
+	}
+	scope.Memory.Set(memOffset.Uint64(), length.Uint64(), getData(interpreter.returnData, dataOffset64, length.Uint64()))
+	return nil, nil
+}
+
+func opEOFCreate(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	if interpreter.readOnly {
+		return nil, ErrWriteProtection
+	}
+	var (
+		idx           = scope.Contract.Code[*pc+1]
+		initContainer = scope.Contract.Container.subContainers[idx]
+		initCode      = scope.Contract.Container.subContainerCodes[idx]
+		value         = scope.Stack.Pop()
+		salt          = scope.Stack.Pop()
+		offset, size  = scope.Stack.Pop(), scope.Stack.Peek()
+	)
+	*pc++
+	// The initcontainer is hashed for the address
+	if !scope.Contract.UseGas(ToWordSize(uint64(len(initCode))) * params.Keccak256WordGas) {
+		return nil, ErrOutOfGas
+	}
+	input := scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64()))
+	gas := scope.Contract.Gas
+	gas -= gas / 64
+	scope.Contract.UseGas(gas)
+
+	// reuse size int for stackvalue
+	stackValue := size
+	res, addr, returnGas, suberr := interpreter.evm.EOFCreate(scope.Contract, initContainer, initCode, input, gas, &value, &salt)
+	if suberr != nil {
+		stackValue.Clear()
+	} else {
+		stackValue.SetBytes(addr.Bytes())
+	}
+	scope.Contract.Gas += returnGas
+
+	if suberr == ErrExecutionReverted {
+		interpreter.returnData = res // set REVERT data to return data buffer
+		return nil, nil
+	}
+	interpreter.returnData = nil // clear dirty return data buffer
+	return nil, nil
+}
+
+// opReturnContract ends the execution of initcode, deploying one of its subcontainers with the aux data appended
+func opReturnContract(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	var (
+		idx          = scope.Contract.Code[*pc+1]
+		offset, size = scope.Stack.Pop(), scope.Stack.Pop()
+		aux          = scope.Memory.GetPtr(int64(offset.Uint64()), int64(size.Uint64()))
+	)
+	deployed, err := scope.Contract.Container.subContainers[idx].withAuxData(aux)
+	if err != nil {
+		return nil, err
+	}
+	return deployed.MarshalBinary(), errStopToken
+}
+
+func opExtCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	stack := scope.Stack
+	addr, inOffset, inSize, value := stack.Pop(), stack.Pop(), stack.Pop(), stack.Pop()
+	if interpreter.readOnly && !value.IsZero() {
+		return nil, ErrWriteProtection
+	}
+	args := scope.Memory.GetPtr(int64(inOffset.Uint64()), int64(inSize.Uint64()))
+	extCall(EXTCALL, interpreter, scope, libcommon.Address(addr.Bytes20()), args, &value)
+	return nil, nil
+}
+
+func opExtDelegateCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	stack := scope.Stack
+	addr, inOffset, inSize := stack.Pop(), stack.Pop(), stack.Pop()
+	args := scope.Memory.GetPtr(int64(inOffset.Uint64()), int64(inSize.Uint64()))
+	extCall(EXTDELEGATECALL, interpreter, scope, libcommon.Address(addr.Bytes20()), args, nil)
+	return nil, nil
+}
+
+func opExtStaticCall(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	stack := scope.Stack
+	addr, inOffset, inSize := stack.Pop(), stack.Pop(), stack.Pop()
+	args := scope.Memory.GetPtr(int64(inOffset.Uint64()), int64(inSize.Uint64()))
+	extCall(EXTSTATICCALL, interpreter, scope, libcommon.Address(addr.Bytes20()), args, new(uint256.Int))
+	return nil, nil
+}
+
+// extCall runs EXTCALL, EXTDELEGATECALL or EXTSTATICCALL and pushes the status:
+// 0 on success, 1 on revert or light failure and 2 on failure.
+func extCall(typ OpCode, interpreter *EVMInterpreter, scope *ScopeContext, toAddr libcommon.Address, args []byte, value *uint256.Int) {
+	var (
+		ret       []byte
+		returnGas uint64
+		err       = errExtCallLightFailure
+	)
+	gas, ok := extCallGas(scope.Contract.Gas)
+	// EXTDELEGATECALL can only run EOF code
+	if typ == EXTDELEGATECALL && !HasEOFMagic(interpreter.evm.IntraBlockState().GetCode(toAddr)) {
+		ok = false
+	}
+	if ok {
+		scope.Contract.UseGas(gas)
+		ret, returnGas, err = interpreter.evm.call(typ, scope.Contract, toAddr, args, gas, value, false /* bailout */)
+		scope.Contract.Gas += returnGas
+	}
+
+	var status uint64
+	switch err {
+	case nil:
+		status = 0
+	case ErrExecutionReverted, ErrDepth, ErrInsufficientBalance, errExtCallLightFailure:
+		status = 1
+	default:
+		status = 2
+	}
+	scope.Stack.Push(new(uint256.Int).SetUint64(status))
+	if err == nil || err == ErrExecutionReverted {
+		interpreter.returnData = libcommon.CopyBytes(ret)
+	} else {
+		interpreter.returnData = nil
+	}
+}
+
+// extCallGas returns the gas passed to the callee of an EXT*CALL (EIP-7069): the caller retains
+// 1/64 of its gas but at least MIN_RETAINED_GAS, and the call is a light failure if that leaves
+// less than MIN_CALLEE_GAS to the callee.
+func extCallGas(available uint64) (uint64, bool) {
+	retained := max(available/64, params.ExtCallMinRetainedGas)
+	if available < retained+params.ExtCallMinCalleeGas {
+		return 0, false
+	}
+	return available - retained, true
+}
+
+// makeGasExtCall returns the dynamic gas function of the EXT*CALL instructions, valuePos is the
+// stack position of the value transferred, -1 for the instructions without one
+func makeGasExtCall(valuePos int) gasFunc {
+	return func(evm *EVM, contract *Contract, stack *stack.Stack, mem *Memory, memorySize uint64) (uint64, error) {
+		// Targets are 20 bytes addresses, anything in the upper bytes halts the execution
+		target := stack.Back(0)
+		if target.BitLen() > 160 {
+			return 0, ErrInvalidCode
+		}
+		gas, err := memoryGasCost(mem, memorySize)
+		if err != nil {
+			return 0, err
+		}
+		addr := libcommon.Address(target.Bytes20())
+		// The WarmStorageReadCostEIP2929 (100) is already deducted in the form of a constant cost
+		if evm.IntraBlockState().AddAddressToAccessList(addr) {
+			gas += params.ColdAccountAccessCostEIP2929 - params.WarmStorageReadCostEIP2929
+		}
+		if valuePos >= 0 && !stack.Back(valuePos).IsZero() {
+			gas += params.CallValueTransferGas
+			if evm.IntraBlockState().Empty(addr) {
+				gas += params.CallNewAccountGas
+			}
+		}
+		return gas, nil
+	}
+}
+
+var (
+	gasExtCall         = makeGasExtCall(3)
+	gasExtDelegateCall = makeGasExtCall(-1)
+	gasExtStaticCall   = makeGasExtCall(-1)
+	gasDataCopy        = memoryCopierGas(2)
+	gasEOFCreate       = pureMemoryGascost
+	gasReturnContract  = pureMemoryGascost
+)
+
+func memoryDataCopy(stack *stack.Stack) (uint64, bool) {
+	return calcMemSize64(stack.Back(0), stack.Back(2))
+}
+
+func memoryEOFCreate(stack *stack.Stack) (uint64, bool) {
+	return calcMemSize64(stack.Back(2), stack.Back(3))
+}
+
+func memoryReturnContract(stack *stack.Stack) (uint64, bool) {
+	return calcMemSize64(stack.Back(0), stack.Back(1))
+}
+
+func memoryExtCall(stack *stack.Stack) (uint64, bool) {
+	return calcMemSize64(stack.Back(1), stack.Back(2))
+}
+
+// opExtCodeSizeEOF is EXTCODESIZE of legacy code once EOF is active: EOF accounts report the size of the magic
+func opExtCodeSizeEOF(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	slot := scope.Stack.Peek()
+	code := interpreter.evm.IntraBlockState().GetCode(slot.Bytes20())
+	if HasEOFMagic(code) {
+		code = eofMagicCode
+	}
+	slot.SetUint64(uint64(len(code)))
+	return nil, nil
+}
+
+// opExtCodeCopyEOF is EXTCODECOPY of legacy code once EOF is active: EOF accounts expose only the magic
+func opExtCodeCopyEOF(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	var (
+		stack      = scope.Stack
+		a          = stack.Pop()
+		memOffset  = stack.Pop()
+		codeOffset = stack.Pop()
+		length     = stack.Pop()
+	)
+	code := interpreter.evm.IntraBlockState().GetCode(a.Bytes20())
+	if HasEOFMagic(code) {
+		code = eofMagicCode
+	}
+	len64 := length.Uint64()
+	scope.Memory.Set(memOffset.Uint64(), len64, getDataBig(code, &codeOffset, len64))
+	return nil, nil
+}
+
+// opExtCodeHashEOF is EXTCODEHASH of legacy code once EOF is active: EOF accounts hash to keccak256(0xEF00)
+func opExtCodeHashEOF(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	slot := scope.Stack.Peek()
+	address := libcommon.Address(slot.Bytes20())
+	switch {
+	case interpreter.evm.IntraBlockState().Empty(address):
+		slot.Clear()
+	case HasEOFMagic(interpreter.evm.IntraBlockState().GetCode(address)):
+		slot.SetBytes(eofMagicHash.Bytes())
+	default:
+		slot.SetBytes(interpreter.evm.IntraBlockState().GetCodeHash(address).Bytes())
+	}
+	return nil, nil
+}
+
+var eofMagicHash = crypto.Keccak256Hash(eofMagicCode)
@@ -0,0 +1,231 @@
+package vm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+)
+
+func TestEOFMarshaling(t *testing.T) {
+	sub := &Container{
+		types:        []*functionMetadata{{inputs: 0, outputs: eofNonReturningFunction, maxStackHeight: 0}},
+		codeSections: [][]byte{{byte(STOP)}},
+		dataSize:     2,
+	}
+	subCode := sub.MarshalBinary()
+	for i, c := range []*Container{
+		{
+			types:        []*functionMetadata{{inputs: 0, outputs: eofNonReturningFunction, maxStackHeight: 1}},
+			codeSections: [][]byte{common.Hex2Bytes("5f5ff3")},
+			data:         []byte{0x01, 0x02, 0x03},
+			dataSize:     3,
+		},
+		{
+			types: []*functionMetadata{
+				{inputs: 0, outputs: eofNonReturningFunction, maxStackHeight: 2},
+				{inputs: 2, outputs: 1, maxStackHeight: 2},
+			},
+			codeSections:      [][]byte{common.Hex2Bytes("60026003e300015f5260205ff3"), common.Hex2Bytes("01e4")},
+			subContainers:     []*Container{sub},
+			subContainerCodes: [][]byte{subCode},
+			data:              []byte{0xaa},
+			dataSize:          4, // truncated
+		},
+	} {
+		b := c.MarshalBinary()
+		require.Equal(t, c.size(), len(b), "container %d", i)
+		var got Container
+		require.NoError(t, got.UnmarshalBinary(b), "container %d", i)
+		require.Equal(t, b, got.MarshalBinary(), "container %d", i)
+		require.Equal(t, c.types, got.types, "container %d", i)
+		require.Equal(t, c.codeSections, got.codeSections, "container %d", i)
+		require.Equal(t, c.data, got.data, "container %d", i)
+		require.Equal(t, c.dataSize, got.dataSize, "container %d", i)
+		require.Equal(t, c.IsTruncated(), got.IsTruncated(), "container %d", i)
+		require.Equal(t, len(c.subContainers), len(got.subContainers), "container %d", i)
+	}
+}
+
+func TestEOFParsing(t *testing.T) {
+	for i, test := range []struct {
+		code string
+		err  error
+	}{
+		{"ef000101000402000100010400000000800000fe", ErrMissingDataHeader},
+		{"ef0001010004020001000104ff0000000080000000", ErrMissingDataHeader},
+		{"ef0002010004020001000100ff00000000800000fe", ErrInvalidVersion},
+		{"ef01", ErrInvalidMagic},
+		{"ef00010100040200010001ff00000000800000", ErrInvalidSectionsSize},
+		{"ef00010100040200010001ff00000001800000fe", ErrInvalidFirstSectionType},
+		{"ef00010100040200010000ff00000000800000", ErrInvalidCodeSize},
+		{"ef00010100080200010001ff0000000080000000800000fe", ErrInvalidCodeHeader},
+		{"ef00010100040200010001ff00000000800400fe", ErrTooLargeMaxStackHeight},
+		{"ef00010100040200010001ff00000000800000fe", nil},
+		{"ef00010100040200010001ff00000000800000fe00", ErrInvalidSectionsSize},
+	} {
+		var c Container
+		err := c.UnmarshalBinary(common.FromHex(test.code))
+		if test.err == nil {
+			require.NoError(t, err, "test %d", i)
+		} else {
+			require.ErrorIs(t, err, test.err, "test %d", i)
+		}
+	}
+}
+
+func TestEOFValidation(t *testing.T) {
+	for i, test := range []struct {
+		code     string
+		maxStack uint16
+		kind     ContainerKind
+		err      error
+	}{
+		{"00", 0, RuntimeContainer, nil},
+		{"00", 0, InitcodeContainer, ErrIncompatibleContainerKind},
+		{"5f5ff3", 2, RuntimeContainer, nil},
+		{"5f5ffd", 2, InitcodeContainer, nil},
+		{"5f", 1, RuntimeContainer, ErrInvalidCodeTermination},
+		{"0000", 0, RuntimeContainer, ErrUnreachableCode},
+		{"0100", 0, RuntimeContainer, ErrEOFStackUnderflow},
+		{"5f5000", 0, RuntimeContainer, ErrInvalidMaxStackHeight},
+		{"5800", 1, RuntimeContainer, ErrUndefinedInstruction},
+		{"60", 1, RuntimeContainer, ErrTruncatedImmediate},
+		{"e00001600000", 0, RuntimeContainer, ErrInvalidJumpDest},
+		// loop back to the start with the same stack height
+		{"5fe1fffc00", 1, RuntimeContainer, nil},
+		{"5f5fe1fffb00", 2, RuntimeContainer, ErrInvalidBackwardJump},
+		{"5fe100015f00", 1, RuntimeContainer, nil},
+		{"e0fffd", 0, RuntimeContainer, nil},
+		{"5fe20100010002005f5f00", 2, RuntimeContainer, nil},
+		{"e4", 0, RuntimeContainer, ErrInvalidNonReturning},
+		{"d1000000", 1, RuntimeContainer, ErrInvalidDataloadNArgument},
+		{"5f5fe6015050505000", 3, RuntimeContainer, ErrEOFStackUnderflow},
+		{"5f5fe600505050", 3, RuntimeContainer, ErrInvalidCodeTermination},
+		{"5f5fe60050505000", 3, RuntimeContainer, nil},
+	} {
+		c := &Container{
+			types:        []*functionMetadata{{inputs: 0, outputs: eofNonReturningFunction, maxStackHeight: test.maxStack}},
+			codeSections: [][]byte{common.FromHex(test.code)},
+		}
+		err := ValidateEOF(c.MarshalBinary(), test.kind)
+		if test.err == nil {
+			require.NoError(t, err, "test %d: %s", i, test.code)
+		} else {
+			require.ErrorIs(t, err, test.err, "test %d: %s", i, test.code)
+		}
+	}
+}
+
+func TestEOFValidationSections(t *testing.T) {
+	nonReturning := &functionMetadata{inputs: 0, outputs: eofNonReturningFunction, maxStackHeight: 0}
+	stop := &Container{types: []*functionMetadata{nonReturning}, codeSections: [][]byte{{byte(STOP)}}}
+	revert := &Container{types: []*functionMetadata{{outputs: eofNonReturningFunction, maxStackHeight: 2}}, codeSections: [][]byte{common.FromHex("5f5ffd")}}
+	returnContract := &Container{
+		types:             []*functionMetadata{{outputs: eofNonReturningFunction, maxStackHeight: 2}},
+		codeSections:      [][]byte{common.FromHex("5f5fee00")},
+		subContainers:     []*Container{stop},
+		subContainerCodes: [][]byte{stop.MarshalBinary()},
+	}
+	for i, test := range []struct {
+		container *Container
+		err       error
+	}{
+		{ // CALLF into a function adding its two inputs
+			&Container{
+				types: []*functionMetadata{
+					{outputs: eofNonReturningFunction, maxStackHeight: 2},
+					{inputs: 2, outputs: 1, maxStackHeight: 2},
+				},
+				codeSections: [][]byte{common.FromHex("60026003e300015f5260205ff3"), common.FromHex("01e4")},
+			},
+			nil,
+		},
+		{ // section 1 is never called
+			&Container{
+				types:        []*functionMetadata{nonReturning, nonReturning},
+				codeSections: [][]byte{{byte(STOP)}, {byte(STOP)}},
+			},
+			ErrUnreachableCodeSection,
+		},
+		{ // CALLF into a non-returning section
+			&Container{
+				types:        []*functionMetadata{nonReturning, nonReturning},
+				codeSections: [][]byte{common.FromHex("e3000100"), {byte(STOP)}},
+			},
+			ErrInvalidCallArgument,
+		},
+		{ // JUMPF into a non-returning section
+			&Container{
+				types:        []*functionMetadata{nonReturning, nonReturning},
+				codeSections: [][]byte{common.FromHex("e50001"), {byte(STOP)}},
+			},
+			nil,
+		},
+		{ // CALLF to a missing section
+			&Container{
+				types:        []*functionMetadata{nonReturning},
+				codeSections: [][]byte{common.FromHex("e3000100")},
+			},
+			ErrInvalidSectionArgument,
+		},
+		{ // EOFCREATE of an initcontainer
+			&Container{
+				types:             []*functionMetadata{{outputs: eofNonReturningFunction, maxStackHeight: 4}},
+				codeSections:      [][]byte{common.FromHex("5f5f5f5fec005000")},
+				subContainers:     []*Container{returnContract},
+				subContainerCodes: [][]byte{returnContract.MarshalBinary()},
+			},
+			nil,
+		},
+		{ // EOFCREATE of a container with STOP
+			&Container{
+				types:             []*functionMetadata{{outputs: eofNonReturningFunction, maxStackHeight: 4}},
+				codeSections:      [][]byte{common.FromHex("5f5f5f5fec005000")},
+				subContainers:     []*Container{stop},
+				subContainerCodes: [][]byte{stop.MarshalBinary()},
+			},
+			ErrIncompatibleContainerKind,
+		},
+		{ // unreferenced subcontainer
+			&Container{
+				types:             []*functionMetadata{nonReturning},
+				codeSections:      [][]byte{{byte(STOP)}},
+				subContainers:     []*Container{revert},
+				subContainerCodes: [][]byte{revert.MarshalBinary()},
+			},
+			ErrUnreferencedSubcontainer,
+		},
+		{ // RETURNCONTRACT in runtime code
+			returnContract,
+			ErrIncompatibleContainerKind,
+		},
+	} {
+		err := ValidateEOF(test.container.MarshalBinary(), RuntimeContainer)
+		if test.err == nil {
+			require.NoError(t, err, "test %d", i)
+		} else {
+			require.True(t, errors.Is(err, test.err), "test %d: %v", i, err)
+		}
+	}
+}
+
+func TestEOFWithAuxData(t *testing.T) {
+	c := &Container{
+		types:        []*functionMetadata{{outputs: eofNonReturningFunction}},
+		codeSections: [][]byte{{byte(INVALID)}},
+		data:         []byte{0x01},
+		dataSize:     3,
+	}
+	_, err := c.withAuxData([]byte{0x02})
+	require.ErrorIs(t, err, ErrTruncatedData)
+
+	deployed, err := c.withAuxData([]byte{0x02, 0x03, 0x04})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01}, c.data)
+	require.NoError(t, ValidateEOF(deployed.MarshalBinary(), RuntimeContainer))
+	require.Equal(t, []byte{0x01, 0x02, 0x03, 0x04}, deployed.data)
+	require.Equal(t, 4, deployed.dataSize)
+}
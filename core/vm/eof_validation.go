@@ -0,0 +1,348 @@
+package vm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ledgerwatch/erigon/params"
+)
+
+// EOF code validation errors
+var (
+	ErrUndefinedInstruction        = errors.New("undefined instruction")
+	ErrTruncatedImmediate          = errors.New("truncated immediate")
+	ErrInvalidSectionArgument      = errors.New("invalid section argument")
+	ErrInvalidCallArgument         = errors.New("callf into non-returning section")
+	ErrInvalidDataloadNArgument    = errors.New("invalid dataloadN argument")
+	ErrInvalidJumpDest             = errors.New("invalid jump destination")
+	ErrInvalidBackwardJump         = errors.New("invalid backward jump")
+	ErrInvalidOutputs              = errors.New("invalid number of outputs")
+	ErrInvalidMaxStackHeight       = errors.New("invalid max stack height")
+	ErrInvalidCodeTermination      = errors.New("invalid code termination")
+	ErrEOFStackUnderflow           = errors.New("stack underflow")
+	ErrEOFStackOverflow            = errors.New("stack overflow")
+	ErrUnreachableCode             = errors.New("unreachable code")
+	ErrUnreachableCodeSection      = errors.New("unreachable code section")
+	ErrInvalidNonReturning         = errors.New("section returning status does not match its code")
+	ErrInvalidContainerArgument    = errors.New("invalid container section argument")
+	ErrIncompatibleContainerKind   = errors.New("incompatible container kind")
+	ErrUnreferencedSubcontainer    = errors.New("unreferenced subcontainer")
+	ErrEOFCreateTruncatedContainer = errors.New("eofcreate with truncated container")
+)
+
+// ContainerKind is the way a container is going to be executed, it restricts the instructions
+// which may terminate its execution
+type ContainerKind int
+
+const (
+	// RuntimeContainer is deployed code, it may not use RETURNCONTRACT
+	RuntimeContainer ContainerKind = iota
+	// InitcodeContainer is run by EOFCREATE or a creation transaction, it may not use STOP or RETURN
+	InitcodeContainer
+)
+
+// Subcontainer references, the kind of a subcontainer follows from the instructions referencing it
+const (
+	refNone             = 0
+	refByEOFCreate      = 1
+	refByReturnContract = 2
+)
+
+// ValidateCode validates a top-level container and all of its subcontainers
+func (c *Container) ValidateCode(kind ContainerKind) error {
+	if c.IsTruncated() {
+		return ErrTruncatedData
+	}
+	return c.validate(&eofInstructionSet, kind)
+}
+
+// ValidateEOF parses and validates the code of a top-level EOF container
+func ValidateEOF(code []byte, kind ContainerKind) error {
+	var c Container
+	if err := c.UnmarshalBinary(code); err != nil {
+		return err
+	}
+	return c.ValidateCode(kind)
+}
+
+func (c *Container) validate(jt *JumpTable, kind ContainerKind) error {
+	var (
+		visited = make([]bool, len(c.codeSections))
+		queue   = []int{0}
+		refs    = make([]int, len(c.subContainers))
+	)
+	visited[0] = true
+	for len(queue) > 0 {
+		section := queue[0]
+		queue = queue[1:]
+		targets, err := c.validateSection(jt, section, kind, refs)
+		if err != nil {
+			return fmt.Errorf("code section %d: %w", section, err)
+		}
+		for _, target := range targets {
+			if !visited[target] {
+				visited[target] = true
+				queue = append(queue, target)
+			}
+		}
+	}
+	for section, ok := range visited {
+		if !ok {
+			return fmt.Errorf("%w: %d", ErrUnreachableCodeSection, section)
+		}
+	}
+	for i, sub := range c.subContainers {
+		var subKind ContainerKind
+		switch refs[i] {
+		case refNone:
+			return fmt.Errorf("%w: %d", ErrUnreferencedSubcontainer, i)
+		case refByEOFCreate:
+			if sub.IsTruncated() {
+				return fmt.Errorf("%w: %d", ErrEOFCreateTruncatedContainer, i)
+			}
+			subKind = InitcodeContainer
+		case refByReturnContract:
+			subKind = RuntimeContainer
+		default:
+			return fmt.Errorf("%w: container section %d is referenced by both EOFCREATE and RETURNCONTRACT", ErrIncompatibleContainerKind, i)
+		}
+		if err := sub.validate(jt, subKind); err != nil {
+			return fmt.Errorf("container section %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// validateSection checks the instructions (EIP-3670, EIP-4200, EIP-4750, EIP-6206, EIP-7480,
+// EIP-663, EIP-7069, EIP-7620) and the stack heights (EIP-5450) of a code section.
+// It returns the code sections called or jumped to and marks the referenced subcontainers.
+func (c *Container) validateSection(jt *JumpTable, section int, kind ContainerKind, refs []int) ([]int, error) {
+	var (
+		code      = c.codeSections[section]
+		meta      = c.types[section]
+		targets   []int
+		immediate = make([]bool, len(code)) // immediate data of the instruction before
+		returning bool                      // has RETF or JUMPF into a returning section
+	)
+	for pos := 0; pos < len(code); {
+		op := OpCode(code[pos])
+		// INVALID is the designated invalid instruction, it stays allowed in EOF code
+		if jt[op].undefined && op != INVALID {
+			return nil, fmt.Errorf("%w: %v at %d", ErrUndefinedInstruction, op, pos)
+		}
+		size := immediateSize(code, pos)
+		if size > 0 && pos+size >= len(code) {
+			return nil, fmt.Errorf("%w: %v at %d", ErrTruncatedImmediate, op, pos)
+		}
+		switch op {
+		case CALLF, JUMPF:
+			target := int(binary.BigEndian.Uint16(code[pos+1:]))
+			if target >= len(c.types) {
+				return nil, fmt.Errorf("%w: %v to %d at %d", ErrInvalidSectionArgument, op, target, pos)
+			}
+			if op == CALLF && !c.types[target].isReturning() {
+				return nil, fmt.Errorf("%w: %d at %d", ErrInvalidCallArgument, target, pos)
+			}
+			if op == JUMPF && c.types[target].isReturning() {
+				returning = true
+			}
+			targets = append(targets, target)
+		case RETF:
+			returning = true
+		case DATALOADN:
+			offset := int(binary.BigEndian.Uint16(code[pos+1:]))
+			if offset+32 > c.dataSize {
+				return nil, fmt.Errorf("%w: offset %d, data size %d at %d", ErrInvalidDataloadNArgument, offset, c.dataSize, pos)
+			}
+		case EOFCREATE, RETURNCONTRACT:
+			idx := int(code[pos+1])
+			if idx >= len(c.subContainers) {
+				return nil, fmt.Errorf("%w: %v to %d at %d", ErrInvalidContainerArgument, op, idx, pos)
+			}
+			if op == EOFCREATE {
+				refs[idx] |= refByEOFCreate
+			} else {
+				if kind != InitcodeContainer {
+					return nil, fmt.Errorf("%w: RETURNCONTRACT in runtime code at %d", ErrIncompatibleContainerKind, pos)
+				}
+				refs[idx] |= refByReturnContract
+			}
+		case STOP, RETURN:
+			if kind == InitcodeContainer {
+				return nil, fmt.Errorf("%w: %v in initcode at %d", ErrIncompatibleContainerKind, op, pos)
+			}
+		}
+		for i := pos + 1; i <= pos+size; i++ {
+			immediate[i] = true
+		}
+		pos += 1 + size
+	}
+	if returning != meta.isReturning() {
+		return nil, ErrInvalidNonReturning
+	}
+	// Jump destinations have to be known to be instructions before they are checked
+	for pos := 0; pos < len(code); pos += 1 + immediateSize(code, pos) {
+		for _, dest := range relativeJumpTargets(code, pos) {
+			if dest < 0 || dest >= len(code) || immediate[dest] {
+				return nil, fmt.Errorf("%w: %v at %d to %d", ErrInvalidJumpDest, OpCode(code[pos]), pos, dest)
+			}
+		}
+	}
+	if err := c.validateStackHeights(jt, section); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+// stackBounds is the range of stack heights an instruction can be reached with
+type stackBounds struct {
+	min, max int
+}
+
+// validateStackHeights runs the EIP-5450 single pass over the code section: every instruction
+// is reached either by a forward jump or falling through, backward jumps have to agree with
+// the heights already known at their destination.
+func (c *Container) validateStackHeights(jt *JumpTable, section int) error {
+	var (
+		code      = c.codeSections[section]
+		meta      = c.types[section]
+		heights   = make([]*stackBounds, len(code))
+		maxHeight = int(meta.inputs)
+	)
+	heights[0] = &stackBounds{min: int(meta.inputs), max: int(meta.inputs)}
+	visit := func(from, dest int, next stackBounds) error {
+		if dest >= len(code) {
+			return fmt.Errorf("%w: falling off the end after %d", ErrInvalidCodeTermination, from)
+		}
+		cur := heights[dest]
+		switch {
+		case dest <= from:
+			if cur == nil || *cur != next {
+				return fmt.Errorf("%w: from %d to %d", ErrInvalidBackwardJump, from, dest)
+			}
+		case cur == nil:
+			heights[dest] = &next
+		default:
+			cur.min, cur.max = min(cur.min, next.min), max(cur.max, next.max)
+		}
+		return nil
+	}
+	for pos := 0; pos < len(code); {
+		op := OpCode(code[pos])
+		size := immediateSize(code, pos)
+		cur := heights[pos]
+		if cur == nil {
+			return fmt.Errorf("%w: at %d", ErrUnreachableCode, pos)
+		}
+		required, change := jt[op].numPop, jt[op].numPush-jt[op].numPop
+		switch op {
+		case CALLF:
+			target := c.types[binary.BigEndian.Uint16(code[pos+1:])]
+			required, change = int(target.inputs), int(target.outputs)-int(target.inputs)
+			if cur.max+int(target.maxStackHeight)-int(target.inputs) > int(params.StackLimit) {
+				return fmt.Errorf("%w: CALLF at %d", ErrEOFStackOverflow, pos)
+			}
+		case JUMPF:
+			target := c.types[binary.BigEndian.Uint16(code[pos+1:])]
+			if cur.max+int(target.maxStackHeight)-int(target.inputs) > int(params.StackLimit) {
+				return fmt.Errorf("%w: JUMPF at %d", ErrEOFStackOverflow, pos)
+			}
+			if !target.isReturning() {
+				required = int(target.inputs)
+				break
+			}
+			if meta.outputs < target.outputs {
+				return fmt.Errorf("%w: JUMPF at %d", ErrInvalidOutputs, pos)
+			}
+			required = int(meta.outputs) + int(target.inputs) - int(target.outputs)
+			if cur.max > required {
+				return fmt.Errorf("%w: JUMPF at %d with stack height %d, want %d", ErrInvalidOutputs, pos, cur.max, required)
+			}
+		case RETF:
+			required = int(meta.outputs)
+			if cur.max > required {
+				return fmt.Errorf("%w: RETF at %d with stack height %d, want %d", ErrInvalidOutputs, pos, cur.max, required)
+			}
+		case DUPN:
+			required, change = int(code[pos+1])+1, 1
+		case SWAPN:
+			required, change = int(code[pos+1])+2, 0
+		case EXCHANGE:
+			n, m := int(code[pos+1]>>4)+1, int(code[pos+1]&0x0f)+1
+			required, change = n+m+1, 0
+		}
+		if cur.min < required {
+			return fmt.Errorf("%w: %v at %d with stack height %d, want %d", ErrEOFStackUnderflow, op, pos, cur.min, required)
+		}
+		next := stackBounds{min: cur.min + change, max: cur.max + change}
+		maxHeight = max(maxHeight, next.max)
+
+		nextPos := pos + 1 + size
+		if !isTerminating(op) {
+			if err := visit(pos, nextPos, next); err != nil {
+				return err
+			}
+		}
+		for _, dest := range relativeJumpTargets(code, pos) {
+			if err := visit(pos, dest, next); err != nil {
+				return err
+			}
+		}
+		pos = nextPos
+	}
+	if maxHeight > eofMaxStackHeight {
+		return fmt.Errorf("%w: %d", ErrEOFStackOverflow, maxHeight)
+	}
+	if maxHeight != int(meta.maxStackHeight) {
+		return fmt.Errorf("%w: computed %d, declared %d", ErrInvalidMaxStackHeight, maxHeight, meta.maxStackHeight)
+	}
+	return nil
+}
+
+// immediateSize returns the length of the immediate arguments of the instruction at pos
+func immediateSize(code []byte, pos int) int {
+	op := OpCode(code[pos])
+	switch {
+	case op >= PUSH1 && op <= PUSH32:
+		return int(op-PUSH1) + 1
+	}
+	switch op {
+	case RJUMP, RJUMPI, CALLF, JUMPF, DATALOADN:
+		return 2
+	case RJUMPV:
+		if pos+1 >= len(code) {
+			return 1
+		}
+		return 1 + (int(code[pos+1])+1)*2
+	case DUPN, SWAPN, EXCHANGE, EOFCREATE, RETURNCONTRACT:
+		return 1
+	}
+	return 0
+}
+
+// relativeJumpTargets returns the destinations of the RJUMP* instruction at pos
+func relativeJumpTargets(code []byte, pos int) []int {
+	switch OpCode(code[pos]) {
+	case RJUMP, RJUMPI:
+		return []int{pos + 3 + int(int16(binary.BigEndian.Uint16(code[pos+1:])))}
+	case RJUMPV:
+		count := int(code[pos+1]) + 1
+		next := pos + 2 + count*2
+		dests := make([]int, count)
+		for i := range dests {
+			dests[i] = next + int(int16(binary.BigEndian.Uint16(code[pos+2+i*2:])))
+		}
+		return dests
+	}
+	return nil
+}
+
+// isTerminating returns whether the instruction never continues to the next one
+func isTerminating(op OpCode) bool {
+	switch op {
+	case STOP, RETURN, REVERT, INVALID, RETF, JUMPF, RETURNCONTRACT, RJUMP:
+		return true
+	}
+	return false
+}
@@ -25,6 +25,7 @@ import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 
 	"github.com/ledgerwatch/erigon/common/u256"
+	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/vm/evmtypes"
 	"github.com/ledgerwatch/erigon/crypto"
 	"github.com/ledgerwatch/erigon/params"
@@ -172,7 +173,7 @@ func (evm *EVM) call(typ OpCode, caller ContractRef, addr libcommon.Address, inp
 	if depth > int(params.CallCreateDepth) {
 		return nil, gas, ErrDepth
 	}
-	if typ == CALL || typ == CALLCODE {
+	if typ == CALL || typ == CALLCODE || typ == EXTCALL {
 		// Fail if we're trying to transfer more than the available balance
 		if !value.IsZero() && !evm.Context.CanTransfer(evm.intraBlockState, caller.Address(), value) {
 			if !bailout {
@@ -182,18 +183,20 @@ func (evm *EVM) call(typ OpCode, caller ContractRef, addr libcommon.Address, inp
 	}
 	p, isPrecompile := evm.precompile(addr)
 	var code []byte
+	codeAddr := addr
 	if !isPrecompile {
 		code = evm.intraBlockState.GetCode(addr)
+		code, codeAddr = evm.resolveDelegation(addr, code)
 	}
 
 	snapshot := evm.intraBlockState.Snapshot()
 
-	if typ == CALL {
+	if typ == CALL || typ == EXTCALL {
 		if !evm.intraBlockState.Exist(addr) {
 			if !isPrecompile && evm.chainRules.IsSpuriousDragon && value.IsZero() {
 				if evm.config.Debug {
 					v := value
-					if typ == STATICCALL {
+					if typ == STATICCALL || typ == EXTSTATICCALL {
 						v = nil
 					}
 					// Calling a non existing account, don't do anything, but ping the tracer
@@ -210,7 +213,7 @@ func (evm *EVM) call(typ OpCode, caller ContractRef, addr libcommon.Address, inp
 			evm.intraBlockState.CreateAccount(addr, false)
 		}
 		evm.Context.Transfer(evm.intraBlockState, caller.Address(), addr, value, bailout)
-	} else if typ == STATICCALL {
+	} else if typ == STATICCALL || typ == EXTSTATICCALL {
 		// We do an AddBalance of zero here, just in order to trigger a touch.
 		// This doesn't matter on Mainnet, where all empties are gone at the time of Byzantium,
 		// but is the correct thing to do and matters on other networks, in tests, and potential
@@ -219,7 +222,7 @@ func (evm *EVM) call(typ OpCode, caller ContractRef, addr libcommon.Address, inp
 	}
 	if evm.config.Debug {
 		v := value
-		if typ == STATICCALL {
+		if typ == STATICCALL || typ == EXTSTATICCALL {
 			v = nil
 		}
 		if depth == 0 {
@@ -249,21 +252,20 @@ func (evm *EVM) call(typ OpCode, caller ContractRef, addr libcommon.Address, inp
 		addrCopy := addr
 		// Initialise a new contract and set the code that is to be used by the EVM.
 		// The contract is a scoped environment for this execution context only.
-		codeHash := evm.intraBlockState.GetCodeHash(addrCopy)
+		codeHash := evm.intraBlockState.GetCodeHash(codeAddr)
 		var contract *Contract
 		if typ == CALLCODE {
 			contract = NewContract(caller, caller.Address(), value, gas, evm.config.SkipAnalysis)
-		} else if typ == DELEGATECALL {
+		} else if typ == DELEGATECALL || typ == EXTDELEGATECALL {
 			contract = NewContract(caller, caller.Address(), value, gas, evm.config.SkipAnalysis).AsDelegate()
 		} else {
 			contract = NewContract(caller, addrCopy, value, gas, evm.config.SkipAnalysis)
 		}
 		contract.SetCallCode(&addrCopy, codeHash, code)
-		readOnly := false
-		if typ == STATICCALL {
-			readOnly = true
+		if err = evm.parseContainer(contract); err == nil {
+			readOnly := typ == STATICCALL || typ == EXTSTATICCALL
+			ret, err = run(evm, contract, input, readOnly)
 		}
-		ret, err = run(evm, contract, input, readOnly)
 		gas = contract.Gas
 	}
 	// When an error was returned by the EVM or when setting the creation code
@@ -281,6 +283,38 @@ func (evm *EVM) call(typ OpCode, caller ContractRef, addr libcommon.Address, inp
 	return ret, gas, err
 }
 
+// resolveDelegation returns the code to run when addr is called and the address it was loaded from.
+// An account with a delegation designator runs the code of its delegate (EIP-7702),
+// which is never resolved further and behaves as empty code if the delegate is a precompile.
+// Only execution follows the delegation, EXTCODE* still see the designator itself.
+func (evm *EVM) resolveDelegation(addr libcommon.Address, code []byte) ([]byte, libcommon.Address) {
+	if !evm.chainRules.IsPrague {
+		return code, addr
+	}
+	delegate, ok := types.ParseDelegation(code)
+	if !ok {
+		return code, addr
+	}
+	if _, isPrecompile := evm.precompile(delegate); isPrecompile {
+		return nil, delegate
+	}
+	return evm.intraBlockState.GetCode(delegate), delegate
+}
+
+// parseContainer sets the EOF container of a contract about to run deployed code.
+// Deployed EOF code has been validated, so only a corrupted state can fail here.
+func (evm *EVM) parseContainer(contract *Contract) error {
+	if !evm.chainRules.IsOsaka || !HasEOFMagic(contract.Code) {
+		return nil
+	}
+	container := new(Container)
+	if err := container.UnmarshalBinary(contract.Code); err != nil {
+		return err
+	}
+	contract.Container = container
+	return nil
+}
+
 // Call executes the contract associated with the addr with the given input as
 // parameters. It also handles any necessary value transfer required and takes
 // the necessary steps to create accounts and reverses the state in case of an
@@ -318,8 +352,9 @@ func (evm *EVM) StaticCall(caller ContractRef, addr libcommon.Address, input []b
 }
 
 type codeAndHash struct {
-	code []byte
-	hash libcommon.Hash
+	code      []byte
+	hash      libcommon.Hash
+	container *Container // parsed EOF initcode, nil for legacy initcode
 }
 
 func NewCodeAndHash(code []byte) *codeAndHash {
@@ -334,11 +369,12 @@ func (c *codeAndHash) Hash() libcommon.Hash {
 }
 
 func (evm *EVM) OverlayCreate(caller ContractRef, codeAndHash *codeAndHash, gas uint64, value *uint256.Int, address libcommon.Address, typ OpCode, incrementNonce bool) ([]byte, libcommon.Address, uint64, error) {
-	return evm.create(caller, codeAndHash, gas, value, address, typ, incrementNonce)
+	return evm.create(caller, codeAndHash, nil, gas, value, address, typ, incrementNonce)
 }
 
 // create creates a new contract using code as deployment code.
-func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gasRemaining uint64, value *uint256.Int, address libcommon.Address, typ OpCode, incrementNonce bool) ([]byte, libcommon.Address, uint64, error) {
+// EOF initcode takes the input as calldata, legacy initcode has none.
+func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, input []byte, gasRemaining uint64, value *uint256.Int, address libcommon.Address, typ OpCode, incrementNonce bool) ([]byte, libcommon.Address, uint64, error) {
 	var ret []byte
 	var err error
 	var gasConsumption uint64
@@ -376,6 +412,21 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gasRemainin
 		}
 		evm.intraBlockState.SetNonce(caller.Address(), nonce+1)
 	}
+	// A creation transaction with EOF initcode (EIP-7698) carries its calldata after the initcontainer.
+	// Invalid initcode consumes all gas, but the nonce stays incremented.
+	if codeAndHash.container == nil && depth == 0 && evm.chainRules.IsOsaka && HasEOFMagic(codeAndHash.code) {
+		container, calldata, perr := ParseEOFInitcode(codeAndHash.code)
+		if perr == nil {
+			perr = container.ValidateCode(InitcodeContainer)
+		}
+		if perr != nil {
+			err = perr
+			return nil, libcommon.Address{}, 0, err
+		}
+		codeAndHash = NewCodeAndHash(codeAndHash.code[:len(codeAndHash.code)-len(calldata)])
+		codeAndHash.container = container
+		input = calldata
+	}
 	// We add this to the access list _before_ taking a snapshot. Even if the creation fails,
 	// the access-list change should not be rolled back
 	if evm.chainRules.IsBerlin {
@@ -399,12 +450,13 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gasRemainin
 	// The contract is a scoped environment for this execution context only.
 	contract := NewContract(caller, address, value, gasRemaining, evm.config.SkipAnalysis)
 	contract.SetCodeOptionalHash(&address, codeAndHash)
+	contract.Container = codeAndHash.container
 
 	if evm.config.NoRecursion && depth > 0 {
 		return nil, address, gasRemaining, nil
 	}
 
-	ret, err = run(evm, contract, nil, false)
+	ret, err = run(evm, contract, input, false)
 
 	// EIP-170: Contract code size limit
 	if err == nil && evm.chainRules.IsSpuriousDragon && len(ret) > params.MaxCodeSize {
@@ -415,8 +467,9 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gasRemainin
 		}
 	}
 
-	// Reject code starting with 0xEF if EIP-3541 is enabled.
-	if err == nil && evm.chainRules.IsLondon && len(ret) >= 1 && ret[0] == 0xEF {
+	// Reject code starting with 0xEF if EIP-3541 is enabled. EOF initcode can only
+	// deploy one of its validated subcontainers.
+	if err == nil && codeAndHash.container == nil && evm.chainRules.IsLondon && len(ret) >= 1 && ret[0] == 0xEF {
 		err = ErrInvalidCode
 	}
 	// if the contract creation ran successfully and no errors were returned
@@ -452,7 +505,7 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gasRemainin
 // DESCRIBED: docs/programmers_guide/guide.md#nonce
 func (evm *EVM) Create(caller ContractRef, code []byte, gasRemaining uint64, endowment *uint256.Int) (ret []byte, contractAddr libcommon.Address, leftOverGas uint64, err error) {
 	contractAddr = crypto.CreateAddress(caller.Address(), evm.intraBlockState.GetNonce(caller.Address()))
-	return evm.create(caller, &codeAndHash{code: code}, gasRemaining, endowment, contractAddr, CREATE, true /* incrementNonce */)
+	return evm.create(caller, &codeAndHash{code: code}, nil, gasRemaining, endowment, contractAddr, CREATE, true /* incrementNonce */)
 }
 
 // Create2 creates a new contract using code as deployment code.
@@ -463,13 +516,21 @@ func (evm *EVM) Create(caller ContractRef, code []byte, gasRemaining uint64, end
 func (evm *EVM) Create2(caller ContractRef, code []byte, gasRemaining uint64, endowment *uint256.Int, salt *uint256.Int) (ret []byte, contractAddr libcommon.Address, leftOverGas uint64, err error) {
 	codeAndHash := &codeAndHash{code: code}
 	contractAddr = crypto.CreateAddress2(caller.Address(), salt.Bytes32(), codeAndHash.Hash().Bytes())
-	return evm.create(caller, codeAndHash, gasRemaining, endowment, contractAddr, CREATE2, true /* incrementNonce */)
+	return evm.create(caller, codeAndHash, nil, gasRemaining, endowment, contractAddr, CREATE2, true /* incrementNonce */)
+}
+
+// EOFCreate creates a new contract running one of the initcontainers of the caller (EIP-7620).
+// The address is derived like the one of Create2, from the hash of the initcontainer.
+func (evm *EVM) EOFCreate(caller ContractRef, initContainer *Container, initCode []byte, input []byte, gasRemaining uint64, endowment *uint256.Int, salt *uint256.Int) (ret []byte, contractAddr libcommon.Address, leftOverGas uint64, err error) {
+	codeAndHash := &codeAndHash{code: initCode, container: initContainer}
+	contractAddr = crypto.CreateAddress2(caller.Address(), salt.Bytes32(), codeAndHash.Hash().Bytes())
+	return evm.create(caller, codeAndHash, input, gasRemaining, endowment, contractAddr, EOFCREATE, true /* incrementNonce */)
 }
 
 // SysCreate is a special (system) contract creation methods for genesis constructors.
 // Unlike the normal Create & Create2, it doesn't increment caller's nonce.
 func (evm *EVM) SysCreate(caller ContractRef, code []byte, gas uint64, endowment *uint256.Int, contractAddr libcommon.Address) (ret []byte, leftOverGas uint64, err error) {
-	ret, _, leftOverGas, err = evm.create(caller, &codeAndHash{code: code}, gas, endowment, contractAddr, CREATE, false /* incrementNonce */)
+	ret, _, leftOverGas, err = evm.create(caller, &codeAndHash{code: code}, nil, gas, endowment, contractAddr, CREATE, false /* incrementNonce */)
 	return
 }
 
@@ -93,6 +93,7 @@ type keccakState interface {
 type EVMInterpreter struct {
 	*VM
 	jt    *JumpTable // EVM instruction table
+	eofJt *JumpTable // EVM instruction table of EOF code, nil before EOF is activated
 	depth int
 }
 
@@ -123,8 +124,10 @@ func copyJumpTable(jt *JumpTable) *JumpTable {
 
 // NewEVMInterpreter returns a new instance of the Interpreter.
 func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
-	var jt *JumpTable
+	var jt, eofJt *JumpTable
 	switch {
+	case evm.ChainRules().IsOsaka:
+		jt, eofJt = &osakaInstructionSet, &eofInstructionSet
 	case evm.ChainRules().IsPrague:
 		jt = &pragueInstructionSet
 	case evm.ChainRules().IsCancun:
@@ -168,7 +171,8 @@ func NewEVMInterpreter(evm *EVM, cfg Config) *EVMInterpreter {
 			evm: evm,
 			cfg: cfg,
 		},
-		jt: jt,
+		jt:    jt,
+		eofJt: eofJt,
 	}
 }
 
@@ -190,6 +194,12 @@ func (in *EVMInterpreter) Run(contract *Contract, input []byte, readOnly bool) (
 	// as every returning call will return new data anyway.
 	in.returnData = nil
 
+	jt := in.jt
+	if contract.Container != nil {
+		jt = in.eofJt
+		contract.enterSection(0)
+	}
+
 	var (
 		op          OpCode // current opcode
 		mem         = pool.Get().(*Memory)
@@ -259,7 +269,7 @@ func (in *EVMInterpreter) Run(contract *Contract, input []byte, readOnly bool) (
 		// Get the operation from the jump table and validate the stack to ensure there are
 		// enough stack items available to perform the operation.
 		op = contract.GetOp(_pc)
-		operation := in.jt[op]
+		operation := jt[op]
 		cost = operation.constantGas // For tracing
 		// Validate stack
 		if sLen := locStack.Len(); sLen < operation.numPop {
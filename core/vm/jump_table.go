@@ -48,6 +48,8 @@ type operation struct {
 	opNum   int // only for push, swap, dup
 	// memorySize returns the memory size required for the operation
 	memorySize memorySizeFunc
+	// undefined is set for the opcodes which are not instructions of the fork, EOF validation rejects them
+	undefined bool
 }
 
 var (
@@ -64,8 +66,15 @@ var (
 	napoliInstructionSet           = newNapoliInstructionSet()
 	cancunInstructionSet           = newCancunInstructionSet()
 	pragueInstructionSet           = newPragueInstructionSet()
+	osakaInstructionSet            = newOsakaInstructionSet()
+	// eofInstructionSet is set up in init: EOF creation transactions validate their initcode with it
+	eofInstructionSet JumpTable
 )
 
+func init() {
+	eofInstructionSet = newEOFInstructionSet()
+}
+
 // JumpTable contains the EVM opcodes supported at a given fork.
 type JumpTable [256]*operation
 
@@ -87,12 +96,32 @@ func validateAndFillMaxStack(jt *JumpTable) {
 	}
 }
 
+// newEOFInstructionSet returns the instructions of EOF code (EIP-3540), which exist
+// alongside the legacy instructions from osaka on.
+func newEOFInstructionSet() JumpTable {
+	instructionSet := newOsakaInstructionSet()
+	enableEOF(&instructionSet)
+	validateAndFillMaxStack(&instructionSet)
+	return instructionSet
+}
+
+// newOsakaInstructionSet returns the frontier, homestead, byzantium,
+// constantinople, istanbul, petersburg, berlin, london, paris, shanghai,
+// cancun, prague and osaka instructions of legacy code.
+func newOsakaInstructionSet() JumpTable {
+	instructionSet := newPragueInstructionSet()
+	enableLegacyEOFAccess(&instructionSet)
+	validateAndFillMaxStack(&instructionSet)
+	return instructionSet
+}
+
 // newPragueInstructionSet returns the frontier, homestead, byzantium,
 // constantinople, istanbul, petersburg, berlin, london, paris, shanghai,
 // cancun, and prague instructions.
 func newPragueInstructionSet() JumpTable {
 	instructionSet := newCancunInstructionSet()
 	enable2935(&instructionSet)
+	enable7702(&instructionSet)
 	validateAndFillMaxStack(&instructionSet)
 	return instructionSet
 }
@@ -1212,7 +1241,7 @@ func newFrontierInstructionSet() JumpTable {
 	// Fill all unassigned slots with opUndefined.
 	for i, entry := range tbl {
 		if entry == nil {
-			tbl[i] = &operation{execute: opUndefined}
+			tbl[i] = &operation{execute: opUndefined, undefined: true}
 		}
 	}
 
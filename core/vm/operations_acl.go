@@ -23,6 +23,7 @@ import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/math"
 
+	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/vm/stack"
 	"github.com/ledgerwatch/erigon/params"
 )
@@ -185,7 +186,57 @@ func makeCallVariantGasCallEIP2929(oldCalculator gasFunc) gasFunc {
 	}
 }
 
+// makeCallVariantGasCallEIP7702 extends makeCallVariantGasCallEIP2929 with the access cost
+// of the delegate when the called account has a delegation designator (EIP-7702)
+func makeCallVariantGasCallEIP7702(oldCalculator gasFunc) gasFunc {
+	return func(evm *EVM, contract *Contract, stack *stack.Stack, mem *Memory, memorySize uint64) (uint64, error) {
+		addr := libcommon.Address(stack.Back(1).Bytes20())
+		// The WarmStorageReadCostEIP2929 (100) is already deducted in the form of a constant cost, so
+		// the cost to charge for cold access, if any, is Cold - Warm
+		var prepaid uint64
+		if evm.IntraBlockState().AddAddressToAccessList(addr) {
+			coldCost := params.ColdAccountAccessCostEIP2929 - params.WarmStorageReadCostEIP2929
+			if !contract.UseGas(coldCost) {
+				return 0, ErrOutOfGas
+			}
+			prepaid += coldCost
+		}
+		// Resolving the delegation costs a full warm or cold access of the delegate
+		if delegate, ok := types.ParseDelegation(evm.IntraBlockState().GetCode(addr)); ok {
+			delegateCost := params.WarmStorageReadCostEIP2929
+			if evm.IntraBlockState().AddAddressToAccessList(delegate) {
+				delegateCost = params.ColdAccountAccessCostEIP2929
+			}
+			if !contract.UseGas(delegateCost) {
+				return 0, ErrOutOfGas
+			}
+			prepaid += delegateCost
+		}
+		// Now call the old calculator, which takes into account
+		// - create new account
+		// - transfer value
+		// - memory expansion
+		// - 63/64ths rule
+		gas, err := oldCalculator(evm, contract, stack, mem, memorySize)
+		if prepaid == 0 || err != nil {
+			return gas, err
+		}
+		// As in makeCallVariantGasCallEIP2929, give the prepaid gas back and charge it as part of the dynamic gas
+		contract.Gas += prepaid
+		var overflow bool
+		if gas, overflow = math.SafeAdd(gas, prepaid); overflow {
+			return 0, ErrGasUintOverflow
+		}
+		return gas, nil
+	}
+}
+
 var (
+	gasCallEIP7702         = makeCallVariantGasCallEIP7702(gasCall)
+	gasDelegateCallEIP7702 = makeCallVariantGasCallEIP7702(gasDelegateCall)
+	gasStaticCallEIP7702   = makeCallVariantGasCallEIP7702(gasStaticCall)
+	gasCallCodeEIP7702     = makeCallVariantGasCallEIP7702(gasCallCode)
+
 	gasCallEIP2929         = makeCallVariantGasCallEIP2929(gasCall)
 	gasDelegateCallEIP2929 = makeCallVariantGasCallEIP2929(gasDelegateCall)
 	gasStaticCallEIP2929   = makeCallVariantGasCallEIP2929(gasStaticCall)
@@ -19,11 +19,14 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/big"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/holiman/uint256"
+
 	"github.com/ledgerwatch/erigon-lib/chain"
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/kv/memdb"
@@ -136,6 +139,129 @@ func TestCall(t *testing.T) {
 	}
 }
 
+func TestCallDelegated(t *testing.T) {
+	t.Parallel()
+	_, tx := memdb.NewTestTx(t)
+	state := state.New(state.NewDbStateReader(tx))
+	var (
+		delegated = libcommon.HexToAddress("0xcc")
+		delegate  = libcommon.HexToAddress("0xbb")
+		plain     = libcommon.HexToAddress("0xdd")
+	)
+	// returns ADDRESS and EXTCODESIZE(ADDRESS)
+	code := []byte{
+		byte(vm.ADDRESS), byte(vm.PUSH1), 0, byte(vm.MSTORE),
+		byte(vm.ADDRESS), byte(vm.EXTCODESIZE), byte(vm.PUSH1), 32, byte(vm.MSTORE),
+		byte(vm.PUSH1), 64, byte(vm.PUSH1), 0, byte(vm.RETURN),
+	}
+	state.SetCode(delegate, code)
+	state.SetCode(plain, code)
+	state.SetCode(delegated, types.AddressToDelegation(delegate))
+
+	// the code of the delegate runs in the context of the delegated account, which sees its own designator
+	ret, _, err := Call(delegated, nil, &Config{State: state})
+	if err != nil {
+		t.Fatal("didn't expect error", err)
+	}
+	if got := libcommon.BytesToAddress(ret[:32]); got != delegated {
+		t.Errorf("Expected ADDRESS %x, got %x", delegated, got)
+	}
+	if got := new(big.Int).SetBytes(ret[32:64]); got.Cmp(big.NewInt(types.DelegationDesignatorLen)) != 0 {
+		t.Errorf("Expected EXTCODESIZE %d, got %d", types.DelegationDesignatorLen, got)
+	}
+
+	// calling a delegated account also pays for the cold access of its delegate
+	callGas := func(callee libcommon.Address) uint64 {
+		caller := libcommon.HexToAddress("0xaa")
+		state.SetCode(caller, []byte{
+			byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+			byte(vm.PUSH1), callee[19], byte(vm.PUSH2), 0xff, 0xff, byte(vm.CALL), byte(vm.STOP),
+		})
+		_, leftOverGas, err := Call(caller, nil, &Config{State: state, GasLimit: 1_000_000})
+		if err != nil {
+			t.Fatal("didn't expect error", err)
+		}
+		return 1_000_000 - leftOverGas
+	}
+	if plainGas, delegatedGas := callGas(plain), callGas(delegated); delegatedGas != plainGas+params.ColdAccountAccessCostEIP2929 {
+		t.Errorf("Expected delegated call to cost %d, got %d", plainGas+params.ColdAccountAccessCostEIP2929, delegatedGas)
+	}
+}
+
+func TestCallEOF(t *testing.T) {
+	t.Parallel()
+	_, tx := memdb.NewTestTx(t)
+	state := state.New(state.NewDbStateReader(tx))
+	var (
+		callee = libcommon.HexToAddress("0xaa")
+		caller = libcommon.HexToAddress("0xbb")
+		legacy = libcommon.HexToAddress("0xcc")
+	)
+	// section 0 returns CALLF(1) of 2 and 3, section 1 adds its two inputs
+	state.SetCode(callee, common.FromHex("ef0001010008020002000d0002ff000000"+
+		"0080000202010002"+
+		"60026003e300015f5260205ff3"+"01e4"))
+	// returns the return data of EXTCALL(0xaa)
+	state.SetCode(caller, common.FromHex("ef0001010004020001000fff000000"+
+		"00800004"+
+		"5f5f5f60aaf860205f5f3e60205ff3"))
+	// returns EXTCODESIZE(0xaa)
+	state.SetCode(legacy, common.FromHex("60aa3b5f5260205ff3"))
+
+	for _, test := range []struct {
+		address libcommon.Address
+		want    int64
+	}{
+		{callee, 5},
+		{caller, 5},
+		{legacy, 2}, // legacy code sees the EOF magic only
+	} {
+		ret, _, err := Call(test.address, nil, &Config{State: state})
+		if err != nil {
+			t.Fatalf("%x: didn't expect error %v", test.address, err)
+		}
+		if num := new(big.Int).SetBytes(ret); num.Cmp(big.NewInt(test.want)) != 0 {
+			t.Errorf("%x: expected %d, got %d", test.address, test.want, num)
+		}
+	}
+}
+
+func TestDelegateWarmInTransaction(t *testing.T) {
+	t.Parallel()
+	_, tx := memdb.NewTestTx(t)
+	state := state.New(state.NewDbStateReader(tx))
+	var (
+		delegated = libcommon.HexToAddress("0xcc")
+		delegate  = libcommon.HexToAddress("0xbb")
+		plain     = libcommon.HexToAddress("0xdd")
+	)
+	// BALANCE of the delegate
+	code := []byte{byte(vm.PUSH1), delegate[19], byte(vm.BALANCE), byte(vm.STOP)}
+	state.SetCode(delegate, code)
+	state.SetCode(plain, code)
+	state.SetCode(delegated, types.AddressToDelegation(delegate))
+
+	cfg := &Config{State: state, BaseFee: new(uint256.Int), EVMConfig: vm.Config{NoBaseFee: true}}
+	setDefaults(cfg)
+	gasUsed := func(to libcommon.Address) uint64 {
+		msg := types.NewMessage(cfg.Origin, &to, 0, new(uint256.Int), 100_000, new(uint256.Int), nil, nil, nil, nil, false, false, nil)
+		evm := NewEnv(cfg)
+		evm.Context.ExcessBlobGas = new(uint64)
+		result, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(math.MaxUint64), true, false)
+		if err != nil {
+			t.Fatal("didn't expect error", err)
+		}
+		if result.Err != nil {
+			t.Fatal("didn't expect execution error", result.Err)
+		}
+		return result.UsedGas
+	}
+	// the delegate of the transaction destination is already warm when the code accesses it
+	if plainGas, delegatedGas := gasUsed(plain), gasUsed(delegated); delegatedGas != plainGas-(params.ColdAccountAccessCostEIP2929-params.WarmStorageReadCostEIP2929) {
+		t.Errorf("Expected delegated call to cost %d, got %d", plainGas-(params.ColdAccountAccessCostEIP2929-params.WarmStorageReadCostEIP2929), delegatedGas)
+	}
+}
+
 func BenchmarkCall(b *testing.B) {
 	var definition = `[{"constant":true,"inputs":[],"name":"seller","outputs":[{"name":"","type":"address"}],"type":"function"},{"constant":false,"inputs":[],"name":"abort","outputs":[],"type":"function"},{"constant":true,"inputs":[],"name":"value","outputs":[{"name":"","type":"uint256"}],"type":"function"},{"constant":false,"inputs":[],"name":"refund","outputs":[],"type":"function"},{"constant":true,"inputs":[],"name":"buyer","outputs":[{"name":"","type":"address"}],"type":"function"},{"constant":false,"inputs":[],"name":"confirmReceived","outputs":[],"type":"function"},{"constant":true,"inputs":[],"name":"state","outputs":[{"name":"","type":"uint8"}],"type":"function"},{"constant":false,"inputs":[],"name":"confirmPurchase","outputs":[],"type":"function"},{"inputs":[],"type":"constructor"},{"anonymous":false,"inputs":[],"name":"Aborted","type":"event"},{"anonymous":false,"inputs":[],"name":"PurchaseConfirmed","type":"event"},{"anonymous":false,"inputs":[],"name":"ItemReceived","type":"event"},{"anonymous":false,"inputs":[],"name":"Refunded","type":"event"}]`
 
@@ -362,6 +488,7 @@ func TestBlockhash(t *testing.T) {
 	}
 	setDefaults(cfg)
 	cfg.ChainConfig.PragueTime = big.NewInt(1)
+	cfg.ChainConfig.OsakaTime = big.NewInt(1)
 	ret, _, err := Execute(data, input, cfg, header.Number.Uint64())
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
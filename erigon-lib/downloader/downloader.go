@@ -268,7 +268,8 @@ func New(ctx context.Context, cfg *downloadercfg.Cfg, logger log.Logger, verbosi
 			MaxConnsPerHost: 10,
 		}}
 
-	cfg.ClientConfig.WebTransport = requestHandler
+	webseeds := NewWebSeeds(cfg.WebSeedUrls, verbosity, logger)
+	cfg.ClientConfig.WebTransport = webseeds.WebTransport(requestHandler)
 
 	db, c, m, torrentClient, err := openClient(ctx, cfg.Dirs.Downloader, cfg.Dirs.Snap, cfg.ClientConfig)
 	if err != nil {
@@ -307,7 +308,7 @@ func New(ctx context.Context, cfg *downloadercfg.Cfg, logger log.Logger, verbosi
 		torrentClient:       torrentClient,
 		lock:                mutex,
 		stats:               stats,
-		webseeds:            NewWebSeeds(cfg.WebSeedUrls, verbosity, logger),
+		webseeds:            webseeds,
 		logger:              logger,
 		verbosity:           verbosity,
 		torrentFS:           &AtomicTorrentFS{dir: cfg.Dirs.Snap},
@@ -1471,14 +1472,14 @@ func (d *Downloader) getWebDownloadInfo(t *torrent.Torrent) (webDownloadInfo, []
 	return infos[0], seedHashMismatches, nil
 }
 
-func getWebpeerTorrentInfo(ctx context.Context, downloadUrl *url.URL) (*metainfo.MetaInfo, error) {
+func getWebpeerTorrentInfo(ctx context.Context, client *http.Client, downloadUrl *url.URL) (*metainfo.MetaInfo, error) {
 	torrentRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadUrl.String()+".torrent", nil)
 
 	if err != nil {
 		return nil, err
 	}
 
-	torrentResponse, err := http.DefaultClient.Do(torrentRequest)
+	torrentResponse, err := client.Do(torrentRequest)
 
 	if err != nil {
 		return nil, err
@@ -1693,7 +1694,7 @@ func selectDownloadPeer(ctx context.Context, peerUrls []*url.URL, t *torrent.Tor
 
 	case 1:
 		downloadUrl := peerUrls[0].JoinPath(t.Name())
-		peerInfo, err := getWebpeerTorrentInfo(ctx, downloadUrl)
+		peerInfo, err := getWebpeerTorrentInfo(ctx, http.DefaultClient, downloadUrl)
 
 		if err == nil && bytes.Equal(peerInfo.HashInfoBytes().Bytes(), t.InfoHash().Bytes()) {
 			return peerUrls[0].String(), nil
@@ -1703,7 +1704,7 @@ func selectDownloadPeer(ctx context.Context, peerUrls []*url.URL, t *torrent.Tor
 		peerIndex := rand.Intn(len(peerUrls))
 		peerUrl := peerUrls[peerIndex]
 		downloadUrl := peerUrl.JoinPath(t.Name())
-		peerInfo, err := getWebpeerTorrentInfo(ctx, downloadUrl)
+		peerInfo, err := getWebpeerTorrentInfo(ctx, http.DefaultClient, downloadUrl)
 
 		if err == nil && bytes.Equal(peerInfo.HashInfoBytes().Bytes(), t.InfoHash().Bytes()) {
 			return peerUrl.String(), nil
@@ -1713,7 +1714,7 @@ func selectDownloadPeer(ctx context.Context, peerUrls []*url.URL, t *torrent.Tor
 			if i == peerIndex {
 				continue
 			}
-			peerInfo, err := getWebpeerTorrentInfo(ctx, downloadUrl)
+			peerInfo, err := getWebpeerTorrentInfo(ctx, http.DefaultClient, downloadUrl)
 
 			if err == nil && bytes.Equal(peerInfo.HashInfoBytes().Bytes(), t.InfoHash().Bytes()) {
 				return peerUrl.String(), nil
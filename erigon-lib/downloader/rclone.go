@@ -618,6 +618,24 @@ type SnapInfo interface {
 	Type() snaptype.Type
 }
 
+// UploadSession - remote copy of the local snapshots dir: RCloneSession or S3Session
+type UploadSession interface {
+	RemoteFsRoot() string
+	LocalFsRoot() string
+	Label() string
+	Upload(ctx context.Context, files ...string) error
+	Download(ctx context.Context, files ...string) error
+	Cat(ctx context.Context, file string) (io.Reader, error)
+	ReadLocalDir(ctx context.Context) ([]fs.DirEntry, error)
+	ReadRemoteDir(ctx context.Context, refresh bool) ([]fs.DirEntry, error)
+	Stop()
+}
+
+var (
+	_ UploadSession = (*RCloneSession)(nil)
+	_ UploadSession = (*S3Session)(nil)
+)
+
 type fileInfo struct {
 	*rcloneInfo
 }
@@ -0,0 +1,706 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/c2h5oh/datasize"
+	"github.com/ledgerwatch/erigon-lib/common/dir"
+	"github.com/ledgerwatch/erigon-lib/downloader/snaptype"
+	"github.com/spaolacci/murmur3"
+	"golang.org/x/sync/errgroup"
+)
+
+// S3Config - location of snapshots in a bucket of an S3-compatible object store (AWS, R2, MinIO, ...)
+type S3Config struct {
+	Endpoint  *url.URL // scheme and host of the S3 API
+	Region    string
+	Bucket    string
+	Prefix    string // "directory" of the files inside the bucket, without leading and trailing '/'
+	PathStyle bool   // bucket in the path: endpoint/bucket/key, otherwise in the host: bucket.endpoint/key
+
+	// requests are anonymous if AccessKey is empty
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+}
+
+// ParseS3URL - parses s3://bucket/prefix?endpoint=http://localhost:9000&region=auto&path-style=true
+//   - endpoint: default is AWS https://s3.<region>.amazonaws.com, scheme is optional (https)
+//   - region: default is AWS_REGION env variable or us-east-1
+//   - path-style: default is true if endpoint is set (most of S3 stand-ins don't support bucket sub-domains)
+//   - anonymous=true: don't sign requests. Otherwise credentials are taken from AWS_ACCESS_KEY_ID,
+//     AWS_SECRET_ACCESS_KEY, AWS_SESSION_TOKEN env variables, and requests are anonymous if they are not set
+func ParseS3URL(u *url.URL) (*S3Config, error) {
+	if u.Scheme != "s3" {
+		return nil, fmt.Errorf("s3: unsupported url scheme %q", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("s3: bucket is not set: %s", u.Redacted())
+	}
+	q := u.Query()
+	cfg := &S3Config{
+		Bucket: u.Host,
+		Prefix: strings.Trim(u.Path, "/"),
+		Region: q.Get("region"),
+	}
+	if cfg.Region == "" {
+		cfg.Region = os.Getenv("AWS_REGION")
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+
+	if endpoint := q.Get("endpoint"); endpoint != "" {
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "https://" + endpoint
+		}
+		var err error
+		if cfg.Endpoint, err = url.Parse(endpoint); err != nil {
+			return nil, fmt.Errorf("s3: invalid endpoint: %w", err)
+		}
+		cfg.PathStyle = true
+	} else {
+		cfg.Endpoint = &url.URL{Scheme: "https", Host: "s3." + cfg.Region + ".amazonaws.com"}
+	}
+	cfg.Endpoint.Path = strings.TrimSuffix(cfg.Endpoint.Path, "/")
+
+	if pathStyle := q.Get("path-style"); pathStyle != "" {
+		var err error
+		if cfg.PathStyle, err = strconv.ParseBool(pathStyle); err != nil {
+			return nil, fmt.Errorf("s3: invalid path-style: %w", err)
+		}
+	}
+
+	if anonymous, _ := strconv.ParseBool(q.Get("anonymous")); !anonymous {
+		cfg.AccessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+		cfg.SecretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		cfg.SessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+	return cfg, nil
+}
+
+// String - s3://bucket/prefix, without credentials
+func (cfg *S3Config) String() string {
+	return (&url.URL{Scheme: "s3", Host: cfg.Bucket, Path: "/" + cfg.Prefix}).String()
+}
+
+const (
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3TimeFormat      = "20060102T150405Z"
+	s3DateFormat      = "20060102"
+
+	// S3PresignMaxExpiry - max lifetime of a presigned url allowed by SigV4
+	S3PresignMaxExpiry = 7 * 24 * time.Hour
+
+	s3MaxParts = 10_000
+)
+
+// S3Client - minimal client of S3 REST API: enough to serve snapshots as webseeds and upload them.
+// Requests are signed by AWS Signature Version 4 with unsigned payload.
+type S3Client struct {
+	cfg      S3Config
+	client   *http.Client
+	partSize int64 // files bigger than partSize are uploaded by multipart upload
+	now      func() time.Time
+}
+
+func NewS3Client(cfg S3Config, client *http.Client) *S3Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &S3Client{cfg: cfg, client: client, partSize: int64(64 * datasize.MB), now: time.Now}
+}
+
+func (c *S3Client) Config() S3Config { return c.cfg }
+
+func (c *S3Client) Anonymous() bool { return c.cfg.AccessKey == "" }
+
+// bucketURL - url of the bucket root, used for listing
+func (c *S3Client) bucketURL() *url.URL {
+	u := *c.cfg.Endpoint
+	if c.cfg.PathStyle {
+		u.Path += "/" + c.cfg.Bucket
+	} else {
+		u.Host = c.cfg.Bucket + "." + u.Host
+		u.Path += "/"
+	}
+	return &u
+}
+
+// BaseURL - http url of the prefix "directory", file urls are BaseURL().JoinPath(name)
+func (c *S3Client) BaseURL() *url.URL {
+	return c.bucketURL().JoinPath(c.cfg.Prefix)
+}
+
+func (c *S3Client) ObjectURL(name string) *url.URL {
+	return c.BaseURL().JoinPath(name)
+}
+
+// owns - url is inside of the BaseURL of the client, whole path segments are compared: bucket "a" doesn't own "ab"
+func (c *S3Client) owns(u *url.URL) bool {
+	base := c.BaseURL()
+	if u.Scheme != base.Scheme || u.Host != base.Host {
+		return false
+	}
+	dir := strings.TrimSuffix(base.Path, "/")
+	return u.Path == dir || strings.HasPrefix(u.Path, dir+"/")
+}
+
+// Sign - adds SigV4 Authorization header. Signs host, range, content-type, content-md5 and x-amz-* headers,
+// payload is unsigned unless X-Amz-Content-Sha256 is already set. Anonymous clients don't change the request.
+func (c *S3Client) Sign(req *http.Request) {
+	if c.Anonymous() {
+		return
+	}
+	t := c.now().UTC()
+	req.Header.Set("X-Amz-Date", t.Format(s3TimeFormat))
+	if req.Header.Get("X-Amz-Content-Sha256") == "" {
+		req.Header.Set("X-Amz-Content-Sha256", s3UnsignedPayload)
+	}
+	if c.cfg.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", c.cfg.SessionToken)
+	}
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-amz-") || name == "range" || name == "content-type" || name == "content-md5" {
+			for i := range values {
+				values[i] = strings.Join(strings.Fields(values[i]), " ")
+			}
+			headers[name] = strings.Join(values, ",")
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3URIEncode(req.URL.Path, false),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		req.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, c.cfg.AccessKey, c.scope(t), signedHeaders, c.signature(t, canonicalRequest)))
+}
+
+// Presign - url which allows `method` on the file without credentials until `expires`.
+// Anonymous clients return the plain object url.
+func (c *S3Client) Presign(method string, name string, expires time.Duration) (*url.URL, error) {
+	return c.presignURL(method, c.ObjectURL(name), expires)
+}
+
+func (c *S3Client) presignURL(method string, u *url.URL, expires time.Duration) (*url.URL, error) {
+	u = u.JoinPath()
+	if c.Anonymous() {
+		return u, nil
+	}
+	if expires <= 0 || expires > S3PresignMaxExpiry {
+		return nil, fmt.Errorf("s3: presigned url expiry must be in (0, %s]: %s", S3PresignMaxExpiry, expires)
+	}
+	t := c.now().UTC()
+	q := u.Query()
+	q.Set("X-Amz-Algorithm", s3Algorithm)
+	q.Set("X-Amz-Credential", c.cfg.AccessKey+"/"+c.scope(t))
+	q.Set("X-Amz-Date", t.Format(s3TimeFormat))
+	q.Set("X-Amz-Expires", strconv.Itoa(int(expires.Seconds())))
+	q.Set("X-Amz-SignedHeaders", "host")
+	if c.cfg.SessionToken != "" {
+		q.Set("X-Amz-Security-Token", c.cfg.SessionToken)
+	}
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		s3URIEncode(u.Path, false),
+		s3CanonicalQuery(q),
+		"host:" + u.Host + "\n",
+		"host",
+		s3UnsignedPayload,
+	}, "\n")
+
+	u.RawQuery = s3CanonicalQuery(q) + "&X-Amz-Signature=" + c.signature(t, canonicalRequest)
+	return u, nil
+}
+
+func (c *S3Client) scope(t time.Time) string {
+	return t.Format(s3DateFormat) + "/" + c.cfg.Region + "/s3/aws4_request"
+}
+
+func (c *S3Client) signature(t time.Time, canonicalRequest string) string {
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := s3Algorithm + "\n" + t.Format(s3TimeFormat) + "\n" + c.scope(t) + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := s3HMAC([]byte("AWS4"+c.cfg.SecretKey), t.Format(s3DateFormat))
+	key = s3HMAC(key, c.cfg.Region)
+	key = s3HMAC(key, "s3")
+	key = s3HMAC(key, "aws4_request")
+	return hex.EncodeToString(s3HMAC(key, stringToSign))
+}
+
+func s3HMAC(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// s3URIEncode - RFC 3986 encoding required by SigV4: everything except unreserved characters is escaped
+func s3URIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ('A' <= ch && ch <= 'Z') || ('a' <= ch && ch <= 'z') || ('0' <= ch && ch <= '9') ||
+			ch == '-' || ch == '_' || ch == '.' || ch == '~' || (ch == '/' && !encodeSlash) {
+			b.WriteByte(ch)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", ch)
+		}
+	}
+	return b.String()
+}
+
+func s3CanonicalQuery(q url.Values) string {
+	pairs := make([]string, 0, len(q))
+	for k, vs := range q {
+		for _, v := range vs {
+			pairs = append(pairs, s3URIEncode(k, true)+"="+s3URIEncode(v, true))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// S3Error - error response of the S3 API
+type S3Error struct {
+	StatusCode int
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e *S3Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("s3: %s", http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("s3: %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// Is - allows errors.Is(err, fs.ErrNotExist) and errors.Is(err, ErrAccessDenied)
+func (e *S3Error) Is(target error) bool {
+	switch target {
+	case fs.ErrNotExist:
+		return e.StatusCode == http.StatusNotFound
+	case ErrAccessDenied:
+		return e.StatusCode == http.StatusForbidden
+	}
+	return false
+}
+
+func (c *S3Client) do(ctx context.Context, method string, u *url.URL, body io.Reader, size int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.ContentLength = size
+	}
+	c.Sign(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		s3err := &S3Error{StatusCode: resp.StatusCode}
+		if data, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024)); err == nil && len(data) > 0 {
+			_ = xml.Unmarshal(data, s3err)
+		}
+		return nil, fmt.Errorf("%s %s: %w", method, u.Redacted(), s3err)
+	}
+	return resp, nil
+}
+
+// Get - content of the file, caller must close it
+func (c *S3Client) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, http.MethodGet, c.ObjectURL(name), nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (c *S3Client) Head(ctx context.Context, name string) (S3Object, error) {
+	resp, err := c.do(ctx, http.MethodHead, c.ObjectURL(name), nil, 0)
+	if err != nil {
+		return S3Object{}, err
+	}
+	resp.Body.Close()
+	modTime, _ := http.ParseTime(resp.Header.Get("Last-Modified"))
+	return S3Object{Name: name, Size: resp.ContentLength, ModTime: modTime, ETag: resp.Header.Get("Etag")}, nil
+}
+
+// Put - uploads `size` bytes of `r`, by multipart upload if the file is bigger than a part
+func (c *S3Client) Put(ctx context.Context, name string, r io.ReaderAt, size int64) error {
+	if size > c.partSize {
+		return c.putMultipart(ctx, name, r, size)
+	}
+	resp, err := c.do(ctx, http.MethodPut, c.ObjectURL(name), io.NewSectionReader(r, 0, size), size)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+type s3CompletedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+// putMultipart - single PUT is limited by 5Gb, snapshots are bigger
+func (c *S3Client) putMultipart(ctx context.Context, name string, r io.ReaderAt, size int64) (err error) {
+	partSize := max(c.partSize, (size+s3MaxParts-1)/s3MaxParts)
+
+	u := c.ObjectURL(name)
+	u.RawQuery = "uploads="
+	resp, err := c.do(ctx, http.MethodPost, u, nil, 0)
+	if err != nil {
+		return err
+	}
+	var initiated struct {
+		UploadId string `xml:"UploadId"`
+	}
+	err = xml.NewDecoder(resp.Body).Decode(&initiated)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("s3: can't decode multipart upload: %w", err)
+	}
+
+	defer func() {
+		if err != nil { // don't leave the parts stored (and billed) in the bucket
+			u := c.ObjectURL(name)
+			u.RawQuery = url.Values{"uploadId": {initiated.UploadId}}.Encode()
+			if resp, abortErr := c.do(context.Background(), http.MethodDelete, u, nil, 0); abortErr == nil {
+				resp.Body.Close()
+			}
+		}
+	}()
+
+	parts := make([]s3CompletedPart, (size+partSize-1)/partSize)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(4)
+	for i := range parts {
+		i := i
+		g.Go(func() error {
+			offset := int64(i) * partSize
+			n := min(partSize, size-offset)
+			u := c.ObjectURL(name)
+			u.RawQuery = url.Values{"partNumber": {strconv.Itoa(i + 1)}, "uploadId": {initiated.UploadId}}.Encode()
+			resp, err := c.do(gctx, http.MethodPut, u, io.NewSectionReader(r, offset, n), n)
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+			parts[i] = s3CompletedPart{PartNumber: i + 1, ETag: resp.Header.Get("Etag")}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	complete, err := xml.Marshal(struct {
+		XMLName xml.Name          `xml:"CompleteMultipartUpload"`
+		Parts   []s3CompletedPart `xml:"Part"`
+	}{Parts: parts})
+	if err != nil {
+		return err
+	}
+	u = c.ObjectURL(name)
+	u.RawQuery = url.Values{"uploadId": {initiated.UploadId}}.Encode()
+	if resp, err = c.do(ctx, http.MethodPost, u, bytes.NewReader(complete), int64(len(complete))); err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// complete may fail after 200 OK: the error is in the body
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if bytes.Contains(data, []byte("<Error>")) {
+		s3err := &S3Error{StatusCode: resp.StatusCode}
+		_ = xml.Unmarshal(data, s3err)
+		return fmt.Errorf("complete multipart upload %s: %w", name, s3err)
+	}
+	return nil
+}
+
+// S3Object - file of the prefix "directory"
+type S3Object struct {
+	Name    string // relative to the prefix
+	Size    int64
+	ModTime time.Time
+	ETag    string
+}
+
+// List - files of the prefix "directory", without sub-directories
+func (c *S3Client) List(ctx context.Context) ([]S3Object, error) {
+	var prefix string
+	if c.cfg.Prefix != "" {
+		prefix = c.cfg.Prefix + "/"
+	}
+	var objects []S3Object
+	var token string
+	for {
+		u := c.bucketURL()
+		q := url.Values{"list-type": {"2"}, "delimiter": {"/"}, "prefix": {prefix}}
+		if token != "" {
+			q.Set("continuation-token", token)
+		}
+		u.RawQuery = q.Encode()
+
+		resp, err := c.do(ctx, http.MethodGet, u, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			IsTruncated           bool   `xml:"IsTruncated"`
+			NextContinuationToken string `xml:"NextContinuationToken"`
+			Contents              []struct {
+				Key          string    `xml:"Key"`
+				Size         int64     `xml:"Size"`
+				LastModified time.Time `xml:"LastModified"`
+				ETag         string    `xml:"ETag"`
+			} `xml:"Contents"`
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("s3: can't decode list: %w", err)
+		}
+
+		for _, o := range result.Contents {
+			name := strings.TrimPrefix(o.Key, prefix)
+			if name == "" {
+				continue
+			}
+			objects = append(objects, S3Object{Name: name, Size: o.Size, ModTime: o.LastModified, ETag: o.ETag})
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// s3Transport - signs http requests to S3 webseeds of private buckets
+type s3Transport struct {
+	base    http.RoundTripper
+	clients []*S3Client
+}
+
+func (t *s3Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for _, c := range t.clients {
+		if c.owns(req.URL) {
+			req = req.Clone(req.Context())
+			c.Sign(req)
+			break
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
+// S3Session - UploadSession of the local snapshots dir and the bucket prefix. Unlike RCloneSession
+// it doesn't need the external rclone process.
+type S3Session struct {
+	*S3Client
+	sync.Mutex
+	files   map[string]*rcloneInfo
+	localFs string
+}
+
+func (c *S3Client) NewSession(localFs string) *S3Session {
+	return &S3Session{S3Client: c, files: map[string]*rcloneInfo{}, localFs: localFs}
+}
+
+func (c *S3Session) RemoteFsRoot() string {
+	return c.cfg.String()
+}
+
+func (c *S3Session) LocalFsRoot() string {
+	return c.localFs
+}
+
+func (c *S3Session) Label() string {
+	return strconv.FormatUint(murmur3.Sum64([]byte(c.localFs+"<->"+c.RemoteFsRoot())), 36)
+}
+
+func (c *S3Session) Stop() {}
+
+func (c *S3Session) Upload(ctx context.Context, files ...string) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(4)
+	for _, file := range files {
+		file := file
+		g.Go(func() error {
+			f, err := os.Open(filepath.Join(c.localFs, file))
+			if err != nil {
+				return fmt.Errorf("can't upload: %s: %w", file, err)
+			}
+			defer f.Close()
+			localInfo, err := f.Stat()
+			if err != nil {
+				return fmt.Errorf("can't upload: %s: %w", file, err)
+			}
+			if !localInfo.Mode().IsRegular() || localInfo.Size() == 0 {
+				return fmt.Errorf("can't upload: %s: %s", file, "file is not uploadable")
+			}
+			if err := c.Put(gctx, file, f, localInfo.Size()); err != nil {
+				return fmt.Errorf("can't upload: %s: %w", file, err)
+			}
+			c.setInfo(file, remoteInfo{Name: file, Size: uint64(localInfo.Size()), ModTime: time.Now()}, localInfo)
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+func (c *S3Session) Download(ctx context.Context, files ...string) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(4)
+	for _, file := range files {
+		file := file
+		g.Go(func() error {
+			if err := c.download(gctx, file); err != nil {
+				return fmt.Errorf("can't download: %s: %w", file, err)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+func (c *S3Session) download(ctx context.Context, file string) error {
+	body, err := c.Get(ctx, file)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	fPath := filepath.Join(c.localFs, file)
+	tmpPath := fPath + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	size, err := io.Copy(f, body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, fPath); err != nil {
+		return err
+	}
+	localInfo, _ := os.Stat(fPath)
+	c.setInfo(file, remoteInfo{Name: file, Size: uint64(size)}, localInfo)
+	return nil
+}
+
+func (c *S3Session) Cat(ctx context.Context, file string) (io.Reader, error) {
+	body, err := c.Get(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	// callers don't close the reader
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+func (c *S3Session) ReadLocalDir(ctx context.Context) ([]fs.DirEntry, error) {
+	return dir.ReadDir(c.localFs)
+}
+
+func (c *S3Session) ReadRemoteDir(ctx context.Context, refresh bool) ([]fs.DirEntry, error) {
+	c.Lock()
+	fileCount := len(c.files)
+	c.Unlock()
+
+	if fileCount == 0 || refresh {
+		objects, err := c.List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("can't get remote list: %w", err)
+		}
+		for _, o := range objects {
+			localInfo, _ := os.Stat(filepath.Join(c.localFs, o.Name))
+			c.setInfo(o.Name, remoteInfo{Name: o.Name, Size: uint64(o.Size), ModTime: o.ModTime}, localInfo)
+		}
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	var entries = make([]fs.DirEntry, 0, len(c.files))
+	for _, info := range c.files {
+		if info.remoteInfo.Size > 0 {
+			entries = append(entries, &dirEntry{&fileInfo{info}})
+		}
+	}
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return entries, nil
+}
+
+func (c *S3Session) setInfo(file string, remote remoteInfo, localInfo fs.FileInfo) {
+	c.Lock()
+	defer c.Unlock()
+	info, ok := c.files[file]
+	if !ok {
+		info = &rcloneInfo{file: file}
+		c.files[file] = info
+	}
+	info.remoteInfo = remote
+	info.localInfo = localInfo
+	info.snapInfo = nil
+	if snapInfo, isStateFile, ok := snaptype.ParseFileName(c.localFs, file); ok && !isStateFile {
+		info.snapInfo = &snapInfo
+	}
+}
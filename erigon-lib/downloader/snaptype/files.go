@@ -337,3 +337,16 @@ func Hex2InfoHash(in string) (infoHash metainfo.Hash) {
 	copy(infoHash[:], inHex)
 	return infoHash
 }
+
+// OpenedFile - data file (.seg, .kv, .v, .ef) opened by RoSnapshots or Aggregator, with the accessors
+// (.idx, .kvi, .bt, .kvei, .vi, .efi) opened for it
+type OpenedFile struct {
+	Path      string
+	Words     uint64 // amount of words in the file
+	Accessors []OpenedAccessor
+}
+
+type OpenedAccessor struct {
+	Path string
+	Keys uint64 // amount of keys in the index, 0 for accessors which don't track it (existence filters)
+}
@@ -0,0 +1,159 @@
+package snaptype
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ledgerwatch/erigon-lib/kv"
+)
+
+// Selection - describes which part of the snapshots a partial-history node downloads and serves.
+// Zero value selects everything.
+//
+// Headers and bodies are always selected (they are needed to map blocks to txNums),
+// as are latest-state domain files. Types restrict other block types (`transactions`, `beaconblocks`, ...)
+// and state history (`accounts`, `storage`, `code`, `logaddrs`, `tracesto`, ...) to [FromBlock, ToBlock).
+type Selection struct {
+	FromBlock uint64
+	ToBlock   uint64 // exclusive, 0 - up to chain tip
+	Types     []string
+}
+
+// historyNames - state history and inverted indices names, which can be selected besides block types.
+// Registered extra inverted indices too, see kv.RegisterExtraInvertedIdx
+func historyNames() []string {
+	names := []string{
+		kv.FileAccountDomain, kv.FileStorageDomain, kv.FileCodeDomain, kv.FileCommitmentDomain, kv.FileReceiptDomain,
+		kv.FileLogAddressIdx, kv.FileLogTopicsIdx, kv.FileTracesFromIdx, kv.FileTracesToIdx,
+	}
+	for _, cfg := range kv.ExtraInvertedIdxs() {
+		names = append(names, cfg.FilenameBase)
+	}
+	return names
+}
+
+// ParseSelection - parses `--snap.partial.blocks` (`from-to` or `from-`) and `--snap.partial.types` (comma separated) values.
+// If types are empty - all types are restricted to block range. Types must be registered block types or state history names.
+func ParseSelection(blocks, types string) (Selection, error) {
+	var sel Selection
+	if blocks == "" {
+		if types != "" {
+			return sel, fmt.Errorf("snapshot types selection requires block range")
+		}
+		return sel, nil
+	}
+	from, to, ok := strings.Cut(blocks, "-")
+	if !ok {
+		return sel, fmt.Errorf("invalid block range %q, expected `from-to` or `from-`", blocks)
+	}
+	var err error
+	if sel.FromBlock, err = strconv.ParseUint(strings.TrimSpace(from), 10, 64); err != nil {
+		return sel, fmt.Errorf("invalid block range %q: %w", blocks, err)
+	}
+	if to = strings.TrimSpace(to); to != "" {
+		if sel.ToBlock, err = strconv.ParseUint(to, 10, 64); err != nil {
+			return sel, fmt.Errorf("invalid block range %q: %w", blocks, err)
+		}
+		if sel.ToBlock <= sel.FromBlock {
+			return sel, fmt.Errorf("invalid block range %q: empty", blocks)
+		}
+	}
+	history := historyNames()
+	for _, t := range strings.Split(types, ",") {
+		if t = strings.ToLower(strings.TrimSpace(t)); t == "" || slices.Contains(sel.Types, t) {
+			continue
+		}
+		if _, ok := ParseEnum(t); !ok && !slices.Contains(history, t) {
+			return sel, fmt.Errorf("unknown snapshot type %q, expected block types (%s) or state history (%s)", t, strings.Join(blockTypeNames(), ","), strings.Join(history, ","))
+		}
+		sel.Types = append(sel.Types, t)
+	}
+	return sel, nil
+}
+
+// blockTypeNames - names of the registered block types
+func blockTypeNames() []string {
+	names := []string{CaplinEnums.BeaconBlocks.String(), CaplinEnums.BlobSidecars.String()}
+	for name := range namedTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Enabled - false means node downloads and serves all snapshots
+func (s Selection) Enabled() bool { return s.FromBlock > 0 || s.ToBlock > 0 || len(s.Types) > 0 }
+
+// alwaysSelected - files needed by node regardless of selection
+func alwaysSelected(name string) bool {
+	return name == "headers" || name == "bodies"
+}
+
+// HasType - is given snapshot type (block type or state history name) downloaded at all
+func (s Selection) HasType(name string) bool {
+	if !s.Enabled() || alwaysSelected(name) || len(s.Types) == 0 {
+		return true
+	}
+	return slices.Contains(s.Types, name)
+}
+
+// Restricted - is given type limited to selected block range
+func (s Selection) Restricted(name string) bool {
+	return s.Enabled() && !alwaysSelected(name)
+}
+
+// Has - is file of given type covering [from, to) blocks selected
+func (s Selection) Has(name string, from, to uint64) bool {
+	if !s.Restricted(name) {
+		return true
+	}
+	if !s.HasType(name) {
+		return false
+	}
+	return s.Overlaps(from, to)
+}
+
+// Overlaps - does [from, to) intersect with selected block range
+func (s Selection) Overlaps(from, to uint64) bool {
+	return to > s.FromBlock && (s.ToBlock == 0 || from < s.ToBlock)
+}
+
+// Contains - is blockNum inside selected block range
+func (s Selection) Contains(name string, blockNum uint64) bool {
+	return s.Has(name, blockNum, blockNum+1)
+}
+
+func (s Selection) String() string {
+	if !s.Enabled() {
+		return "all"
+	}
+	to := ""
+	if s.ToBlock > 0 {
+		to = strconv.FormatUint(s.ToBlock, 10)
+	}
+	types := "all"
+	if len(s.Types) > 0 {
+		types = strings.Join(s.Types, ",")
+	}
+	return fmt.Sprintf("blocks=%d-%s types=%s", s.FromBlock, to, types)
+}
+
+// ParseStateFileName - parses state file names like `history/v1-accounts.0-32.v`, returns name (`accounts`) and steps range
+func ParseStateFileName(name string) (base string, fromStep, toStep uint64, ok bool) {
+	_, name = filepath.Split(name)
+	subs := stateFileRegex.FindStringSubmatch(name)
+	if len(subs) != 6 {
+		return "", 0, 0, false
+	}
+	var err error
+	if fromStep, err = strconv.ParseUint(subs[3], 10, 64); err != nil {
+		return "", 0, 0, false
+	}
+	if toStep, err = strconv.ParseUint(subs[4], 10, 64); err != nil {
+		return "", 0, 0, false
+	}
+	return subs[2], fromStep, toStep, true
+}
@@ -0,0 +1,72 @@
+package snaptype_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon-lib/downloader/snaptype"
+	"github.com/ledgerwatch/erigon-lib/kv"
+)
+
+// core block types and extra indices are registered by erigon, only names are needed here
+var _ = snaptype.RegisterType(snaptype.MinCoreEnum+2, "transactions", snaptype.Versions{Current: 1, MinSupported: 1}, nil, nil, nil)
+var _ = kv.RegisterExtraInvertedIdx(kv.ExtraInvertedIdxCfg{Name: "TokenTransfersIdx", FilenameBase: "tokentransfers", KeysTable: "TokenTransfersKeys", IndexTable: "TokenTransfersIdx"})
+
+func TestParseSelection(t *testing.T) {
+	require := require.New(t)
+
+	sel, err := snaptype.ParseSelection("", "")
+	require.NoError(err)
+	require.False(sel.Enabled())
+	require.True(sel.Has("transactions", 0, 500_000))
+
+	_, err = snaptype.ParseSelection("", "transactions")
+	require.Error(err)
+	_, err = snaptype.ParseSelection("1000", "")
+	require.Error(err)
+	_, err = snaptype.ParseSelection("2000-1000", "")
+	require.Error(err)
+
+	_, err = snaptype.ParseSelection("1000-", "transactions,acounts")
+	require.ErrorContains(err, `unknown snapshot type "acounts"`)
+	sel, err = snaptype.ParseSelection("1000-", "beaconblocks,logaddrs,tokentransfers")
+	require.NoError(err)
+	require.Equal([]string{"beaconblocks", "logaddrs", "tokentransfers"}, sel.Types)
+
+	sel, err = snaptype.ParseSelection("18000000-", "Transactions, accounts,accounts")
+	require.NoError(err)
+	require.True(sel.Enabled())
+	require.Equal(uint64(18_000_000), sel.FromBlock)
+	require.Equal(uint64(0), sel.ToBlock)
+	require.Equal([]string{"transactions", "accounts"}, sel.Types)
+	require.Equal("blocks=18000000- types=transactions,accounts", sel.String())
+
+	// headers/bodies are always needed
+	require.True(sel.Has("headers", 0, 500_000))
+	require.True(sel.Has("bodies", 0, 500_000))
+	require.False(sel.Has("transactions", 0, 500_000))
+	require.True(sel.Has("transactions", 17_500_000, 18_000_001))
+	require.True(sel.Has("transactions", 19_000_000, 19_500_000))
+	require.False(sel.Has("storage", 19_000_000, 19_500_000))
+	require.False(sel.Contains("transactions", 17_999_999))
+	require.True(sel.Contains("transactions", 18_000_000))
+
+	sel, err = snaptype.ParseSelection("1000-2000", "")
+	require.NoError(err)
+	require.True(sel.HasType("storage"))
+	require.True(sel.Has("storage", 1999, 3000))
+	require.False(sel.Has("storage", 2000, 3000))
+	require.False(sel.Has("storage", 0, 1000))
+}
+
+func TestParseStateFileName(t *testing.T) {
+	name, from, to, ok := snaptype.ParseStateFileName("history/v1-accounts.0-32.v")
+	require.True(t, ok)
+	require.Equal(t, "accounts", name)
+	require.Equal(t, uint64(0), from)
+	require.Equal(t, uint64(32), to)
+
+	_, _, _, ok = snaptype.ParseStateFileName("v1-000000-000500-headers.seg")
+	require.False(t, ok)
+}
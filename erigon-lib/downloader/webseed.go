@@ -44,18 +44,35 @@ type WebSeeds struct {
 
 	torrentFiles *AtomicTorrentFS
 	client       *http.Client
+	s3           []*S3Client // s3:// seeds
 }
 
 func NewWebSeeds(seeds []*url.URL, verbosity log.Lvl, logger log.Logger) *WebSeeds {
 	ws := &WebSeeds{
-		seeds:     seeds,
 		logger:    logger,
 		verbosity: verbosity,
 	}
 
+	for _, seed := range seeds {
+		if seed.Scheme != "s3" {
+			ws.seeds = append(ws.seeds, seed)
+			continue
+		}
+		// bucket is served by its http url, requests to private buckets are signed by the transport
+		cfg, err := ParseS3URL(seed)
+		if err != nil {
+			logger.Warn("[snapshots.webseed] invalid s3 webseed", "url", seed.Redacted(), "err", err)
+			continue
+		}
+		s3 := NewS3Client(*cfg, nil)
+		ws.s3 = append(ws.s3, s3)
+		ws.seeds = append(ws.seeds, s3.BaseURL())
+	}
+
 	rc := retryablehttp.NewClient()
 	rc.RetryMax = 5
 	rc.Logger = logger
+	rc.HTTPClient.Transport = ws.WebTransport(rc.HTTPClient.Transport)
 	ws.client = rc.StandardClient()
 	return ws
 }
@@ -80,7 +97,7 @@ func (d *WebSeeds) getWebDownloadInfo(ctx context.Context, t *torrent.Torrent) (
 					"webseed", webseed.String(), "name", t.Name(), "status", headResponse.Status)
 				continue
 			}
-			if meta, err := getWebpeerTorrentInfo(ctx, downloadUrl); err == nil {
+			if meta, err := getWebpeerTorrentInfo(ctx, d.client, downloadUrl); err == nil {
 				if bytes.Equal(torrentHash, meta.HashInfoBytes().Bytes()) {
 					md5tag := headResponse.Header.Get("Etag")
 					if md5tag != "" {
@@ -421,6 +438,15 @@ func (d *WebSeeds) makeWebSeedUrls(listsOfFiles []snaptype.WebSeedsFromProvider,
 	d.lock.Unlock()
 }
 
+// WebTransport - transport of the torrent client for webseeds: signs requests to private s3:// seeds on every
+// round trip, so their urls never expire (unlike presigned ones)
+func (d *WebSeeds) WebTransport(base http.RoundTripper) http.RoundTripper {
+	if len(d.s3) == 0 {
+		return base
+	}
+	return &s3Transport{base: base, clients: d.s3}
+}
+
 func (d *WebSeeds) TorrentUrls() snaptype.TorrentUrls {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -21,15 +21,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Txpool_Version_FullMethodName      = "/txpool.Txpool/Version"
-	Txpool_FindUnknown_FullMethodName  = "/txpool.Txpool/FindUnknown"
-	Txpool_Add_FullMethodName          = "/txpool.Txpool/Add"
-	Txpool_Transactions_FullMethodName = "/txpool.Txpool/Transactions"
-	Txpool_All_FullMethodName          = "/txpool.Txpool/All"
-	Txpool_Pending_FullMethodName      = "/txpool.Txpool/Pending"
-	Txpool_OnAdd_FullMethodName        = "/txpool.Txpool/OnAdd"
-	Txpool_Status_FullMethodName       = "/txpool.Txpool/Status"
-	Txpool_Nonce_FullMethodName        = "/txpool.Txpool/Nonce"
+	Txpool_Version_FullMethodName                  = "/txpool.Txpool/Version"
+	Txpool_FindUnknown_FullMethodName              = "/txpool.Txpool/FindUnknown"
+	Txpool_Add_FullMethodName                      = "/txpool.Txpool/Add"
+	Txpool_Transactions_FullMethodName             = "/txpool.Txpool/Transactions"
+	Txpool_All_FullMethodName                      = "/txpool.Txpool/All"
+	Txpool_Pending_FullMethodName                  = "/txpool.Txpool/Pending"
+	Txpool_OnAdd_FullMethodName                    = "/txpool.Txpool/OnAdd"
+	Txpool_Status_FullMethodName                   = "/txpool.Txpool/Status"
+	Txpool_Nonce_FullMethodName                    = "/txpool.Txpool/Nonce"
+	Txpool_TransactionStatusHistory_FullMethodName = "/txpool.Txpool/TransactionStatusHistory"
+	Txpool_GetBlobs_FullMethodName                 = "/txpool.Txpool/GetBlobs"
 )
 
 // TxpoolClient is the client API for Txpool service.
@@ -55,6 +57,11 @@ type TxpoolClient interface {
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusReply, error)
 	// returns nonce for given account
 	Nonce(ctx context.Context, in *NonceRequest, opts ...grpc.CallOption) (*NonceReply, error)
+	// returns the recorded pool events of the transaction, requires the pool event journal
+	TransactionStatusHistory(ctx context.Context, in *TransactionStatusHistoryRequest, opts ...grpc.CallOption) (*TransactionStatusHistoryReply, error)
+	// returns the blobs and proofs of the pooled transactions by versioned hash, preserves incoming order and amount,
+	// empty blob and proof for unknown hashes
+	GetBlobs(ctx context.Context, in *GetBlobsRequest, opts ...grpc.CallOption) (*GetBlobsReply, error)
 }
 
 type txpoolClient struct {
@@ -169,6 +176,24 @@ func (c *txpoolClient) Nonce(ctx context.Context, in *NonceRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *txpoolClient) TransactionStatusHistory(ctx context.Context, in *TransactionStatusHistoryRequest, opts ...grpc.CallOption) (*TransactionStatusHistoryReply, error) {
+	out := new(TransactionStatusHistoryReply)
+	err := c.cc.Invoke(ctx, Txpool_TransactionStatusHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txpoolClient) GetBlobs(ctx context.Context, in *GetBlobsRequest, opts ...grpc.CallOption) (*GetBlobsReply, error) {
+	out := new(GetBlobsReply)
+	err := c.cc.Invoke(ctx, Txpool_GetBlobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TxpoolServer is the server API for Txpool service.
 // All implementations must embed UnimplementedTxpoolServer
 // for forward compatibility
@@ -192,6 +217,11 @@ type TxpoolServer interface {
 	Status(context.Context, *StatusRequest) (*StatusReply, error)
 	// returns nonce for given account
 	Nonce(context.Context, *NonceRequest) (*NonceReply, error)
+	// returns the recorded pool events of the transaction, requires the pool event journal
+	TransactionStatusHistory(context.Context, *TransactionStatusHistoryRequest) (*TransactionStatusHistoryReply, error)
+	// returns the blobs and proofs of the pooled transactions by versioned hash, preserves incoming order and amount,
+	// empty blob and proof for unknown hashes
+	GetBlobs(context.Context, *GetBlobsRequest) (*GetBlobsReply, error)
 	mustEmbedUnimplementedTxpoolServer()
 }
 
@@ -226,6 +256,12 @@ func (UnimplementedTxpoolServer) Status(context.Context, *StatusRequest) (*Statu
 func (UnimplementedTxpoolServer) Nonce(context.Context, *NonceRequest) (*NonceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Nonce not implemented")
 }
+func (UnimplementedTxpoolServer) TransactionStatusHistory(context.Context, *TransactionStatusHistoryRequest) (*TransactionStatusHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactionStatusHistory not implemented")
+}
+func (UnimplementedTxpoolServer) GetBlobs(context.Context, *GetBlobsRequest) (*GetBlobsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlobs not implemented")
+}
 func (UnimplementedTxpoolServer) mustEmbedUnimplementedTxpoolServer() {}
 
 // UnsafeTxpoolServer may be embedded to opt out of forward compatibility for this service.
@@ -404,6 +440,42 @@ func _Txpool_Nonce_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Txpool_TransactionStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxpoolServer).TransactionStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Txpool_TransactionStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxpoolServer).TransactionStatusHistory(ctx, req.(*TransactionStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Txpool_GetBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxpoolServer).GetBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Txpool_GetBlobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxpoolServer).GetBlobs(ctx, req.(*GetBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Txpool_ServiceDesc is the grpc.ServiceDesc for Txpool service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -443,6 +515,14 @@ var Txpool_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Nonce",
 			Handler:    _Txpool_Nonce_Handler,
 		},
+		{
+			MethodName: "TransactionStatusHistory",
+			Handler:    _Txpool_TransactionStatusHistory_Handler,
+		},
+		{
+			MethodName: "GetBlobs",
+			Handler:    _Txpool_GetBlobs_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
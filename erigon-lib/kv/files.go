@@ -14,6 +14,7 @@ const (
 	FileStorageDomain    = "storage"
 	FileCodeDomain       = "code"
 	FileCommitmentDomain = "commitment"
+	FileReceiptDomain    = "receipt"
 
 	// Inverted indexes: File<identifier>Idx constant used
 	// as part of the filenames in the /snapshots/idx dir.
@@ -183,17 +183,18 @@ func (m *UnionUno[T]) Close() {
 // Intersected
 type Intersected[T constraints.Ordered] struct {
 	x, y               Uno[T]
+	asc                bool
 	xHasNext, yHasNext bool
 	xNextK, yNextK     T
 	limit              int
 	err                error
 }
 
-func Intersect[T constraints.Ordered](x, y Uno[T], limit int) Uno[T] {
+func Intersect[T constraints.Ordered](x, y Uno[T], asc order.By, limit int) Uno[T] {
 	if x == nil || y == nil || !x.HasNext() || !y.HasNext() {
 		return &Empty[T]{}
 	}
-	m := &Intersected[T]{x: x, y: y, limit: limit}
+	m := &Intersected[T]{x: x, y: y, asc: bool(asc), limit: limit}
 	m.advance()
 	return m
 }
@@ -207,7 +208,7 @@ func (m *Intersected[T]) advance() {
 		if m.err != nil {
 			break
 		}
-		if m.xNextK < m.yNextK {
+		if (m.asc && m.xNextK < m.yNextK) || (!m.asc && m.xNextK > m.yNextK) {
 			m.advanceX()
 			continue
 		} else if m.xNextK == m.yNextK {
@@ -147,28 +147,36 @@ func TestIntersect(t *testing.T) {
 	t.Run("intersect", func(t *testing.T) {
 		s1 := iter.Array[uint64]([]uint64{1, 3, 4, 5, 6, 7})
 		s2 := iter.Array[uint64]([]uint64{2, 3, 7})
-		s3 := iter.Intersect[uint64](s1, s2, -1)
+		s3 := iter.Intersect[uint64](s1, s2, order.Asc, -1)
 		res, err := iter.ToArray[uint64](s3)
 		require.NoError(t, err)
 		require.Equal(t, []uint64{3, 7}, res)
 
 		s1 = iter.Array[uint64]([]uint64{1, 3, 4, 5, 6, 7})
 		s2 = iter.Array[uint64]([]uint64{2, 3, 7})
-		s3 = iter.Intersect[uint64](s1, s2, 1)
+		s3 = iter.Intersect[uint64](s1, s2, order.Asc, 1)
 		res, err = iter.ToArray[uint64](s3)
 		require.NoError(t, err)
 		require.Equal(t, []uint64{3}, res)
 	})
+	t.Run("desc", func(t *testing.T) {
+		s1 := iter.Array[uint64]([]uint64{7, 6, 5, 4, 3, 1})
+		s2 := iter.Array[uint64]([]uint64{8, 7, 3, 2})
+		s3 := iter.Intersect[uint64](s1, s2, order.Desc, -1)
+		res, err := iter.ToArray[uint64](s3)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{7, 3}, res)
+	})
 	t.Run("empty left", func(t *testing.T) {
 		s1 := iter.EmptyU64
 		s2 := iter.Array[uint64]([]uint64{2, 3, 7, 8})
-		s3 := iter.Intersect[uint64](s1, s2, -1)
+		s3 := iter.Intersect[uint64](s1, s2, order.Asc, -1)
 		res, err := iter.ToArray[uint64](s3)
 		require.NoError(t, err)
 		require.Nil(t, res)
 
 		s2 = iter.Array[uint64]([]uint64{2, 3, 7, 8})
-		s3 = iter.Intersect[uint64](nil, s2, -1)
+		s3 = iter.Intersect[uint64](nil, s2, order.Asc, -1)
 		res, err = iter.ToArray[uint64](s3)
 		require.NoError(t, err)
 		require.Nil(t, res)
@@ -176,13 +184,13 @@ func TestIntersect(t *testing.T) {
 	t.Run("empty right", func(t *testing.T) {
 		s1 := iter.Array[uint64]([]uint64{1, 3, 4, 5, 6, 7})
 		s2 := iter.EmptyU64
-		s3 := iter.Intersect[uint64](s1, s2, -1)
+		s3 := iter.Intersect[uint64](s1, s2, order.Asc, -1)
 		res, err := iter.ToArray[uint64](s3)
 		require.NoError(t, err)
 		require.Nil(t, nil, res)
 
 		s1 = iter.Array[uint64]([]uint64{1, 3, 4, 5, 6, 7})
-		s3 = iter.Intersect[uint64](s1, nil, -1)
+		s3 = iter.Intersect[uint64](s1, nil, order.Asc, -1)
 		res, err = iter.ToArray[uint64](s3)
 		require.NoError(t, err)
 		require.Nil(t, res)
@@ -190,12 +198,12 @@ func TestIntersect(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
 		s1 := iter.EmptyU64
 		s2 := iter.EmptyU64
-		s3 := iter.Intersect[uint64](s1, s2, -1)
+		s3 := iter.Intersect[uint64](s1, s2, order.Asc, -1)
 		res, err := iter.ToArray[uint64](s3)
 		require.NoError(t, err)
 		require.Nil(t, res)
 
-		s3 = iter.Intersect[uint64](nil, nil, -1)
+		s3 = iter.Intersect[uint64](nil, nil, order.Asc, -1)
 		res, err = iter.ToArray[uint64](s3)
 		require.NoError(t, err)
 		require.Nil(t, res)
@@ -266,6 +266,13 @@ type RoDB interface {
 	CHandle() unsafe.Pointer
 }
 
+// TryBeginRoDB - optional RoDB capability: BeginRo which doesn't wait for a free slot of read transactions
+// (see `--db.read.concurrency`). Useful for code which already holds a read transaction and wants more:
+// waiting for slots while holding one may deadlock. Returns nil tx if there is no free slot.
+type TryBeginRoDB interface {
+	TryBeginRo(ctx context.Context) (Tx, error)
+}
+
 // RwDB low-level database interface - main target is - to provide common abstraction over top of MDBX and RemoteKV.
 //
 // Common pattern for short-living transactions:
@@ -746,7 +746,24 @@ func (db *MdbxKV) Close() {
 	removeFromPathDbMap(db.path)
 }
 
-func (db *MdbxKV) BeginRo(ctx context.Context) (txn kv.Tx, err error) {
+func (db *MdbxKV) BeginRo(ctx context.Context) (kv.Tx, error) {
+	tx, err := db.beginRo(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// TryBeginRo - returns nil tx if all read transactions slots are taken, see kv.TryBeginRoDB
+func (db *MdbxKV) TryBeginRo(ctx context.Context) (kv.Tx, error) {
+	tx, err := db.beginRo(ctx, false)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (db *MdbxKV) beginRo(ctx context.Context, wait bool) (txn *MdbxTx, err error) {
 	// don't try to acquire if the context is already done
 	select {
 	case <-ctx.Done():
@@ -759,8 +776,12 @@ func (db *MdbxKV) BeginRo(ctx context.Context) (txn kv.Tx, err error) {
 		return nil, fmt.Errorf("db closed")
 	}
 
-	// will return nil err if context is cancelled (may appear to acquire the semaphore)
-	if semErr := db.roTxsLimiter.Acquire(ctx, 1); semErr != nil {
+	if !wait {
+		if !db.roTxsLimiter.TryAcquire(1) {
+			db.trackTxEnd()
+			return nil, nil
+		}
+	} else if semErr := db.roTxsLimiter.Acquire(ctx, 1); semErr != nil { // will return nil err if context is cancelled (may appear to acquire the semaphore)
 		db.trackTxEnd()
 		return nil, fmt.Errorf("mdbx.MdbxKV.BeginRo: roTxsLimiter error %w", semErr)
 	}
@@ -31,6 +31,7 @@ import (
 	"github.com/ledgerwatch/log/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
 )
 
 func BaseCaseDB(t *testing.T) kv.RwDB {
@@ -797,6 +798,27 @@ func TestBeginRoAfterClose(t *testing.T) {
 	require.ErrorContains(t, err, "closed")
 }
 
+func TestTryBeginRo(t *testing.T) {
+	db := NewMDBX(log.New()).InMem(t.TempDir()).RoTxsLimiter(semaphore.NewWeighted(1)).MustOpen()
+	defer db.Close()
+	ctx := context.Background()
+
+	tx, err := db.(kv.TryBeginRoDB).TryBeginRo(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, tx)
+
+	// no free slot: must not wait
+	tx2, err := db.(kv.TryBeginRoDB).TryBeginRo(ctx)
+	require.NoError(t, err)
+	require.Nil(t, tx2)
+
+	tx.Rollback()
+	tx2, err = db.(kv.TryBeginRoDB).TryBeginRo(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, tx2)
+	tx2.Rollback()
+}
+
 func TestBeginRwAfterClose(t *testing.T) {
 	db := NewMDBX(log.New()).InMem(t.TempDir()).MustOpen()
 	db.Close()
@@ -0,0 +1,86 @@
+/*
+   Copyright 2024 Erigon contributors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package rawdbv3
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ledgerwatch/erigon-lib/kv"
+)
+
+// ReceiptKey - the only key of kv.ReceiptDomain. Every transaction of a block overwrites its value by the
+// cumulative counters of the block and its own status and logs, the system transaction at the beginning of the
+// block resets the counters. So the history of the key gives the whole receipt of a transaction without the
+// re-execution of the block.
+var ReceiptKey = []byte{0}
+
+// ReceiptValue - the value of ReceiptKey after a transaction
+type ReceiptValue struct {
+	CumulativeGasUsed     uint64
+	CumulativeBlobGasUsed uint64
+	LogIndex              uint64 // amount of logs emitted in the block, it's the index of the next log
+	Failed                bool
+	Logs                  []byte // RLP list of the logs of the transaction, nil if the value has only the counters
+}
+
+func (r ReceiptValue) Encode() []byte {
+	v := make([]byte, 0, 3*binary.MaxVarintLen64+1+len(r.Logs))
+	v = binary.AppendUvarint(v, r.CumulativeGasUsed)
+	v = binary.AppendUvarint(v, r.CumulativeBlobGasUsed)
+	v = binary.AppendUvarint(v, r.LogIndex)
+	if r.Logs == nil {
+		return v
+	}
+	if r.Failed {
+		v = append(v, 1)
+	} else {
+		v = append(v, 0)
+	}
+	return append(v, r.Logs...)
+}
+
+func DecodeReceiptValue(v []byte) (r ReceiptValue, err error) {
+	for _, field := range []*uint64{&r.CumulativeGasUsed, &r.CumulativeBlobGasUsed, &r.LogIndex} {
+		n := 0
+		if *field, n = binary.Uvarint(v); n <= 0 {
+			return r, fmt.Errorf("can't decode receipt value: %x", v)
+		}
+		v = v[n:]
+	}
+	// values written before the status and logs were stored
+	if len(v) == 0 {
+		return r, nil
+	}
+	r.Failed = v[0] == 1
+	r.Logs = v[1:]
+	return r, nil
+}
+
+// ReceiptValueAfter - value of the block's receipt key after the execution of txNum. ok is false if the receipt
+// domain has no data for txNum: the transaction wasn't executed by the node since the domain was introduced.
+func ReceiptValueAfter(tx kv.TemporalTx, txNum uint64) (r ReceiptValue, ok bool, err error) {
+	v, ok, err := tx.DomainGetAsOf(kv.ReceiptDomain, ReceiptKey, nil, txNum+1)
+	if err != nil || !ok || len(v) == 0 {
+		return r, false, err
+	}
+	r, err = DecodeReceiptValue(v)
+	if err != nil {
+		return r, false, err
+	}
+	return r, true, nil
+}
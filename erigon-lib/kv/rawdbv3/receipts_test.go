@@ -0,0 +1,43 @@
+/*
+   Copyright 2024 Erigon contributors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package rawdbv3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiptValue(t *testing.T) {
+	for _, r := range []ReceiptValue{
+		{},
+		{CumulativeGasUsed: 21_000, LogIndex: 2, Logs: []byte{0xc0}},
+		{CumulativeGasUsed: 1 << 40, CumulativeBlobGasUsed: 131072, LogIndex: 7, Failed: true, Logs: []byte{0xc1, 0x80}},
+	} {
+		decoded, err := DecodeReceiptValue(r.Encode())
+		require.NoError(t, err)
+		require.Equal(t, r, decoded)
+	}
+
+	// a value of the system transaction has only the counters
+	decoded, err := DecodeReceiptValue(ReceiptValue{CumulativeGasUsed: 5, Failed: true}.Encode())
+	require.NoError(t, err)
+	require.Equal(t, ReceiptValue{CumulativeGasUsed: 5}, decoded)
+
+	_, err = DecodeReceiptValue([]byte{0x80})
+	require.Error(t, err)
+}
@@ -18,6 +18,7 @@ package kv
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -249,6 +250,7 @@ const (
 	//   Same about: TxNum/TxID, BlockNum/BlockID
 	HeaderNumber    = "HeaderNumber"           // header_hash -> header_num_u64
 	BadHeaderNumber = "BadHeaderNumber"        // header_hash -> header_num_u64
+	BadBlocks       = "BadBlocks"              // write_seq_u64 + block_num_u64 + hash -> block (RLP), blocks rejected by validation
 	HeaderCanonical = "CanonicalHeader"        // block_num_u64 -> header hash
 	Headers         = "Header"                 // block_num_u64 + hash -> header (RLP)
 	HeaderTD        = "HeadersTotalDifficulty" // block_num_u64 + hash -> td (RLP)
@@ -399,11 +401,11 @@ const (
 	TblCommitmentHistoryVals = "CommitmentHistoryVals"
 	TblCommitmentIdx         = "CommitmentIdx"
 
-	//TblGasUsedKeys        = "GasUsedKeys"
-	//TblGasUsedVals        = "GasUsedVals"
-	//TblGasUsedHistoryKeys = "GasUsedHistoryKeys"
-	//TblGasUsedHistoryVals = "GasUsedHistoryVals"
-	//TblGasUsedIdx         = "GasUsedIdx"
+	TblReceiptKeys        = "ReceiptKeys"
+	TblReceiptVals        = "ReceiptVals"
+	TblReceiptHistoryKeys = "ReceiptHistoryKeys"
+	TblReceiptHistoryVals = "ReceiptHistoryVals"
+	TblReceiptIdx         = "ReceiptIdx"
 
 	TblLogAddressKeys = "LogAddressKeys"
 	TblLogAddressIdx  = "LogAddressIdx"
@@ -560,6 +562,7 @@ var ChaindataTables = []string{
 	ContractCode,
 	HeaderNumber,
 	BadHeaderNumber,
+	BadBlocks,
 	BlockBody,
 	Receipts,
 	TxLookup,
@@ -641,11 +644,11 @@ var ChaindataTables = []string{
 	TblCommitmentHistoryVals,
 	TblCommitmentIdx,
 
-	//TblGasUsedKeys,
-	//TblGasUsedVals,
-	//TblGasUsedHistoryKeys,
-	//TblGasUsedHistoryVals,
-	//TblGasUsedIdx,
+	TblReceiptKeys,
+	TblReceiptVals,
+	TblReceiptHistoryKeys,
+	TblReceiptHistoryVals,
+	TblReceiptIdx,
 
 	TblLogAddressKeys,
 	TblLogAddressIdx,
@@ -723,12 +726,18 @@ const (
 	RecentLocalTransaction = "RecentLocalTransaction" // sequence_u64 -> tx_hash
 	PoolTransaction        = "PoolTransaction"        // txHash -> sender+tx_rlp
 	PoolInfo               = "PoolInfo"               // option_key -> option_value
+	PoolArrival            = "PoolArrival"            // txHash -> arrival_u64, order of addition of the PoolTransaction entries
+	PoolEvent              = "PoolEvent"              // sequence_u64 -> tx_hash + event, journal of the pool events
+	PoolEventByHash        = "PoolEventByHash"        // tx_hash + sequence_u64 -> empty
 )
 
 var TxPoolTables = []string{
 	RecentLocalTransaction,
 	PoolTransaction,
 	PoolInfo,
+	PoolArrival,
+	PoolEvent,
+	PoolEventByHash,
 }
 var SentryTables = []string{}
 var DownloaderTables = []string{
@@ -816,19 +825,18 @@ var ChaindataTablesCfg = TableCfg{
 	TblCommitmentHistoryKeys: {Flags: DupSort},
 	TblCommitmentHistoryVals: {Flags: DupSort},
 	TblCommitmentIdx:         {Flags: DupSort},
-	//TblGasUsedKeys:           {Flags: DupSort},
-	//TblGasUsedHistoryKeys:    {Flags: DupSort},
-	//TblGasUsedHistoryVals:    {Flags: DupSort},
-	//TblGasUsedIdx:            {Flags: DupSort},
-	TblLogAddressKeys:  {Flags: DupSort},
-	TblLogAddressIdx:   {Flags: DupSort},
-	TblLogTopicsKeys:   {Flags: DupSort},
-	TblLogTopicsIdx:    {Flags: DupSort},
-	TblTracesFromKeys:  {Flags: DupSort},
-	TblTracesFromIdx:   {Flags: DupSort},
-	TblTracesToKeys:    {Flags: DupSort},
-	TblTracesToIdx:     {Flags: DupSort},
-	TblPruningProgress: {Flags: DupSort},
+	TblReceiptKeys:           {Flags: DupSort},
+	TblReceiptHistoryKeys:    {Flags: DupSort},
+	TblReceiptIdx:            {Flags: DupSort},
+	TblLogAddressKeys:        {Flags: DupSort},
+	TblLogAddressIdx:         {Flags: DupSort},
+	TblLogTopicsKeys:         {Flags: DupSort},
+	TblLogTopicsIdx:          {Flags: DupSort},
+	TblTracesFromKeys:        {Flags: DupSort},
+	TblTracesFromIdx:         {Flags: DupSort},
+	TblTracesToKeys:          {Flags: DupSort},
+	TblTracesToIdx:           {Flags: DupSort},
+	TblPruningProgress:       {Flags: DupSort},
 
 	RAccountKeys: {Flags: DupSort},
 	RAccountIdx:  {Flags: DupSort},
@@ -940,9 +948,9 @@ const (
 	StorageDomain    Domain = 1
 	CodeDomain       Domain = 2
 	CommitmentDomain Domain = 3
-	//GasUsedDomain    Domain = 4
+	ReceiptDomain    Domain = 4
 
-	DomainLen Domain = 4
+	DomainLen Domain = 5
 )
 
 const (
@@ -950,7 +958,7 @@ const (
 	StorageHistory    History = "StorageHistory"
 	CodeHistory       History = "CodeHistory"
 	CommitmentHistory History = "CommitmentHistory"
-	//GasUsedHistory    History = "GasUsedHistory"
+	ReceiptHistory    History = "ReceiptHistory"
 )
 
 const (
@@ -958,7 +966,7 @@ const (
 	StorageHistoryIdx    InvertedIdx = "StorageHistoryIdx"
 	CodeHistoryIdx       InvertedIdx = "CodeHistoryIdx"
 	CommitmentHistoryIdx InvertedIdx = "CommitmentHistoryIdx"
-	//GasUsedHistoryIdx    InvertedIdx = "GasUsedHistoryIdx"
+	ReceiptHistoryIdx    InvertedIdx = "ReceiptHistoryIdx"
 
 	LogTopicIdx   InvertedIdx = "LogTopicIdx"
 	LogAddrIdx    InvertedIdx = "LogAddrIdx"
@@ -987,6 +995,56 @@ func (iip InvertedIdxPos) String() string {
 	}
 }
 
+// ExtraInvertedIdxCfg - optional standalone inverted index. It's given to the Aggregator at creation, fed during
+// execution by SharedDomains.IndexAdd(Name, key) and has the same files building, merge, prune and IndexRange
+// support as the built-in LogAddrIdx, LogTopicIdx, TracesFromIdx, TracesToIdx.
+// It must be registered by RegisterExtraInvertedIdx, which also makes its tables known by the chain db.
+type ExtraInvertedIdxCfg struct {
+	Name         InvertedIdx // name for IndexAdd and IndexRange
+	FilenameBase string      // name of the files: v1-<FilenameBase>.0-32.ef
+	KeysTable    string
+	IndexTable   string
+}
+
+var extraInvertedIdxs []ExtraInvertedIdxCfg
+
+// RegisterExtraInvertedIdx - makes the optional inverted index known: its tables are added to the DupSort tables of
+// the chain db and the Aggregator accepts it. Must be called before the chain db is opened, usually from init().
+func RegisterExtraInvertedIdx(cfg ExtraInvertedIdxCfg) ExtraInvertedIdxCfg {
+	if cfg.Name == "" || cfg.FilenameBase == "" || cfg.KeysTable == "" || cfg.IndexTable == "" {
+		panic(fmt.Sprintf("extra inverted index: all of name, filename base and tables are required: %+v", cfg))
+	}
+	if cfg.KeysTable == cfg.IndexTable {
+		panic(fmt.Sprintf("extra inverted index %s: keys and index tables must differ", cfg.Name))
+	}
+	switch cfg.Name {
+	case AccountsHistoryIdx, StorageHistoryIdx, CodeHistoryIdx, CommitmentHistoryIdx, ReceiptHistoryIdx, LogTopicIdx, LogAddrIdx, TracesFromIdx, TracesToIdx:
+		panic(fmt.Sprintf("extra inverted index %s: name is reserved", cfg.Name))
+	}
+	switch cfg.FilenameBase {
+	case FileAccountDomain, FileStorageDomain, FileCodeDomain, FileCommitmentDomain, FileReceiptDomain, FileLogAddressIdx, FileLogTopicsIdx, FileTracesFromIdx, FileTracesToIdx:
+		panic(fmt.Sprintf("extra inverted index %s: filename base %s is reserved", cfg.Name, cfg.FilenameBase))
+	}
+	for _, registered := range extraInvertedIdxs {
+		if registered.Name == cfg.Name || registered.FilenameBase == cfg.FilenameBase {
+			panic(fmt.Sprintf("extra inverted index %s: registered twice", cfg.Name))
+		}
+	}
+	for _, table := range []string{cfg.KeysTable, cfg.IndexTable} {
+		if _, ok := ChaindataTablesCfg[table]; ok {
+			panic(fmt.Sprintf("extra inverted index %s: table %s already exists", cfg.Name, table))
+		}
+		ChaindataTables = append(ChaindataTables, table)
+		ChaindataTablesCfg[table] = TableCfgItem{Flags: DupSort}
+	}
+	extraInvertedIdxs = append(extraInvertedIdxs, cfg)
+	reinit()
+	return cfg
+}
+
+// ExtraInvertedIdxs - registered optional inverted indices, see RegisterExtraInvertedIdx
+func ExtraInvertedIdxs() []ExtraInvertedIdxCfg { return slices.Clone(extraInvertedIdxs) }
+
 func (d Domain) String() string {
 	switch d {
 	case AccountsDomain:
@@ -997,8 +1055,8 @@ func (d Domain) String() string {
 		return "code"
 	case CommitmentDomain:
 		return "commitment"
-	//case GasUsedDomain:
-	//	return "gasused"
+	case ReceiptDomain:
+		return "receipt"
 	default:
 		return "unknown domain"
 	}
@@ -1014,8 +1072,8 @@ func String2Domain(in string) (Domain, error) {
 		return CodeDomain, nil
 	case "commitment":
 		return CommitmentDomain, nil
-	//case "gasused":
-	//	return GasUsedDomain, nil
+	case "receipt":
+		return ReceiptDomain, nil
 	default:
 		return 0, fmt.Errorf("unknown history name: %s", in)
 	}
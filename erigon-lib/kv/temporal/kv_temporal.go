@@ -74,6 +74,21 @@ func (db *DB) ViewTemporal(ctx context.Context, f func(tx kv.TemporalTx) error)
 	return f(tx)
 }
 
+// TryBeginRo - see kv.TryBeginRoDB. Returns nil tx if underlying db has no free slot or doesn't support it.
+func (db *DB) TryBeginRo(ctx context.Context) (kv.Tx, error) {
+	tryDB, ok := db.RwDB.(kv.TryBeginRoDB)
+	if !ok {
+		return nil, nil
+	}
+	kvTx, err := tryDB.TryBeginRo(ctx)
+	if err != nil || kvTx == nil {
+		return nil, err
+	}
+	tx := &Tx{MdbxTx: kvTx.(*mdbx.MdbxTx), db: db}
+	tx.aggCtx = db.agg.BeginFilesRo()
+	return tx, nil
+}
+
 // TODO: it's temporary method, allowing inject TemproalTx without changing code. But it's not type-safe.
 func (db *DB) BeginRo(ctx context.Context) (kv.Tx, error) {
 	return db.BeginTemporalRo(ctx)
@@ -26,6 +26,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -44,6 +45,7 @@ import (
 	"github.com/ledgerwatch/erigon-lib/common/datadir"
 	"github.com/ledgerwatch/erigon-lib/common/dbg"
 	"github.com/ledgerwatch/erigon-lib/common/dir"
+	"github.com/ledgerwatch/erigon-lib/downloader/snaptype"
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/bitmapdb"
 	"github.com/ledgerwatch/erigon-lib/kv/iter"
@@ -55,7 +57,8 @@ import (
 type Aggregator struct {
 	db               kv.RoDB
 	d                [kv.DomainLen]*Domain
-	iis              [kv.StandaloneIdxLen]*InvertedIndex
+	iis              []*InvertedIndex // built-in indices by kv.InvertedIdxPos, then extraIndices
+	extraIndices     []kv.ExtraInvertedIdxCfg
 	backgroundResult *BackgroundResult
 	dirs             datadir.Dirs
 	tmpdir           string
@@ -102,6 +105,16 @@ type OnFreezeFunc func(frozenFileNames []string)
 const AggregatorSqueezeCommitmentValues = true
 
 func NewAggregator(ctx context.Context, dirs datadir.Dirs, aggregationStep uint64, db kv.RoDB, logger log.Logger) (*Aggregator, error) {
+	return NewAggregatorWithExtraIndices(ctx, dirs, aggregationStep, db, nil, logger)
+}
+
+// NewAggregatorWithExtraIndices - creates Aggregator which also builds, merges and prunes the given optional
+// inverted indices, registered by kv.RegisterExtraInvertedIdx. Every process which opens the same datadir must pass
+// the same extra indices.
+func NewAggregatorWithExtraIndices(ctx context.Context, dirs datadir.Dirs, aggregationStep uint64, db kv.RoDB, extraIndices []kv.ExtraInvertedIdxCfg, logger log.Logger) (*Aggregator, error) {
+	if err := checkExtraIndices(db, extraIndices); err != nil {
+		return nil, err
+	}
 	tmpdir := dirs.Tmp
 	salt, err := getStateIndicesSalt(dirs.Snap)
 	if err != nil {
@@ -117,6 +130,7 @@ func NewAggregator(ctx context.Context, dirs datadir.Dirs, aggregationStep uint6
 		tmpdir:                 tmpdir,
 		aggregationStep:        aggregationStep,
 		db:                     db,
+		extraIndices:           extraIndices,
 		leakDetector:           dbg.NewLeakDetector("agg", dbg.SlowTx()),
 		ps:                     background.NewProgressSet(),
 		backgroundResult:       &BackgroundResult{},
@@ -168,15 +182,16 @@ func NewAggregator(ctx context.Context, dirs datadir.Dirs, aggregationStep uint6
 	if a.d[kv.CommitmentDomain], err = NewDomain(cfg, aggregationStep, kv.FileCommitmentDomain, kv.TblCommitmentKeys, kv.TblCommitmentVals, kv.TblCommitmentHistoryKeys, kv.TblCommitmentHistoryVals, kv.TblCommitmentIdx, logger); err != nil {
 		return nil, err
 	}
-	//cfg = domainCfg{
-	//	hist: histCfg{
-	//		iiCfg:             iiCfg{salt: salt, dirs: dirs},
-	//		withLocalityIndex: false, withExistenceIndex: false, historyLargeValues: false,
-	//	},
-	//}
-	//if a.d[kv.GasUsedDomain], err = NewDomain(cfg, aggregationStep, "gasused", kv.TblGasUsedKeys, kv.TblGasUsedVals, kv.TblGasUsedHistoryKeys, kv.TblGasUsedHistoryVals, kv.TblGasUsedIdx, logger); err != nil {
-	//	return nil, err
-	//}
+	cfg = domainCfg{
+		hist: histCfg{
+			iiCfg:             iiCfg{salt: salt, dirs: dirs, db: db},
+			withLocalityIndex: false, withExistenceIndex: false, compression: CompressVals, historyLargeValues: true, // values have the logs of the transaction
+		},
+		compress: CompressNone,
+	}
+	if a.d[kv.ReceiptDomain], err = NewDomain(cfg, aggregationStep, kv.FileReceiptDomain, kv.TblReceiptKeys, kv.TblReceiptVals, kv.TblReceiptHistoryKeys, kv.TblReceiptHistoryVals, kv.TblReceiptIdx, logger); err != nil {
+		return nil, err
+	}
 	if err := a.registerII(kv.LogAddrIdxPos, salt, dirs, db, aggregationStep, kv.FileLogAddressIdx, kv.TblLogAddressKeys, kv.TblLogAddressIdx, logger); err != nil {
 		return nil, err
 	}
@@ -189,6 +204,12 @@ func NewAggregator(ctx context.Context, dirs datadir.Dirs, aggregationStep uint6
 	if err := a.registerII(kv.TracesToIdxPos, salt, dirs, db, aggregationStep, kv.FileTracesToIdx, kv.TblTracesToKeys, kv.TblTracesToIdx, logger); err != nil {
 		return nil, err
 	}
+	for i, cfg := range extraIndices {
+		idx := kv.InvertedIdxPos(kv.StandaloneIdxLen) + kv.InvertedIdxPos(i)
+		if err := a.registerII(idx, salt, dirs, db, aggregationStep, cfg.FilenameBase, cfg.KeysTable, cfg.IndexTable, logger); err != nil {
+			return nil, err
+		}
+	}
 	a.KeepStepsInDB(1)
 	a.recalcVisibleFiles()
 
@@ -226,8 +247,42 @@ func getStateIndicesSalt(baseDir string) (salt *uint32, err error) {
 	return salt, nil
 }
 
+func checkExtraIndices(db kv.RoDB, extraIndices []kv.ExtraInvertedIdxCfg) error {
+	registered := kv.ExtraInvertedIdxs()
+	for i, cfg := range extraIndices {
+		if !slices.Contains(registered, cfg) {
+			return fmt.Errorf("extra inverted index %s: not registered, see kv.RegisterExtraInvertedIdx", cfg.Name)
+		}
+		// the index is written by etl and cursors of the db, which knows only its own tables
+		for _, t := range []string{cfg.KeysTable, cfg.IndexTable} {
+			if tCfg, ok := db.AllTables()[t]; !ok || tCfg.Flags&kv.DupSort == 0 {
+				return fmt.Errorf("extra inverted index %s: table %s is not a DupSort table of the db", cfg.Name, t)
+			}
+		}
+		if slices.Contains(extraIndices[:i], cfg) {
+			return fmt.Errorf("extra inverted index %s: given twice", cfg.Name)
+		}
+	}
+	return nil
+}
+
+func (a *Aggregator) extraIdxPos(name kv.InvertedIdx) (kv.InvertedIdxPos, bool) {
+	for i, cfg := range a.extraIndices {
+		if cfg.Name == name {
+			return kv.InvertedIdxPos(kv.StandaloneIdxLen) + kv.InvertedIdxPos(i), true
+		}
+	}
+	return 0, false
+}
+
+// ExtraIndices - optional inverted indices given at creation, see NewAggregatorWithExtraIndices
+func (a *Aggregator) ExtraIndices() []kv.ExtraInvertedIdxCfg { return a.extraIndices }
+
 func (a *Aggregator) registerII(idx kv.InvertedIdxPos, salt *uint32, dirs datadir.Dirs, db kv.RoDB, aggregationStep uint64, filenameBase, indexKeysTable, indexTable string, logger log.Logger) error {
 	idxCfg := iiCfg{salt: salt, dirs: dirs, db: db}
+	if int(idx) >= len(a.iis) {
+		a.iis = append(a.iis, make([]*InvertedIndex, int(idx)+1-len(a.iis))...)
+	}
 	var err error
 	a.iis[idx], err = NewInvertedIndex(idxCfg, aggregationStep, filenameBase, indexKeysTable, indexTable, nil, logger)
 	if err != nil {
@@ -353,6 +408,21 @@ func (ac *AggregatorRoTx) Files() []string {
 	}
 	return res
 }
+
+// OpenedFiles - data files of the domains, histories and inverted indices visible to this transaction, with their accessors
+func (ac *AggregatorRoTx) OpenedFiles() (res []snaptype.OpenedFile) {
+	if ac == nil {
+		return res
+	}
+	for _, d := range ac.d {
+		res = append(res, d.openedFiles()...)
+	}
+	for _, ii := range ac.iis {
+		res = append(res, ii.openedFiles()...)
+	}
+	return res
+}
+
 func (a *Aggregator) Files() []string {
 	ac := a.BeginFilesRo()
 	defer ac.Close()
@@ -497,7 +567,7 @@ func (c AggV3Collation) Close() {
 
 type AggV3StaticFiles struct {
 	d    [kv.DomainLen]StaticFiles
-	ivfs [kv.StandaloneIdxLen]InvertedFiles
+	ivfs []InvertedFiles
 }
 
 // CleanupOnError - call it on collation fail. It's closing all files
@@ -519,7 +589,7 @@ func (a *Aggregator) buildFiles(ctx context.Context, step uint64) error {
 		txTo          = a.FirstTxNumOfStep(step + 1)
 		stepStartedAt = time.Now()
 
-		static          AggV3StaticFiles
+		static          = AggV3StaticFiles{ivfs: make([]InvertedFiles, len(a.iis))}
 		closeCollations = true
 		collListMu      = sync.Mutex{}
 		collations      = make([]Collation, 0)
@@ -573,8 +643,8 @@ func (a *Aggregator) buildFiles(ctx context.Context, step uint64) error {
 	closeCollations = false
 
 	// indices are built concurrently
-	for _, d := range a.iis {
-		d := d
+	for id, d := range a.iis {
+		id, d := id, d
 		a.wg.Add(1)
 		g.Go(func() error {
 			defer a.wg.Done()
@@ -592,19 +662,7 @@ func (a *Aggregator) buildFiles(ctx context.Context, step uint64) error {
 				sf.CleanupOnError()
 				return err
 			}
-
-			switch d.indexKeysTable {
-			case kv.TblLogTopicsKeys:
-				static.ivfs[kv.LogTopicIdxPos] = sf
-			case kv.TblLogAddressKeys:
-				static.ivfs[kv.LogAddrIdxPos] = sf
-			case kv.TblTracesFromKeys:
-				static.ivfs[kv.TracesFromIdxPos] = sf
-			case kv.TblTracesToKeys:
-				static.ivfs[kv.TracesToIdxPos] = sf
-			default:
-				panic("unknown index " + d.indexKeysTable)
-			}
+			static.ivfs[id] = sf
 			return nil
 		})
 	}
@@ -731,6 +789,8 @@ type flusher interface {
 	Flush(ctx context.Context, tx kv.RwTx) error
 }
 
+// minimaxTxNumInDomainFiles - receipt domain is not taken into account: it's optional for the state, the datadirs
+// created before it was introduced have no its files
 func (ac *AggregatorRoTx) minimaxTxNumInDomainFiles(cold bool) uint64 {
 	return min(
 		ac.d[kv.AccountsDomain].maxTxNumInDomainFiles(cold),
@@ -1071,8 +1131,8 @@ func (ac *AggregatorRoTx) Prune(ctx context.Context, tx kv.RwTx, limit uint64, w
 			return aggStat, err
 		}
 	}
-	var stats [kv.StandaloneIdxLen]*InvertedIndexPruneStat
-	for i := 0; i < int(kv.StandaloneIdxLen); i++ {
+	stats := make([]*InvertedIndexPruneStat, len(ac.iis))
+	for i := range ac.iis {
 		stat, err := ac.iis[i].Prune(ctx, tx, txFrom, txTo, limit, logEvery, false, withWarmup, nil)
 		if err != nil {
 			return nil, err
@@ -1080,7 +1140,7 @@ func (ac *AggregatorRoTx) Prune(ctx context.Context, tx kv.RwTx, limit uint64, w
 		stats[i] = stat
 	}
 
-	for i := 0; i < int(kv.StandaloneIdxLen); i++ {
+	for i := range ac.iis {
 		aggStat.Indices[ac.iis[i].ii.filenameBase] = stats[i]
 	}
 
@@ -1193,7 +1253,7 @@ func (a *Aggregator) recalcVisibleFilesMinimaxTxNum() {
 
 type RangesV3 struct {
 	d      [kv.DomainLen]DomainRanges
-	ranges [kv.StandaloneIdxLen]*MergeRange
+	ranges []*MergeRange
 }
 
 func (r RangesV3) String() string {
@@ -1228,7 +1288,7 @@ func (r RangesV3) any() bool {
 }
 
 func (ac *AggregatorRoTx) findMergeRange(maxEndTxNum, maxSpan uint64) RangesV3 {
-	var r RangesV3
+	r := RangesV3{ranges: make([]*MergeRange, len(ac.iis))}
 	for id, d := range ac.d {
 		r.d[id] = d.findMergeRange(maxEndTxNum, maxSpan)
 	}
@@ -1409,7 +1469,7 @@ func (ac *AggregatorRoTx) SqueezeCommitmentFiles() error {
 }
 
 func (ac *AggregatorRoTx) mergeFiles(ctx context.Context, files SelectedStaticFilesV3, r RangesV3) (MergedFilesV3, error) {
-	var mf MergedFilesV3
+	mf := MergedFilesV3{iis: make([]*filesItem, len(ac.iis))}
 	g, ctx := errgroup.WithContext(ctx)
 	g.SetLimit(ac.a.mergeWorkers)
 	closeFiles := true
@@ -1616,7 +1676,62 @@ func (a *Aggregator) BuildFilesInBackground(txNum uint64) chan struct{} {
 	return fin
 }
 
+// invertedIndex - for read-only checks only: history indices must be read by HistoryRoTx.
+// nil if availability of the index is not checked.
+func (ac *AggregatorRoTx) invertedIndex(name kv.InvertedIdx) *InvertedIndexRoTx {
+	switch name {
+	case kv.AccountsHistoryIdx:
+		return ac.historyIdx(kv.AccountsDomain)
+	case kv.StorageHistoryIdx:
+		return ac.historyIdx(kv.StorageDomain)
+	case kv.CodeHistoryIdx:
+		return ac.historyIdx(kv.CodeDomain)
+	case kv.CommitmentHistoryIdx:
+		return ac.historyIdx(kv.CommitmentDomain)
+	case kv.ReceiptHistoryIdx:
+		return ac.historyIdx(kv.ReceiptDomain)
+	case kv.LogTopicIdx:
+		return ac.iis[kv.LogTopicIdxPos]
+	case kv.LogAddrIdx:
+		return ac.iis[kv.LogAddrIdxPos]
+	case kv.TracesFromIdx:
+		return ac.iis[kv.TracesFromIdxPos]
+	case kv.TracesToIdx:
+		return ac.iis[kv.TracesToIdxPos]
+	default:
+		if pos, ok := ac.a.extraIdxPos(name); ok {
+			return ac.iis[pos]
+		}
+		return nil
+	}
+}
+
+// historyIdx - inverted index of the domain history, nil if its availability is not checked: history without files
+// is in DB only, receipt domain has no files in datadirs created before it was introduced.
+func (ac *AggregatorRoTx) historyIdx(name kv.Domain) *InvertedIndexRoTx {
+	dt := ac.d[name]
+	if dt.ht.h.dontProduceHistoryFiles || (name == kv.ReceiptDomain && len(dt.files) == 0) {
+		return nil
+	}
+	return dt.ht.iit
+}
+
+// checkHistoryAvailable - history as of `txNum` needs all changes after it: in files and in DB, which keeps history
+// since the end of the domain files
+func (ac *AggregatorRoTx) checkHistoryAvailable(name kv.Domain, txNum uint64) error {
+	if iit := ac.historyIdx(name); iit != nil {
+		return iit.checkAvailable(txNum, math2.MaxUint64, ac.minimaxTxNumInDomainFiles(false))
+	}
+	return nil
+}
+
+// IndexRange - returns ErrHistoryOutOfRange if node has no history for requested range
 func (ac *AggregatorRoTx) IndexRange(name kv.InvertedIdx, k []byte, fromTs, toTs int, asc order.By, limit int, tx kv.Tx) (timestamps iter.U64, err error) {
+	if iit := ac.invertedIndex(name); iit != nil {
+		if err := iit.checkRangeAvailable(fromTs, toTs, asc, ac.minimaxTxNumInDomainFiles(false)); err != nil {
+			return nil, err
+		}
+	}
 	switch name {
 	case kv.AccountsHistoryIdx:
 		return ac.d[kv.AccountsDomain].ht.IdxRange(k, fromTs, toTs, asc, limit, tx)
@@ -1626,8 +1741,8 @@ func (ac *AggregatorRoTx) IndexRange(name kv.InvertedIdx, k []byte, fromTs, toTs
 		return ac.d[kv.CodeDomain].ht.IdxRange(k, fromTs, toTs, asc, limit, tx)
 	case kv.CommitmentHistoryIdx:
 		return ac.d[kv.StorageDomain].ht.IdxRange(k, fromTs, toTs, asc, limit, tx)
-	//case kv.GasUsedHistoryIdx:
-	//	return ac.d[kv.GasUsedDomain].ht.IdxRange(k, fromTs, toTs, asc, limit, tx)
+	case kv.ReceiptHistoryIdx:
+		return ac.d[kv.ReceiptDomain].ht.IdxRange(k, fromTs, toTs, asc, limit, tx)
 	case kv.LogTopicIdx:
 		return ac.iis[kv.LogTopicIdxPos].IdxRange(k, fromTs, toTs, asc, limit, tx)
 	case kv.LogAddrIdx:
@@ -1637,13 +1752,22 @@ func (ac *AggregatorRoTx) IndexRange(name kv.InvertedIdx, k []byte, fromTs, toTs
 	case kv.TracesToIdx:
 		return ac.iis[kv.TracesToIdxPos].IdxRange(k, fromTs, toTs, asc, limit, tx)
 	default:
+		if pos, ok := ac.a.extraIdxPos(name); ok {
+			return ac.iis[pos].IdxRange(k, fromTs, toTs, asc, limit, tx)
+		}
 		return nil, fmt.Errorf("unexpected history name: %s", name)
 	}
 }
 
 // -- range end
 
+// HistorySeek - returns ErrHistoryOutOfRange if node has no history for `ts`
 func (ac *AggregatorRoTx) HistorySeek(name kv.History, key []byte, ts uint64, tx kv.Tx) (v []byte, ok bool, err error) {
+	if d, ok := historyDomain(name); ok {
+		if err := ac.checkHistoryAvailable(d, ts); err != nil {
+			return nil, false, err
+		}
+	}
 	switch name {
 	case kv.AccountsHistory:
 		v, ok, err = ac.d[kv.AccountsDomain].ht.HistorySeek(key, ts, tx)
@@ -1660,27 +1784,42 @@ func (ac *AggregatorRoTx) HistorySeek(name kv.History, key []byte, ts uint64, tx
 		return ac.d[kv.CodeDomain].ht.HistorySeek(key, ts, tx)
 	case kv.CommitmentHistory:
 		return ac.d[kv.CommitmentDomain].ht.HistorySeek(key, ts, tx)
-	//case kv.GasUsedHistory:
-	//	return ac.d[kv.GasUsedDomain].ht.HistorySeek(key, ts, tx)
+	case kv.ReceiptHistory:
+		return ac.d[kv.ReceiptDomain].ht.HistorySeek(key, ts, tx)
 	default:
 		panic(fmt.Sprintf("unexpected: %s", name))
 	}
 }
 
-func (ac *AggregatorRoTx) HistoryRange(name kv.History, fromTs, toTs int, asc order.By, limit int, tx kv.Tx) (it iter.KV, err error) {
-	//TODO: aggTx to store array of histories
-	var domainName kv.Domain
-
+func historyDomain(name kv.History) (kv.Domain, bool) {
 	switch name {
 	case kv.AccountsHistory:
-		domainName = kv.AccountsDomain
+		return kv.AccountsDomain, true
 	case kv.StorageHistory:
-		domainName = kv.StorageDomain
+		return kv.StorageDomain, true
 	case kv.CodeHistory:
-		domainName = kv.CodeDomain
+		return kv.CodeDomain, true
+	case kv.CommitmentHistory:
+		return kv.CommitmentDomain, true
+	case kv.ReceiptHistory:
+		return kv.ReceiptDomain, true
 	default:
+		return 0, false
+	}
+}
+
+// HistoryRange - returns ErrHistoryOutOfRange if node has no history for requested range
+func (ac *AggregatorRoTx) HistoryRange(name kv.History, fromTs, toTs int, asc order.By, limit int, tx kv.Tx) (it iter.KV, err error) {
+	//TODO: aggTx to store array of histories
+	domainName, ok := historyDomain(name)
+	if !ok || name == kv.CommitmentHistory {
 		return nil, fmt.Errorf("unexpected history name: %s", name)
 	}
+	if iit := ac.historyIdx(domainName); iit != nil {
+		if err := iit.checkRangeAvailable(fromTs, toTs, asc, ac.minimaxTxNumInDomainFiles(false)); err != nil {
+			return nil, err
+		}
+	}
 
 	hr, err := ac.d[domainName].ht.HistoryRange(fromTs, toTs, asc, limit, tx)
 	if err != nil {
@@ -1705,7 +1844,7 @@ func (a *Aggregator) Stats() FilesStats22 {
 type AggregatorRoTx struct {
 	a   *Aggregator
 	d   [kv.DomainLen]*DomainRoTx
-	iis [kv.StandaloneIdxLen]*InvertedIndexRoTx
+	iis []*InvertedIndexRoTx
 
 	id      uint64 // auto-increment id of ctx for logs
 	_leakID uint64 // set only if TRACE_AGG=true
@@ -1714,6 +1853,7 @@ type AggregatorRoTx struct {
 func (a *Aggregator) BeginFilesRo() *AggregatorRoTx {
 	ac := &AggregatorRoTx{
 		a:       a,
+		iis:     make([]*InvertedIndexRoTx, len(a.iis)),
 		id:      a.ctxAutoIncrement.Add(1),
 		_leakID: a.leakDetector.Add(),
 	}
@@ -1734,13 +1874,20 @@ func (ac *AggregatorRoTx) ViewID() uint64 { return ac.id }
 // --- Domain part START ---
 
 func (ac *AggregatorRoTx) DomainRange(tx kv.Tx, domain kv.Domain, fromKey, toKey []byte, ts uint64, asc order.By, limit int) (it iter.KV, err error) {
+	if err := ac.checkHistoryAvailable(domain, ts); err != nil {
+		return nil, err
+	}
 	return ac.d[domain].DomainRange(tx, fromKey, toKey, ts, asc, limit)
 }
 func (ac *AggregatorRoTx) DomainRangeLatest(tx kv.Tx, domain kv.Domain, from, to []byte, limit int) (iter.KV, error) {
 	return ac.d[domain].DomainRangeLatest(tx, from, to, limit)
 }
 
+// DomainGetAsOf - returns ErrHistoryOutOfRange if node has no history for `ts`
 func (ac *AggregatorRoTx) DomainGetAsOf(tx kv.Tx, name kv.Domain, key []byte, ts uint64) (v []byte, ok bool, err error) {
+	if err := ac.checkHistoryAvailable(name, ts); err != nil {
+		return nil, false, err
+	}
 	v, err = ac.d[name].GetAsOf(key, ts, tx)
 	return v, v != nil, err
 }
@@ -1785,11 +1932,11 @@ func (ac *AggregatorRoTx) DebugEFAllValuesAreInRange(ctx context.Context, name k
 		if err != nil {
 			return err
 		}
-	//case kv.GasUsedHistoryIdx:
-	//	err := ac.d[kv.GasUsedDomain].ht.iit.DebugEFAllValuesAreInRange(ctx)
-	//	if err != nil {
-	//		return err
-	//	}
+	case kv.ReceiptHistoryIdx:
+		err := ac.d[kv.ReceiptDomain].ht.iit.DebugEFAllValuesAreInRange(ctx)
+		if err != nil {
+			return err
+		}
 	case kv.TracesFromIdx:
 		err := ac.iis[kv.TracesFromIdxPos].DebugEFAllValuesAreInRange(ctx)
 		if err != nil {
@@ -1811,7 +1958,13 @@ func (ac *AggregatorRoTx) DebugEFAllValuesAreInRange(ctx context.Context, name k
 			return err
 		}
 	default:
-		panic(fmt.Sprintf("unexpected: %s", name))
+		pos, ok := ac.a.extraIdxPos(name)
+		if !ok {
+			panic(fmt.Sprintf("unexpected: %s", name))
+		}
+		if err := ac.iis[pos].DebugEFAllValuesAreInRange(ctx); err != nil {
+			return err
+		}
 	}
 	return nil
 }
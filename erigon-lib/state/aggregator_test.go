@@ -830,6 +830,130 @@ func TestAggregatorV3_RestartOnFiles(t *testing.T) {
 	require.NoError(t, err)
 }
 
+var testExtraIdx = kv.RegisterExtraInvertedIdx(kv.ExtraInvertedIdxCfg{Name: "TestExtraIdx", FilenameBase: "testextra", KeysTable: "TestExtraKeys", IndexTable: "TestExtraIdx"})
+
+func TestAggregatorV3_ExtraInvertedIndex(t *testing.T) {
+	extraIdx := testExtraIdx
+
+	aggStep := uint64(10)
+	dirs := datadir.New(t.TempDir())
+	logger := log.New()
+	// registered tables are known by the chain db
+	db := mdbx.NewMDBX(logger).InMem(dirs.Chaindata).GrowthStep(32 * datasize.MB).MapSize(2 * datasize.GB).WithTableCfg(func(defaultBuckets kv.TableCfg) kv.TableCfg {
+		return kv.ChaindataTablesCfg
+	}).MustOpen()
+	t.Cleanup(db.Close)
+
+	_, err := NewAggregatorWithExtraIndices(context.Background(), dirs, aggStep, db, []kv.ExtraInvertedIdxCfg{{Name: "NotRegisteredIdx", FilenameBase: "notregistered", KeysTable: "NotRegisteredKeys", IndexTable: "NotRegisteredIdx"}}, logger)
+	require.ErrorContains(t, err, "not registered")
+	_, err = NewAggregatorWithExtraIndices(context.Background(), dirs, aggStep, db, []kv.ExtraInvertedIdxCfg{extraIdx, extraIdx}, logger)
+	require.ErrorContains(t, err, "given twice")
+	require.Panics(t, func() {
+		kv.RegisterExtraInvertedIdx(kv.ExtraInvertedIdxCfg{Name: kv.LogAddrIdx, FilenameBase: "other", KeysTable: "OtherKeys", IndexTable: "OtherIdx"})
+	})
+	require.Panics(t, func() { kv.RegisterExtraInvertedIdx(extraIdx) })
+
+	agg, err := NewAggregatorWithExtraIndices(context.Background(), dirs, aggStep, db, []kv.ExtraInvertedIdxCfg{extraIdx}, logger)
+	require.NoError(t, err)
+	t.Cleanup(agg.Close)
+	require.NoError(t, agg.OpenFolder(false))
+	agg.DisableFsync()
+
+	tx, err := db.BeginRw(context.Background())
+	require.NoError(t, err)
+	defer tx.Rollback()
+	ac := agg.BeginFilesRo()
+	defer ac.Close()
+	domains, err := NewSharedDomains(WrapTxWithCtx(tx, ac), log.New())
+	require.NoError(t, err)
+	defer domains.Close()
+
+	txs := aggStep * 5
+	var expect []uint64
+	for txNum := uint64(1); txNum <= txs; txNum++ {
+		domains.SetTxNum(txNum)
+		// files are built only for steps with accounts
+		acc := types.EncodeAccountBytesV3(txNum, uint256.NewInt(txNum), nil, 0)
+		require.NoError(t, domains.DomainPut(kv.AccountsDomain, []byte{byte(txNum)}, nil, acc, nil, 0))
+		require.NoError(t, domains.IndexAdd(extraIdx.Name, []byte{byte(txNum % 3)}))
+		if txNum%3 == 0 {
+			expect = append(expect, txNum)
+		}
+	}
+	require.NoError(t, domains.Flush(context.Background(), tx))
+	domains.Close()
+	ac.Close()
+	require.NoError(t, tx.Commit())
+
+	require.NoError(t, agg.BuildFiles(txs))
+
+	tx, err = db.BeginRw(context.Background())
+	require.NoError(t, err)
+	defer tx.Rollback()
+	ac = agg.BeginFilesRo()
+	defer ac.Close()
+	_, err = ac.PruneSmallBatches(context.Background(), time.Minute, tx)
+	require.NoError(t, err)
+
+	pos, ok := agg.extraIdxPos(extraIdx.Name)
+	require.True(t, ok)
+	require.Equal(t, extraIdx.FilenameBase, ac.iis[pos].ii.filenameBase)
+	require.NotEmpty(t, ac.iis[pos].files)
+	require.NoError(t, ac.DebugEFAllValuesAreInRange(context.Background(), extraIdx.Name))
+
+	it, err := ac.IndexRange(extraIdx.Name, []byte{0}, -1, -1, order.Asc, -1, tx)
+	require.NoError(t, err)
+	got, err := iter.ToArrayU64(it)
+	require.NoError(t, err)
+	require.Equal(t, expect, got)
+}
+
+func TestAggregatorV3_OpenedFiles(t *testing.T) {
+	aggStep := uint64(10)
+	db, agg := testDbAndAggregatorv3(t, aggStep)
+
+	tx, err := db.BeginRw(context.Background())
+	require.NoError(t, err)
+	defer tx.Rollback()
+	ac := agg.BeginFilesRo()
+	defer ac.Close()
+	domains, err := NewSharedDomains(WrapTxWithCtx(tx, ac), log.New())
+	require.NoError(t, err)
+	defer domains.Close()
+
+	txs := aggStep * 3
+	for txNum := uint64(1); txNum <= txs; txNum++ {
+		domains.SetTxNum(txNum)
+		acc := types.EncodeAccountBytesV3(txNum, uint256.NewInt(txNum), nil, 0)
+		require.NoError(t, domains.DomainPut(kv.AccountsDomain, []byte{byte(txNum)}, nil, acc, nil, 0))
+	}
+	require.NoError(t, domains.Flush(context.Background(), tx))
+	domains.Close()
+	ac.Close()
+	require.NoError(t, tx.Commit())
+	require.NoError(t, agg.BuildFiles(txs))
+
+	ac = agg.BeginFilesRo()
+	defer ac.Close()
+	exts, kvWithWords := map[string]int{}, 0
+	for _, f := range ac.OpenedFiles() {
+		exts[path.Ext(f.Path)]++
+		require.FileExists(t, f.Path)
+		if path.Ext(f.Path) == ".kv" && f.Words > 0 {
+			kvWithWords++
+		}
+		require.NotEmpty(t, f.Accessors, f.Path)
+		for _, a := range f.Accessors {
+			require.FileExists(t, a.Path)
+		}
+	}
+	require.NotZero(t, exts[".kv"])
+	require.NotZero(t, kvWithWords)
+	require.NotZero(t, exts[".v"])
+	require.NotZero(t, exts[".ef"])
+	require.Len(t, ac.Files(), exts[".kv"]+exts[".v"]+exts[".ef"])
+}
+
 func TestAggregatorV3_ReplaceCommittedKeys(t *testing.T) {
 	ctx := context.Background()
 	aggStep := uint64(500)
@@ -41,6 +41,7 @@ import (
 	"github.com/ledgerwatch/erigon-lib/common/background"
 	"github.com/ledgerwatch/erigon-lib/common/dbg"
 	"github.com/ledgerwatch/erigon-lib/common/dir"
+	"github.com/ledgerwatch/erigon-lib/downloader/snaptype"
 	"github.com/ledgerwatch/erigon-lib/etl"
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/iter"
@@ -2154,6 +2155,26 @@ func (dt *DomainRoTx) Files() (res []string) {
 	return append(res, dt.ht.Files()...)
 }
 
+func (dt *DomainRoTx) openedFiles() (res []snaptype.OpenedFile) {
+	for _, item := range dt.files {
+		if item.src.decompressor == nil {
+			continue
+		}
+		f := snaptype.OpenedFile{Path: item.src.decompressor.FilePath(), Words: uint64(item.src.decompressor.Count())}
+		if item.src.index != nil {
+			f.Accessors = append(f.Accessors, snaptype.OpenedAccessor{Path: item.src.index.FilePath(), Keys: item.src.index.KeyCount()})
+		}
+		if item.src.bindex != nil {
+			f.Accessors = append(f.Accessors, snaptype.OpenedAccessor{Path: item.src.bindex.FilePath(), Keys: item.src.bindex.KeyCount()})
+		}
+		if item.src.existence != nil && item.src.existence.FilePath != "" {
+			f.Accessors = append(f.Accessors, snaptype.OpenedAccessor{Path: item.src.existence.FilePath})
+		}
+		res = append(res, f)
+	}
+	return append(res, dt.ht.openedFiles()...)
+}
+
 type SelectedStaticFiles struct {
 	accounts       []*filesItem
 	accountsIdx    []*filesItem
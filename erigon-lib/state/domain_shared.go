@@ -76,7 +76,7 @@ type SharedDomains struct {
 	storage *btree2.Map[string, []byte]
 
 	dWriter   [kv.DomainLen]*domainBufferedWriter
-	iiWriters [kv.StandaloneIdxLen]*invertedIndexBufferedWriter
+	iiWriters []*invertedIndexBufferedWriter
 }
 
 type HasAggTx interface {
@@ -91,6 +91,7 @@ func NewSharedDomains(tx kv.Tx, logger log.Logger) (*SharedDomains, error) {
 	}
 	sd.SetTx(tx)
 
+	sd.iiWriters = make([]*invertedIndexBufferedWriter, len(sd.aggTx.iis))
 	for id, ii := range sd.aggTx.iis {
 		sd.iiWriters[id] = ii.NewWriter()
 	}
@@ -492,6 +493,9 @@ func (sd *SharedDomains) delAccountStorage(addr, loc []byte, preVal []byte, prev
 	return sd.dWriter[kv.StorageDomain].DeleteWithPrev(composite, nil, preVal, prevStep)
 }
 
+// ExtraIndices - optional inverted indices of the aggregator, they are fed by IndexAdd as the built-in ones
+func (sd *SharedDomains) ExtraIndices() []kv.ExtraInvertedIdxCfg { return sd.aggTx.a.extraIndices }
+
 func (sd *SharedDomains) IndexAdd(table kv.InvertedIdx, key []byte) (err error) {
 	switch table {
 	case kv.LogAddrIdx, kv.TblLogAddressIdx:
@@ -503,7 +507,11 @@ func (sd *SharedDomains) IndexAdd(table kv.InvertedIdx, key []byte) (err error)
 	case kv.TblTracesFromIdx:
 		err = sd.iiWriters[kv.TracesFromIdxPos].Add(key)
 	default:
-		panic(fmt.Errorf("unknown shared index %s", table))
+		pos, ok := sd.aggTx.a.extraIdxPos(table)
+		if !ok {
+			panic(fmt.Errorf("unknown shared index %s", table))
+		}
+		err = sd.iiWriters[pos].Add(key)
 	}
 	return err
 }
@@ -4,6 +4,7 @@ import (
 	"os"
 	"sync/atomic"
 
+	"github.com/ledgerwatch/erigon-lib/downloader/snaptype"
 	"github.com/ledgerwatch/erigon-lib/kv/bitmapdb"
 	"github.com/ledgerwatch/erigon-lib/recsplit"
 	"github.com/ledgerwatch/erigon-lib/seg"
@@ -143,6 +144,21 @@ type ctxItem struct {
 	src *filesItem
 }
 
+// openedFiles - data files with their recsplit accessors, for histories and inverted indices
+func openedFiles(items []ctxItem) (res []snaptype.OpenedFile) {
+	for _, item := range items {
+		if item.src.decompressor == nil {
+			continue
+		}
+		f := snaptype.OpenedFile{Path: item.src.decompressor.FilePath(), Words: uint64(item.src.decompressor.Count())}
+		if item.src.index != nil {
+			f.Accessors = append(f.Accessors, snaptype.OpenedAccessor{Path: item.src.index.FilePath(), Keys: item.src.index.KeyCount()})
+		}
+		res = append(res, f)
+	}
+	return res
+}
+
 func (i *ctxItem) isSubSetOf(j *ctxItem) bool { return i.src.isSubsetOf(j.src) } //nolint
 func (i *ctxItem) isSubsetOf(j *ctxItem) bool { return i.src.isSubsetOf(j.src) } //nolint
 
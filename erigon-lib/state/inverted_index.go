@@ -30,10 +30,12 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/ledgerwatch/erigon-lib/common/assert"
+	"github.com/ledgerwatch/erigon-lib/downloader/snaptype"
 
 	"github.com/RoaringBitmap/roaring/roaring64"
 	"github.com/ledgerwatch/erigon-lib/kv/backup"
@@ -354,6 +356,8 @@ func (iit *InvertedIndexRoTx) Files() (res []string) {
 	return res
 }
 
+func (iit *InvertedIndexRoTx) openedFiles() []snaptype.OpenedFile { return openedFiles(iit.files) }
+
 // Add - !NotThreadSafe. Must use WalRLock/BatchHistoryWriteEnd
 func (w *invertedIndexBufferedWriter) Add(key []byte) error {
 	return w.add(key, key)
@@ -576,6 +580,82 @@ func (iit *InvertedIndexRoTx) lastTxNumInFiles() uint64 {
 	return iit.files[len(iit.files)-1].endTxNum
 }
 
+// ErrHistoryOutOfRange - requested txNums are not in history files of this node and not in DB.
+// For example: partial-history node didn't download them (see `--snap.partial.blocks`).
+var ErrHistoryOutOfRange = errors.New("history is not available on this node")
+
+// checkAvailable - history of [fromTxNum, toTxNum) is in files or in DB, which keeps it since `dbFromTxNum`.
+// Files may not start from 0 and may have gaps before `dbFromTxNum`, then this history is not available.
+func (iit *InvertedIndexRoTx) checkAvailable(fromTxNum, toTxNum, dbFromTxNum uint64) error {
+	toTxNum = min(toTxNum, dbFromTxNum)
+	for _, item := range iit.files {
+		if fromTxNum >= toTxNum || item.startTxNum > fromTxNum {
+			break
+		}
+		fromTxNum = max(fromTxNum, item.endTxNum)
+	}
+	if fromTxNum >= toTxNum {
+		return nil
+	}
+	return fmt.Errorf("%w: %s txNum=%d, available txNums: %s", ErrHistoryOutOfRange, iit.ii.filenameBase, fromTxNum, iit.availableTxNums(dbFromTxNum))
+}
+
+// availableTxNums - ranges of txNums covered by files and DB, for error messages
+func (iit *InvertedIndexRoTx) availableTxNums(dbFromTxNum uint64) string {
+	var ranges []string
+	var from, to uint64
+	merged := false
+	for _, item := range iit.files {
+		if item.startTxNum >= dbFromTxNum {
+			break
+		}
+		if merged && item.startTxNum == to {
+			to = item.endTxNum
+			continue
+		}
+		if merged {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", from, to))
+		}
+		from, to, merged = item.startTxNum, item.endTxNum, true
+	}
+	if merged && to < dbFromTxNum {
+		ranges = append(ranges, fmt.Sprintf("%d-%d", from, to))
+		merged = false
+	}
+	if !merged {
+		from = dbFromTxNum
+	}
+	return strings.Join(append(ranges, fmt.Sprintf("%d-", from)), ", ")
+}
+
+// checkRangeAvailable - checkAvailable for IdxRange arguments.
+// Unbounded (-1) lower bound means "as much as available": it starts from the first file.
+func (iit *InvertedIndexRoTx) checkRangeAvailable(startTxNum, endTxNum int, asc order.By, dbFromTxNum uint64) error {
+	from, to := uint64(math.MaxUint64), uint64(math.MaxUint64)
+	if asc {
+		if startTxNum >= 0 {
+			from = uint64(startTxNum)
+		}
+		if endTxNum >= 0 {
+			to = uint64(endTxNum)
+		}
+	} else { // desc: (endTxNum, startTxNum]
+		if endTxNum >= 0 {
+			from = uint64(endTxNum) + 1
+		}
+		if startTxNum >= 0 {
+			to = uint64(startTxNum) + 1
+		}
+	}
+	if from == math.MaxUint64 {
+		from = dbFromTxNum
+		if len(iit.files) > 0 {
+			from = min(from, iit.files[0].startTxNum)
+		}
+	}
+	return iit.checkAvailable(from, to, dbFromTxNum)
+}
+
 // IdxRange - return range of txNums for given `key`
 // is to be used in public API, therefore it relies on read-only transaction
 // so that iteration can be done even when the inverted index is being updated.
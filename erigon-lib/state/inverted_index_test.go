@@ -589,6 +589,52 @@ func TestCtxFiles(t *testing.T) {
 	require.Equal(t, 512, int(visibleFiles[2].endTxNum))
 }
 
+func TestHistoryOutOfRange(t *testing.T) {
+	ii := emptyTestInvertedIndex(10)
+	ii.scanStateFiles([]string{
+		"v1-test.2-4.ef", // partial-history node: first 2 steps were not downloaded
+		"v1-test.4-5.ef",
+		"v1-test.7-8.ef", // and the steps after the selected range, while DB keeps history since step 10
+	})
+	ii.dirtyFiles.Scan(func(item *filesItem) bool {
+		fName := ii.efFilePath(item.startTxNum/ii.aggregationStep, item.endTxNum/ii.aggregationStep)
+		item.decompressor = &seg.Decompressor{FileName1: fName}
+		return true
+	})
+	iit := &InvertedIndexRoTx{ii: ii, files: calcVisibleFiles(ii.dirtyFiles, 0, false)}
+	require.Len(t, iit.files, 3)
+	const dbFrom = 100
+
+	require.ErrorIs(t, iit.checkAvailable(19, 30, dbFrom), ErrHistoryOutOfRange)
+	require.NoError(t, iit.checkAvailable(20, 50, dbFrom))
+	require.NoError(t, iit.checkAvailable(70, 80, dbFrom))
+	require.NoError(t, iit.checkAvailable(100, math.MaxUint64, dbFrom)) // newer than files - in db
+	require.ErrorIs(t, iit.checkAvailable(40, 60, dbFrom), ErrHistoryOutOfRange)
+	require.ErrorIs(t, iit.checkAvailable(75, 90, dbFrom), ErrHistoryOutOfRange)
+	// history as of txNum needs all later changes
+	err := iit.checkAvailable(75, math.MaxUint64, dbFrom)
+	require.ErrorIs(t, err, ErrHistoryOutOfRange)
+	require.ErrorContains(t, err, "txNum=80, available txNums: 20-50, 70-80, 100-")
+
+	require.ErrorIs(t, iit.checkRangeAvailable(0, 30, order.Asc, dbFrom), ErrHistoryOutOfRange)
+	require.NoError(t, iit.checkRangeAvailable(20, 30, order.Asc, dbFrom))
+	require.NoError(t, iit.checkRangeAvailable(-1, 30, order.Asc, dbFrom))
+	require.ErrorIs(t, iit.checkRangeAvailable(-1, 60, order.Asc, dbFrom), ErrHistoryOutOfRange)
+	require.ErrorIs(t, iit.checkRangeAvailable(70, -1, order.Asc, dbFrom), ErrHistoryOutOfRange)
+	require.NoError(t, iit.checkRangeAvailable(100, -1, order.Asc, dbFrom))
+	require.ErrorIs(t, iit.checkRangeAvailable(30, 18, order.Desc, dbFrom), ErrHistoryOutOfRange)
+	require.NoError(t, iit.checkRangeAvailable(30, 19, order.Desc, dbFrom))
+	require.NoError(t, iit.checkRangeAvailable(30, -1, order.Desc, dbFrom))
+	require.ErrorIs(t, iit.checkRangeAvailable(-1, 19, order.Desc, dbFrom), ErrHistoryOutOfRange)
+	require.NoError(t, iit.checkRangeAvailable(79, 69, order.Desc, dbFrom))
+
+	// node without history files keeps all history in db
+	empty := &InvertedIndexRoTx{ii: ii}
+	require.NoError(t, empty.checkAvailable(0, math.MaxUint64, 0))
+	require.NoError(t, empty.checkRangeAvailable(-1, -1, order.Asc, dbFrom))
+	require.ErrorContains(t, empty.checkAvailable(0, 10, dbFrom), "available txNums: 100-")
+}
+
 func TestIsSubset(t *testing.T) {
 	assert := assert.New(t)
 	assert.True((&filesItem{startTxNum: 0, endTxNum: 1}).isSubsetOf(&filesItem{startTxNum: 0, endTxNum: 2}))
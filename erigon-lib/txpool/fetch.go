@@ -303,7 +303,7 @@ func (f *Fetch) handleInboundMessage(ctx context.Context, req *sentry.InboundMes
 			if err != nil {
 				return err
 			}
-			if txn == nil {
+			if txn == nil || f.pool.IsPrivate(txnHash) {
 				continue
 			}
 
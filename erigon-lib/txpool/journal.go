@@ -0,0 +1,218 @@
+/*
+   Copyright 2024 The Erigon contributors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package txpool
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
+)
+
+var ErrJournalDisabled = errors.New("txpool event journal is disabled, enable it with --txpool.journal")
+
+type JournalEventType uint8
+
+const (
+	TxAdded     JournalEventType = 0
+	TxPromoted  JournalEventType = 1 // moved to a better sub-pool
+	TxDemoted   JournalEventType = 2 // moved to a worse sub-pool
+	TxReplaced  JournalEventType = 3 // replaced by a transaction with the same nonce and a higher tip
+	TxDiscarded JournalEventType = 4
+	TxMined     JournalEventType = 5
+)
+
+func (t JournalEventType) String() string {
+	switch t {
+	case TxAdded:
+		return "added"
+	case TxPromoted:
+		return "promoted"
+	case TxDemoted:
+		return "demoted"
+	case TxReplaced:
+		return "replaced"
+	case TxDiscarded:
+		return "discarded"
+	case TxMined:
+		return "mined"
+	}
+	return fmt.Sprintf("unknown:%d", t)
+}
+
+// JournalEvent - event of a transaction lifecycle in the pool
+type JournalEvent struct {
+	Type     JournalEventType
+	SubPool  SubPoolType             // where the transaction moved to, for promotions and demotions
+	Reason   txpoolcfg.DiscardReason // why the transaction left the pool
+	BlockNum uint64                  // last seen block at the moment of the event
+	Time     uint64                  // unix seconds
+}
+
+const journalEventLen = 32 + 1 + 1 + 1 + 8 + 8
+
+func (e JournalEvent) encode(idHash [32]byte) []byte {
+	v := make([]byte, journalEventLen)
+	copy(v, idHash[:])
+	v[32], v[33], v[34] = byte(e.Type), byte(e.SubPool), byte(e.Reason)
+	binary.BigEndian.PutUint64(v[35:], e.BlockNum)
+	binary.BigEndian.PutUint64(v[43:], e.Time)
+	return v
+}
+
+func decodeJournalEvent(v []byte) (JournalEvent, error) {
+	if len(v) != journalEventLen {
+		return JournalEvent{}, fmt.Errorf("unexpected journal event length: %d", len(v))
+	}
+	return JournalEvent{
+		Type:     JournalEventType(v[32]),
+		SubPool:  SubPoolType(v[33]),
+		Reason:   txpoolcfg.DiscardReason(v[34]),
+		BlockNum: binary.BigEndian.Uint64(v[35:]),
+		Time:     binary.BigEndian.Uint64(v[43:]),
+	}, nil
+}
+
+type journalRecord struct {
+	idHash [32]byte
+	event  JournalEvent
+}
+
+// Journal - keeps the pool events of every transaction in the pool db: kv.PoolEvent is the log of events
+// by sequence number, which makes pruning of the oldest ones cheap, and kv.PoolEventByHash is its index by
+// transaction hash. Events are buffered in memory and written to db on the pool commits.
+// Not thread-safe: used under the pool lock.
+type Journal struct {
+	retention time.Duration
+	limit     uint64
+	seq       uint64 // sequence number of the last written event, 0 - not read from db yet
+	records   []journalRecord
+}
+
+func NewJournal(retention time.Duration, limit uint64) *Journal {
+	return &Journal{retention: retention, limit: limit}
+}
+
+func (j *Journal) record(idHash [32]byte, event JournalEvent) {
+	j.records = append(j.records, journalRecord{idHash: idHash, event: event})
+}
+
+// unflushed - events of the transaction which are not written to db yet
+func (j *Journal) unflushed(idHash []byte) (events []JournalEvent) {
+	for _, r := range j.records {
+		if bytes.Equal(r.idHash[:], idHash) {
+			events = append(events, r.event)
+		}
+	}
+	return events
+}
+
+func (j *Journal) flush(tx kv.RwTx, now time.Time) error {
+	if j.seq == 0 {
+		c, err := tx.Cursor(kv.PoolEvent)
+		if err != nil {
+			return err
+		}
+		k, _, err := c.Last()
+		c.Close()
+		if err != nil {
+			return err
+		}
+		if k != nil {
+			j.seq = binary.BigEndian.Uint64(k)
+		}
+	}
+
+	byHashKey := make([]byte, 32+8)
+	for _, r := range j.records {
+		j.seq++
+		binary.BigEndian.PutUint64(byHashKey[32:], j.seq)
+		if err := tx.Append(kv.PoolEvent, byHashKey[32:], r.event.encode(r.idHash)); err != nil {
+			return err
+		}
+		copy(byHashKey, r.idHash[:])
+		if err := tx.Put(kv.PoolEventByHash, byHashKey, []byte{}); err != nil {
+			return err
+		}
+	}
+	if err := j.prune(tx, now); err != nil {
+		return err
+	}
+
+	// clean as late as possible, the failed write transaction can be retried
+	j.records = j.records[:0]
+	return nil
+}
+
+// prune - deletes the events which are out of the retention period or the limit, the oldest first
+func (j *Journal) prune(tx kv.RwTx, now time.Time) error {
+	minTime := uint64(now.Add(-j.retention).Unix())
+	c, err := tx.RwCursor(kv.PoolEvent)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	byHashKey := make([]byte, 32+8)
+	for k, v, err := c.First(); k != nil; k, v, err = c.Next() {
+		if err != nil {
+			return err
+		}
+		seq := binary.BigEndian.Uint64(k)
+		event, err := decodeJournalEvent(v)
+		if err != nil {
+			return err
+		}
+		if j.seq-seq < j.limit && event.Time >= minTime {
+			break
+		}
+		copy(byHashKey, v[:32])
+		copy(byHashKey[32:], k)
+		if err := tx.Delete(kv.PoolEventByHash, byHashKey); err != nil {
+			return err
+		}
+		if err := c.DeleteCurrent(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JournalEvents - written pool events of the transaction, the oldest first
+func JournalEvents(tx kv.Tx, idHash []byte) (events []JournalEvent, err error) {
+	if err := tx.ForPrefix(kv.PoolEventByHash, idHash, func(k, _ []byte) error {
+		v, err := tx.GetOne(kv.PoolEvent, k[32:])
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			return nil
+		}
+		event, err := decodeJournalEvent(v)
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
@@ -0,0 +1,86 @@
+/*
+   Copyright 2024 The Erigon contributors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package txpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon-lib/kv/memdb"
+	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
+)
+
+func TestJournal(t *testing.T) {
+	db := memdb.NewTestPoolDB(t)
+	now := time.Unix(1_000_000, 0)
+	at := func(ago time.Duration) uint64 { return uint64(now.Add(-ago).Unix()) }
+	h1, h2 := [32]byte{1}, [32]byte{2}
+
+	j := NewJournal(time.Hour, 3)
+	j.record(h1, JournalEvent{Type: TxAdded, SubPool: QueuedSubPool, BlockNum: 10, Time: at(2 * time.Hour)})
+	j.record(h2, JournalEvent{Type: TxAdded, SubPool: QueuedSubPool, BlockNum: 10, Time: at(30 * time.Minute)})
+	j.record(h1, JournalEvent{Type: TxPromoted, SubPool: PendingSubPool, BlockNum: 11, Time: at(20 * time.Minute)})
+	require.Equal(t, []JournalEvent{
+		{Type: TxAdded, SubPool: QueuedSubPool, BlockNum: 10, Time: at(2 * time.Hour)},
+		{Type: TxPromoted, SubPool: PendingSubPool, BlockNum: 11, Time: at(20 * time.Minute)},
+	}, j.unflushed(h1[:]))
+
+	// the first event is out of the retention period
+	require.NoError(t, db.Update(context.Background(), func(tx kv.RwTx) error { return j.flush(tx, now) }))
+	require.Empty(t, j.unflushed(h1[:]))
+	require.NoError(t, db.View(context.Background(), func(tx kv.Tx) error {
+		events, err := JournalEvents(tx, h1[:])
+		require.NoError(t, err)
+		require.Equal(t, []JournalEvent{{Type: TxPromoted, SubPool: PendingSubPool, BlockNum: 11, Time: at(20 * time.Minute)}}, events)
+		return nil
+	}))
+
+	// a new journal continues the sequence, the oldest events are beyond the limit
+	j = NewJournal(time.Hour, 3)
+	j.record(h1, JournalEvent{Type: TxMined, Reason: txpoolcfg.Mined, BlockNum: 12, Time: at(10 * time.Minute)})
+	j.record(h2, JournalEvent{Type: TxReplaced, Reason: txpoolcfg.ReplacedByHigherTip, BlockNum: 12, Time: at(10 * time.Minute)})
+	require.NoError(t, db.Update(context.Background(), func(tx kv.RwTx) error { return j.flush(tx, now) }))
+	require.NoError(t, db.View(context.Background(), func(tx kv.Tx) error {
+		events, err := JournalEvents(tx, h1[:])
+		require.NoError(t, err)
+		require.Equal(t, []JournalEvent{
+			{Type: TxPromoted, SubPool: PendingSubPool, BlockNum: 11, Time: at(20 * time.Minute)},
+			{Type: TxMined, Reason: txpoolcfg.Mined, BlockNum: 12, Time: at(10 * time.Minute)},
+		}, events)
+		events, err = JournalEvents(tx, h2[:])
+		require.NoError(t, err)
+		require.Equal(t, []JournalEvent{{Type: TxReplaced, Reason: txpoolcfg.ReplacedByHigherTip, BlockNum: 12, Time: at(10 * time.Minute)}}, events)
+		count := 0
+		require.NoError(t, tx.ForEach(kv.PoolEventByHash, nil, func(_, _ []byte) error { count++; return nil }))
+		require.Equal(t, 3, count)
+		return nil
+	}))
+}
+
+func TestConvertJournalEventType(t *testing.T) {
+	for eventType := TxAdded; eventType <= TxMined; eventType++ {
+		_, err := convertJournalEventType(eventType)
+		require.NoError(t, err)
+	}
+	// events are read from the db, so an unknown type is an error rather than a panic
+	_, err := convertJournalEventType(TxMined + 1)
+	require.Error(t, err)
+}
@@ -0,0 +1,95 @@
+/*
+   Copyright 2024 The Erigon contributors
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package txpool
+
+import (
+	"sort"
+
+	"github.com/holiman/uint256"
+
+	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
+)
+
+// orderingPolicy sorts the pending sub-pool, YieldBest and PeekBest return the transactions in its order.
+// It must be a strict weak ordering, the pending sub-pool is kept sorted with sort.Sort
+type orderingPolicy interface {
+	// prepare is called with the pending transactions before they are sorted
+	prepare(ms []*metaTx)
+	// better reports whether mt is yielded before than
+	better(mt, than *metaTx, pendingBaseFee uint256.Int) bool
+}
+
+func newOrderingPolicy(ordering txpoolcfg.Ordering) orderingPolicy {
+	switch ordering {
+	case txpoolcfg.TipOrdering:
+		return tipOrdering{}
+	case txpoolcfg.FIFOOrdering:
+		return fifoOrdering{}
+	default:
+		return localsFirstOrdering{}
+	}
+}
+
+// localsFirstOrdering ranks by the sub-pool markers, where local transactions are ahead of the remote ones, then by effective tip
+type localsFirstOrdering struct{}
+
+func (localsFirstOrdering) prepare([]*metaTx) {}
+
+func (localsFirstOrdering) better(mt, than *metaTx, pendingBaseFee uint256.Int) bool {
+	return mt.better(than, pendingBaseFee)
+}
+
+// tipOrdering is localsFirstOrdering which doesn't tell local transactions from the remote ones
+type tipOrdering struct{}
+
+func (tipOrdering) prepare([]*metaTx) {}
+
+func (tipOrdering) better(mt, than *metaTx, pendingBaseFee uint256.Int) bool {
+	return mt.betterByMarkers(than, mt.subPool&^IsLocal, than.subPool&^IsLocal, pendingBaseFee)
+}
+
+// fifoOrdering yields the transactions which can pay the base fee in the order of their arrival to the pool.
+// A transaction is never yielded before a lower nonce of its sender: it is ranked by the latest arrival among
+// the pending transactions of its sender up to its nonce
+type fifoOrdering struct{}
+
+func (fifoOrdering) prepare(ms []*metaTx) {
+	bySender := map[uint64][]*metaTx{}
+	for _, mt := range ms {
+		bySender[mt.Tx.SenderID] = append(bySender[mt.Tx.SenderID], mt)
+	}
+	for _, txs := range bySender {
+		sort.Slice(txs, func(i, j int) bool { return txs[i].Tx.Nonce < txs[j].Tx.Nonce })
+		var arrival uint64
+		for _, mt := range txs {
+			arrival = max(arrival, mt.arrival)
+			mt.effectiveArrival = arrival
+		}
+	}
+}
+
+func (fifoOrdering) better(mt, than *metaTx, pendingBaseFee uint256.Int) bool {
+	enoughFeeCap, thanEnoughFeeCap := mt.minFeeCap.Cmp(&pendingBaseFee) >= 0, than.minFeeCap.Cmp(&pendingBaseFee) >= 0
+	if enoughFeeCap != thanEnoughFeeCap {
+		return enoughFeeCap
+	}
+	if mt.effectiveArrival != than.effectiveArrival {
+		return mt.effectiveArrival < than.effectiveArrival
+	}
+	// the same effective arrival is only shared by the transactions of one sender
+	return mt.Tx.Nonce < than.Tx.Nonce
+}
@@ -95,6 +95,8 @@ type Pool interface {
 	FilterKnownIdHashes(tx kv.Tx, hashes types.Hashes) (unknownHashes types.Hashes, err error)
 	Started() bool
 	GetRlp(tx kv.Tx, hash []byte) ([]byte, error)
+	// IsPrivate check whether transaction with given Id hash must not be sent to the peers
+	IsPrivate(idHash []byte) bool
 
 	AddNewGoodPeer(peerID types.PeerID)
 }
@@ -129,9 +131,12 @@ type metaTx struct {
 	bestIndex                 int
 	worstIndex                int
 	timestamp                 uint64 // when it was added to pool
+	arrival                   uint64 // sequence number of the addition to pool, orders the same block arrivals
+	effectiveArrival          uint64 // arrival in the FIFO ordering, which keeps the nonce order of the sender
 	subPool                   SubPoolMarker
 	currentSubPool            SubPoolType
 	minedBlockNum             uint64
+	privateMaxBlock           uint64 // private transaction is never gossiped and is discarded after this block, 0 - public
 }
 
 func newMetaTx(slot *types.TxSlot, isLocal bool, timestamp uint64) *metaTx {
@@ -202,11 +207,14 @@ type TxPool struct {
 	unprocessedRemoteByHash map[string]int                                  // to reject duplicates
 	byHash                  map[string]*metaTx                              // tx_hash => tx : only those records not committed to db yet
 	discardReasonsLRU       *simplelru.LRU[string, txpoolcfg.DiscardReason] // tx_hash => discard_reason : non-persisted
+	journal                 *Journal                                        // persisted pool events, nil if disabled
+	privateTxs              map[string]*metaTx                              // tx_hash => tx : private transactions, never gossiped
 	pending                 *PendingPool
 	baseFee                 *SubPool
 	queued                  *SubPool
 	minedBlobTxsByBlock     map[uint64][]*metaTx             // (blockNum => slice): cache of recently mined blobs
 	minedBlobTxsByHash      map[string]*metaTx               // (hash => mt): map of recently mined blobs
+	blobsByHash             map[common.Hash][]blobRef        // versioned blob hash => blobs of the pooled or recently mined txs carrying it
 	isLocalLRU              *simplelru.LRU[string, struct{}] // tx_hash => is_local : to restore isLocal flag of unwinded transactions
 	newPendingTxs           chan types.Announcements         // notifications about new txs in Pending sub-pool
 	all                     *BySenderAndNonce                // senderID => (sorted map of tx nonce => *metaTx)
@@ -228,7 +236,10 @@ type TxPool struct {
 	isPostAgra              atomic.Bool
 	cancunTime              *uint64
 	isPostCancun            atomic.Bool
+	pragueTime              *uint64
+	isPostPrague            atomic.Bool
 	maxBlobsPerBlock        uint64
+	arrivals                uint64 // counter of the transactions added to pool, see metaTx.arrival
 	feeCalculator           FeeCalculator
 	logger                  log.Logger
 }
@@ -238,7 +249,7 @@ type FeeCalculator interface {
 }
 
 func New(newTxs chan types.Announcements, coreDB kv.RoDB, cfg txpoolcfg.Config, cache kvcache.Cache,
-	chainID uint256.Int, shanghaiTime, agraBlock, cancunTime *big.Int, maxBlobsPerBlock uint64,
+	chainID uint256.Int, shanghaiTime, agraBlock, cancunTime, pragueTime *big.Int, maxBlobsPerBlock uint64,
 	feeCalculator FeeCalculator, logger log.Logger,
 ) (*TxPool, error) {
 	localsHistory, err := simplelru.NewLRU[string, struct{}](10_000, nil)
@@ -267,11 +278,12 @@ func New(newTxs chan types.Announcements, coreDB kv.RoDB, cfg txpoolcfg.Config,
 		lock:                    lock,
 		lastSeenCond:            sync.NewCond(lock),
 		byHash:                  map[string]*metaTx{},
+		privateTxs:              map[string]*metaTx{},
 		isLocalLRU:              localsHistory,
 		discardReasonsLRU:       discardHistory,
 		all:                     byNonce,
 		recentlyConnectedPeers:  &recentlyConnectedPeers{},
-		pending:                 NewPendingSubPool(PendingSubPool, cfg.PendingSubPoolLimit),
+		pending:                 NewPendingSubPool(PendingSubPool, cfg.PendingSubPoolLimit, cfg.Ordering),
 		baseFee:                 NewSubPool(BaseFeeSubPool, cfg.BaseFeeSubPoolLimit),
 		queued:                  NewSubPool(QueuedSubPool, cfg.QueuedSubPoolLimit),
 		newPendingTxs:           newTxs,
@@ -284,11 +296,16 @@ func New(newTxs chan types.Announcements, coreDB kv.RoDB, cfg txpoolcfg.Config,
 		unprocessedRemoteByHash: map[string]int{},
 		minedBlobTxsByBlock:     map[uint64][]*metaTx{},
 		minedBlobTxsByHash:      map[string]*metaTx{},
+		blobsByHash:             map[common.Hash][]blobRef{},
 		maxBlobsPerBlock:        maxBlobsPerBlock,
 		feeCalculator:           feeCalculator,
 		logger:                  logger,
 	}
 
+	if cfg.Journal {
+		res.journal = NewJournal(cfg.JournalRetention, cfg.JournalLimit)
+	}
+
 	if shanghaiTime != nil {
 		if !shanghaiTime.IsUint64() {
 			return nil, errors.New("shanghaiTime overflow")
@@ -310,6 +327,13 @@ func New(newTxs chan types.Announcements, coreDB kv.RoDB, cfg txpoolcfg.Config,
 		cancunTimeU64 := cancunTime.Uint64()
 		res.cancunTime = &cancunTimeU64
 	}
+	if pragueTime != nil {
+		if !pragueTime.IsUint64() {
+			return nil, errors.New("pragueTime overflow")
+		}
+		pragueTimeU64 := pragueTime.Uint64()
+		res.pragueTime = &pragueTimeU64
+	}
 
 	return res, nil
 }
@@ -477,6 +501,8 @@ func (p *TxPool) OnNewBlock(ctx context.Context, stateChanges *remote.StateChang
 		return err
 	}
 
+	p.discardExpiredPrivateLocked(block)
+
 	var announcements types.Announcements
 
 	announcements, err = p.addTxsOnNewBlock(block, cacheView, stateChanges, p.senders, unwindTxs, /* newTxs */
@@ -587,7 +613,7 @@ func (p *TxPool) AppendLocalAnnouncements(types []byte, sizes []uint32, hashes [
 	p.lock.Lock()
 	defer p.lock.Unlock()
 	for hash, txn := range p.byHash {
-		if txn.subPool&IsLocal == 0 {
+		if txn.subPool&IsLocal == 0 || txn.privateMaxBlock != 0 {
 			continue
 		}
 		types = append(types, txn.Tx.Type)
@@ -695,6 +721,14 @@ func (p *TxPool) getCachedBlobTxnLocked(tx kv.Tx, hash []byte) (*metaTx, error)
 	return newMetaTx(txSlot, false, 0), nil
 }
 
+// IsPrivate - private transactions are never announced to the peers and never sent in the pooled transactions responses
+func (p *TxPool) IsPrivate(idHash []byte) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	_, ok := p.privateTxs[string(idHash)]
+	return ok
+}
+
 func (p *TxPool) IsLocal(idHash []byte) bool {
 	hashS := string(idHash)
 	p.lock.Lock()
@@ -762,7 +796,7 @@ func (p *TxPool) best(n uint16, txs *types.TxsRlp, tx kv.Tx, onTopOf, availableG
 		// make sure we have enough gas in the caller to add this transaction.
 		// not an exact science using intrinsic gas but as close as we could hope for at
 		// this stage
-		intrinsicGas, _ := txpoolcfg.CalcIntrinsicGas(uint64(mt.Tx.DataLen), uint64(mt.Tx.DataNonZeroLen), nil, mt.Tx.Creation, true, true, isShanghai)
+		intrinsicGas, _ := txpoolcfg.CalcIntrinsicGas(uint64(mt.Tx.DataLen), uint64(mt.Tx.DataNonZeroLen), uint64(len(mt.Tx.Authorizations)), nil, mt.Tx.Creation, true, true, isShanghai)
 		if intrinsicGas > availableGas {
 			// we might find another TX with a low enough intrinsic gas to include so carry on
 			continue
@@ -888,6 +922,21 @@ func (p *TxPool) validateTx(txn *types.TxSlot, isLocal bool, stateCache kvcache.
 		}
 	}
 
+	if txn.Type == types.SetCodeTxType {
+		if !p.isPrague() {
+			return txpoolcfg.TypeNotActivated
+		}
+		if txn.Creation {
+			return txpoolcfg.CreateSetCodeTxn
+		}
+		if len(txn.Authorizations) == 0 {
+			return txpoolcfg.NoAuthorizations
+		}
+		if txn.Traced {
+			p.traceStaleAuthorizations(txn, stateCache)
+		}
+	}
+
 	// Drop non-local transactions under our own minimal accepted gas price or tip
 	if !isLocal && uint256.NewInt(p.cfg.MinFeeCap).Cmp(&txn.FeeCap) == 1 {
 		if txn.Traced {
@@ -895,7 +944,7 @@ func (p *TxPool) validateTx(txn *types.TxSlot, isLocal bool, stateCache kvcache.
 		}
 		return txpoolcfg.UnderPriced
 	}
-	gas, reason := txpoolcfg.CalcIntrinsicGas(uint64(txn.DataLen), uint64(txn.DataNonZeroLen), nil, txn.Creation, true, true, isShanghai)
+	gas, reason := txpoolcfg.CalcIntrinsicGas(uint64(txn.DataLen), uint64(txn.DataNonZeroLen), uint64(len(txn.Authorizations)), nil, txn.Creation, true, true, isShanghai)
 	if txn.Traced {
 		p.logger.Info(fmt.Sprintf("TX TRACING: validateTx intrinsic gas idHash=%x gas=%d", txn.IDHash, gas))
 	}
@@ -937,6 +986,23 @@ func (p *TxPool) validateTx(txn *types.TxSlot, isLocal bool, stateCache kvcache.
 	return txpoolcfg.Success
 }
 
+// traceStaleAuthorizations logs the EIP-7702 authorizations of txn which can't be applied as of the current state.
+// Execution skips such authorizations without invalidating the transaction, so they are no reason to discard it:
+// otherwise an authority could evict a transaction sponsored for it just by bumping its own nonce.
+func (p *TxPool) traceStaleAuthorizations(txn *types.TxSlot, stateCache kvcache.CacheView) {
+	for i := range txn.Authorizations {
+		auth := &txn.Authorizations[i]
+		if (!auth.ChainID.IsZero() && !auth.ChainID.Eq(&p.chainID)) || auth.Nonce == math.MaxUint64 || auth.Authority == nil {
+			p.logger.Info(fmt.Sprintf("TX TRACING: validateTx invalid authorization idHash=%x index=%d chainId=%d nonce=%d", txn.IDHash, i, &auth.ChainID, auth.Nonce))
+			continue
+		}
+		authorityNonce, _, _ := p.senders.accountInfo(stateCache, *auth.Authority)
+		if authorityNonce > auth.Nonce {
+			p.logger.Info(fmt.Sprintf("TX TRACING: validateTx authorization nonce too low idHash=%x authority=%x nonce in state=%d, auth.nonce=%d", txn.IDHash, *auth.Authority, authorityNonce, auth.Nonce))
+		}
+	}
+}
+
 var maxUint256 = new(uint256.Int).SetAllOne()
 
 // Sender should have enough balance for: gasLimit x feeCap + blobGas x blobFeeCap + transferred_value
@@ -1056,6 +1122,31 @@ func (p *TxPool) isCancun() bool {
 	return activated
 }
 
+func (p *TxPool) isPrague() bool {
+	// once this flag has been set for the first time we no longer need to check the timestamp
+	set := p.isPostPrague.Load()
+	if set {
+		return true
+	}
+	if p.pragueTime == nil {
+		return false
+	}
+	pragueTime := *p.pragueTime
+
+	// a zero here means Prague is always active
+	if pragueTime == 0 {
+		p.isPostPrague.Swap(true)
+		return true
+	}
+
+	now := time.Now().Unix()
+	activated := uint64(now) >= pragueTime
+	if activated {
+		p.isPostPrague.Swap(true)
+	}
+	return activated
+}
+
 // Check that the serialized txn should not exceed a certain max size
 func (p *TxPool) ValidateSerializedTxn(serializedTxn []byte) error {
 	const (
@@ -1169,6 +1260,23 @@ func fillDiscardReasons(reasons []txpoolcfg.DiscardReason, newTxs types.TxSlots,
 }
 
 func (p *TxPool) AddLocalTxs(ctx context.Context, newTransactions types.TxSlots, tx kv.Tx) ([]txpoolcfg.DiscardReason, error) {
+	return p.addLocalTxs(ctx, newTransactions, 0, tx)
+}
+
+// AddPrivateTxs - adds local transactions which are never gossiped to the peers, they are available for
+// mining until maxBlockNum and discarded after it
+func (p *TxPool) AddPrivateTxs(ctx context.Context, newTransactions types.TxSlots, maxBlockNum uint64, tx kv.Tx) ([]txpoolcfg.DiscardReason, error) {
+	if maxBlockNum <= p.lastSeenBlock.Load() {
+		reasons := make([]txpoolcfg.DiscardReason, len(newTransactions.Txs))
+		for i := range reasons {
+			reasons[i] = txpoolcfg.PrivateTxExpired
+		}
+		return reasons, nil
+	}
+	return p.addLocalTxs(ctx, newTransactions, maxBlockNum, tx)
+}
+
+func (p *TxPool) addLocalTxs(ctx context.Context, newTransactions types.TxSlots, privateMaxBlock uint64, tx kv.Tx) ([]txpoolcfg.DiscardReason, error) {
 	coreDb, cache := p.coreDBWithCache()
 	coreTx, err := coreDb.BeginRo(ctx)
 	if err != nil {
@@ -1214,6 +1322,14 @@ func (p *TxPool) AddLocalTxs(ctx context.Context, newTransactions types.TxSlots,
 			if txn.Traced {
 				p.logger.Info(fmt.Sprintf("TX TRACING: AddLocalTxs promotes idHash=%x, senderId=%d", txn.IDHash, txn.SenderID))
 			}
+			if privateMaxBlock != 0 {
+				if mt, ok := p.byHash[string(txn.IDHash[:])]; ok {
+					mt.privateMaxBlock = privateMaxBlock
+					p.privateTxs[string(txn.IDHash[:])] = mt
+					// private transactions are not persisted, so they are not remembered as recent local ones either
+					p.isLocalLRU.Remove(string(txn.IDHash[:]))
+				}
+			}
 			p.promoted.Append(txn.Type, txn.Size, txn.IDHash[:])
 		}
 	}
@@ -1263,6 +1379,8 @@ func (p *TxPool) addTxs(blockNum uint64, cacheView kvcache.CacheView, senders *s
 			continue
 		}
 		mt := newMetaTx(txn, newTxs.IsLocal[i], blockNum)
+		p.arrivals++
+		mt.arrival = p.arrivals
 		if reason := p.addLocked(mt, &announcements); reason != txpoolcfg.NotSet {
 			discardReasons[i] = reason
 			continue
@@ -1314,6 +1432,8 @@ func (p *TxPool) addTxsOnNewBlock(blockNum uint64, cacheView kvcache.CacheView,
 			continue
 		}
 		mt := newMetaTx(txn, newTxs.IsLocal[i], blockNum)
+		p.arrivals++
+		mt.arrival = p.arrivals
 		if reason := p.addLocked(mt, &announcements); reason != txpoolcfg.NotSet {
 			p.discardLocked(mt, reason)
 			continue
@@ -1429,6 +1549,7 @@ func (p *TxPool) addLocked(mt *metaTx, announcements *types.Announcements) txpoo
 
 	hashStr := string(mt.Tx.IDHash[:])
 	p.byHash[hashStr] = mt
+	p.addBlobsLocked(mt)
 
 	if replaced := p.all.replaceOrInsert(mt, p.logger); replaced != nil {
 		if assert.Enable {
@@ -1446,6 +1567,8 @@ func (p *TxPool) addLocked(mt *metaTx, announcements *types.Announcements) txpoo
 		p.totalBlobsInPool.Store(t + (uint64(len(mt.Tx.BlobHashes))))
 	}
 
+	p.journalLocked(mt, TxAdded, QueuedSubPool, txpoolcfg.NotSet)
+
 	// Remove from mined cache as we are now "resurrecting" it to a sub-pool
 	p.deleteMinedBlobTxn(hashStr)
 	return txpoolcfg.NotSet
@@ -1456,6 +1579,8 @@ func (p *TxPool) addLocked(mt *metaTx, announcements *types.Announcements) txpoo
 func (p *TxPool) discardLocked(mt *metaTx, reason txpoolcfg.DiscardReason) {
 	hashStr := string(mt.Tx.IDHash[:])
 	delete(p.byHash, hashStr)
+	delete(p.privateTxs, hashStr)
+	p.deleteBlobsLocked(mt)
 	p.deletedTxs = append(p.deletedTxs, mt)
 	p.all.delete(mt, reason, p.logger)
 	p.discardReasonsLRU.Add(hashStr, reason)
@@ -1463,6 +1588,114 @@ func (p *TxPool) discardLocked(mt *metaTx, reason txpoolcfg.DiscardReason) {
 		t := p.totalBlobsInPool.Load()
 		p.totalBlobsInPool.Store(t - uint64(len(mt.Tx.BlobHashes)))
 	}
+	switch reason {
+	case txpoolcfg.ReplacedByHigherTip:
+		p.journalLocked(mt, TxReplaced, 0, reason)
+	case txpoolcfg.Mined:
+		p.journalLocked(mt, TxMined, 0, reason)
+	default:
+		p.journalLocked(mt, TxDiscarded, 0, reason)
+	}
+}
+
+// discardExpiredPrivateLocked - discards the private transactions which weren't mined until their max block
+func (p *TxPool) discardExpiredPrivateLocked(blockNum uint64) {
+	for _, mt := range p.privateTxs {
+		if mt.privateMaxBlock > blockNum {
+			continue
+		}
+		switch mt.currentSubPool {
+		case PendingSubPool:
+			p.pending.Remove(mt, "private-expired", p.logger)
+		case BaseFeeSubPool:
+			p.baseFee.Remove(mt, "private-expired", p.logger)
+		case QueuedSubPool:
+			p.queued.Remove(mt, "private-expired", p.logger)
+		}
+		p.discardLocked(mt, txpoolcfg.PrivateTxExpired)
+	}
+}
+
+func (p *TxPool) journalLocked(mt *metaTx, eventType JournalEventType, subPool SubPoolType, reason txpoolcfg.DiscardReason) {
+	if p.journal == nil {
+		return
+	}
+	p.journal.record(mt.Tx.IDHash, JournalEvent{
+		Type:     eventType,
+		SubPool:  subPool,
+		Reason:   reason,
+		BlockNum: p.lastSeenBlock.Load(),
+		Time:     uint64(time.Now().Unix()),
+	})
+}
+
+// TransactionStatusHistory - pool events of the transaction, the oldest first
+func (p *TxPool) TransactionStatusHistory(ctx context.Context, db kv.RoDB, hash []byte) ([]JournalEvent, error) {
+	if p.journal == nil {
+		return nil, ErrJournalDisabled
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	// flush commits under the lock: the read tx must be opened under it too, otherwise the events flushed
+	// in between are neither visible to tx nor unflushed anymore
+	tx, err := db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	events, err := JournalEvents(tx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return append(events, p.journal.unflushed(hash)...), nil
+}
+
+// GetBlobs - blobs and KZG proofs by versioned blob hash, from pooled and recently mined blob transactions.
+// Both results are aligned with blobHashes, nil for unknown hashes.
+func (p *TxPool) GetBlobs(blobHashes []common.Hash) (blobs [][]byte, proofs [][]byte) {
+	blobs, proofs = make([][]byte, len(blobHashes)), make([][]byte, len(blobHashes))
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for i, h := range blobHashes {
+		if refs := p.blobsByHash[h]; len(refs) > 0 {
+			ref := refs[len(refs)-1]
+			blobs[i], proofs[i] = common.Copy(ref.mt.Tx.Blobs[ref.index]), common.Copy(ref.mt.Tx.Proofs[ref.index][:])
+		}
+	}
+	return blobs, proofs
+}
+
+// blobRef - blob of a pooled or recently mined transaction, see TxPool.blobsByHash
+type blobRef struct {
+	mt    *metaTx
+	index int // in mt.Tx.Blobs and mt.Tx.Proofs
+}
+
+func (p *TxPool) addBlobsLocked(mt *metaTx) {
+	txn := mt.Tx
+	if txn.Type != types.BlobTxType || len(txn.Blobs) != len(txn.BlobHashes) || len(txn.Proofs) != len(txn.BlobHashes) {
+		return // mined transactions are usually known without blobs
+	}
+	for i, h := range txn.BlobHashes {
+		p.blobsByHash[h] = append(p.blobsByHash[h], blobRef{mt: mt, index: i})
+	}
+}
+
+func (p *TxPool) deleteBlobsLocked(mt *metaTx) {
+	for _, h := range mt.Tx.BlobHashes {
+		// the same blob may be in other transactions, keep their entries
+		refs := p.blobsByHash[h]
+		for i := len(refs) - 1; i >= 0; i-- {
+			if refs[i].mt == mt {
+				refs = append(refs[:i], refs[i+1:]...)
+			}
+		}
+		if len(refs) == 0 {
+			delete(p.blobsByHash, h)
+		} else {
+			p.blobsByHash[h] = refs
+		}
+	}
 }
 
 // Cache recently mined blobs in anticipation of reorg, delete finalized ones
@@ -1473,6 +1706,7 @@ func (p *TxPool) processMinedFinalizedBlobs(coreTx kv.Tx, minedTxs []*types.TxSl
 		// delete individual hashes
 		for _, mt := range p.minedBlobTxsByBlock[finalizedBlock] {
 			delete(p.minedBlobTxsByHash, string(mt.Tx.IDHash[:]))
+			p.deleteBlobsLocked(mt)
 		}
 		// delete the map entry for this block num
 		delete(p.minedBlobTxsByBlock, finalizedBlock)
@@ -1489,6 +1723,7 @@ func (p *TxPool) processMinedFinalizedBlobs(coreTx kv.Tx, minedTxs []*types.TxSl
 			p.minedBlobTxsByBlock[minedBlock] = append(p.minedBlobTxsByBlock[minedBlock], mt)
 			mt.bestIndex = len(p.minedBlobTxsByBlock[minedBlock]) - 1
 			p.minedBlobTxsByHash[string(txn.IDHash[:])] = mt
+			p.addBlobsLocked(mt)
 		}
 	}
 	return nil
@@ -1506,6 +1741,7 @@ func (p *TxPool) deleteMinedBlobTxn(hash string) {
 	}
 	p.minedBlobTxsByBlock[mt.minedBlockNum] = p.minedBlobTxsByBlock[mt.minedBlockNum][:l-1]
 	delete(p.minedBlobTxsByHash, hash)
+	p.deleteBlobsLocked(mt)
 }
 
 func (p *TxPool) NonceFromAddress(addr [20]byte) (nonce uint64, inPool bool) {
@@ -1706,8 +1942,11 @@ func (p *TxPool) promote(pendingBaseFee uint64, pendingBlobFee uint64, announcem
 			tx := p.pending.PopWorst()
 			announcements.Append(tx.Tx.Type, tx.Tx.Size, tx.Tx.IDHash[:])
 			p.baseFee.Add(tx, "demote-pending", logger)
+			p.journalLocked(tx, TxDemoted, BaseFeeSubPool, txpoolcfg.NotSet)
 		} else {
-			p.queued.Add(p.pending.PopWorst(), "demote-pending", logger)
+			tx := p.pending.PopWorst()
+			p.queued.Add(tx, "demote-pending", logger)
+			p.journalLocked(tx, TxDemoted, QueuedSubPool, txpoolcfg.NotSet)
 		}
 	}
 
@@ -1716,11 +1955,14 @@ func (p *TxPool) promote(pendingBaseFee uint64, pendingBlobFee uint64, announcem
 		tx := p.baseFee.PopBest()
 		announcements.Append(tx.Tx.Type, tx.Tx.Size, tx.Tx.IDHash[:])
 		p.pending.Add(tx, logger)
+		p.journalLocked(tx, TxPromoted, PendingSubPool, txpoolcfg.NotSet)
 	}
 
 	// Demote worst transactions that do not qualify for base fee pool anymore, to queued sub pool, or discard
 	for worst := p.baseFee.Worst(); p.baseFee.Len() > 0 && worst.subPool < BaseFeePoolBits; worst = p.baseFee.Worst() {
-		p.queued.Add(p.baseFee.PopWorst(), "demote-base", logger)
+		tx := p.baseFee.PopWorst()
+		p.queued.Add(tx, "demote-base", logger)
+		p.journalLocked(tx, TxDemoted, QueuedSubPool, txpoolcfg.NotSet)
 	}
 
 	// Promote best transactions from the queued pool to either pending or base fee pool, while they qualify
@@ -1729,8 +1971,11 @@ func (p *TxPool) promote(pendingBaseFee uint64, pendingBlobFee uint64, announcem
 			tx := p.queued.PopBest()
 			announcements.Append(tx.Tx.Type, tx.Tx.Size, tx.Tx.IDHash[:])
 			p.pending.Add(tx, logger)
+			p.journalLocked(tx, TxPromoted, PendingSubPool, txpoolcfg.NotSet)
 		} else {
-			p.baseFee.Add(p.queued.PopBest(), "promote-queued", logger)
+			tx := p.queued.PopBest()
+			p.baseFee.Add(tx, "promote-queued", logger)
+			p.journalLocked(tx, TxPromoted, BaseFeeSubPool, txpoolcfg.NotSet)
 		}
 	}
 
@@ -1856,6 +2101,9 @@ func MainLoop(ctx context.Context, db kv.RwDB, p *TxPool, newTxs chan types.Anno
 				if err := db.View(ctx, func(tx kv.Tx) error {
 					for i := 0; i < announcements.Len(); i++ {
 						t, size, hash := announcements.At(i)
+						if p.IsPrivate(hash) {
+							continue
+						}
 						slotRlp, err := p.GetRlp(tx, hash)
 						if err != nil {
 							return err
@@ -1995,6 +2243,9 @@ func (p *TxPool) flushLocked(tx kv.RwTx) (err error) {
 			if err := tx.Delete(kv.PoolTransaction, idHash); err != nil {
 				return err
 			}
+			if err := tx.Delete(kv.PoolArrival, idHash); err != nil {
+				return err
+			}
 		}
 		p.deletedTxs[i] = nil // for gc
 	}
@@ -2013,7 +2264,8 @@ func (p *TxPool) flushLocked(tx kv.RwTx) (err error) {
 
 	v := make([]byte, 0, 1024)
 	for txHash, metaTx := range p.byHash {
-		if metaTx.Tx.Rlp == nil {
+		// private transactions are not persisted: after restart they would be re-announced as the usual local ones
+		if metaTx.Tx.Rlp == nil || metaTx.privateMaxBlock != 0 {
 			continue
 		}
 		v = common.EnsureEnoughSize(v, 20+len(metaTx.Tx.Rlp))
@@ -2035,6 +2287,11 @@ func (p *TxPool) flushLocked(tx kv.RwTx) (err error) {
 			if err := tx.Put(kv.PoolTransaction, []byte(txHash), v); err != nil {
 				return err
 			}
+			// restored transactions get new arrivals in the persisted order, see fromDB
+			binary.BigEndian.PutUint64(encID, metaTx.arrival)
+			if err := tx.Put(kv.PoolArrival, []byte(txHash), encID); err != nil {
+				return err
+			}
 		}
 		metaTx.Tx.Rlp = nil
 	}
@@ -2050,6 +2307,11 @@ func (p *TxPool) flushLocked(tx kv.RwTx) (err error) {
 	if err := PutLastSeenBlock(tx, p.lastSeenBlock.Load(), encID); err != nil {
 		return err
 	}
+	if p.journal != nil {
+		if err := p.journal.flush(tx, time.Now()); err != nil {
+			return err
+		}
+	}
 
 	// clean - in-memory data structure as later as possible - because if during this Tx will happen error,
 	// DB will stay consistent but some in-memory structures may be already cleaned, and retry will not work
@@ -2101,11 +2363,17 @@ func (p *TxPool) fromDB(ctx context.Context, tx kv.Tx, coreTx kv.Tx) error {
 		p.isLocalLRU.Add(string(v), struct{}{})
 	}
 
-	txs := types.TxSlots{}
+	type persistedTx struct {
+		txn     *types.TxSlot
+		sender  common.Address
+		isLocal bool
+		arrival uint64
+	}
+	var persisted []persistedTx
+	var lastArrival uint64
 	parseCtx := types.NewTxParseContext(p.chainID)
 	parseCtx.WithSender(false)
 
-	i := 0
 	it, err = tx.Range(kv.PoolTransaction, nil, nil)
 	if err != nil {
 		return err
@@ -2135,11 +2403,29 @@ func (p *TxPool) fromDB(ctx context.Context, tx kv.Tx, coreTx kv.Tx) error {
 		if reason := p.validateTx(txn, isLocalTx, cacheView); reason != txpoolcfg.NotSet && reason != txpoolcfg.Success {
 			return nil // TODO: Clarify - if one of the txs has the wrong reason, no pooled txs!
 		}
-		txs.Resize(uint(i + 1))
-		txs.Txs[i] = txn
-		txs.IsLocal[i] = isLocalTx
-		copy(txs.Senders.At(i), addr[:])
-		i++
+		// transactions persisted before arrivals were stored go after the others
+		arrival := uint64(math.MaxUint64)
+		arrivalV, err := tx.GetOne(kv.PoolArrival, k)
+		if err != nil {
+			return err
+		}
+		if len(arrivalV) == 8 {
+			arrival = binary.BigEndian.Uint64(arrivalV)
+			lastArrival = max(lastArrival, arrival)
+		}
+		persisted = append(persisted, persistedTx{txn: txn, sender: addr, isLocal: isLocalTx, arrival: arrival})
+	}
+
+	// addTxs numbers the arrivals in the order of txs: keep the original one, so that the FIFO ordering survives
+	// restarts. New arrivals continue after the persisted ones, which keeps them comparable on the next restart.
+	sort.SliceStable(persisted, func(i, j int) bool { return persisted[i].arrival < persisted[j].arrival })
+	p.arrivals = max(p.arrivals, lastArrival)
+	txs := types.TxSlots{}
+	txs.Resize(uint(len(persisted)))
+	for i, ptx := range persisted {
+		txs.Txs[i] = ptx.txn
+		txs.IsLocal[i] = ptx.isLocal
+		copy(txs.Senders.At(i), ptx.sender[:])
 	}
 
 	var pendingBaseFee, pendingBlobFee, minBlobGasPrice, blockGasLimit uint64
@@ -2405,6 +2691,11 @@ func (sc *sendersBatch) info(cacheView kvcache.CacheView, id uint64) (nonce uint
 	if !ok {
 		panic("must not happen")
 	}
+	return sc.accountInfo(cacheView, addr)
+}
+
+// accountInfo reads nonce and balance of any account, not necessarily a sender known to the pool
+func (sc *sendersBatch) accountInfo(cacheView kvcache.CacheView, addr common.Address) (nonce uint64, balance uint256.Int, err error) {
 	encoded, err := cacheView.Get(addr.Bytes())
 	if err != nil {
 		return 0, emptySender.balance, err
@@ -2597,8 +2888,8 @@ type PendingPool struct {
 	t     SubPoolType
 }
 
-func NewPendingSubPool(t SubPoolType, limit int) *PendingPool {
-	return &PendingPool{limit: limit, t: t, best: &bestSlice{ms: []*metaTx{}}, worst: &WorstQueue{ms: []*metaTx{}}}
+func NewPendingSubPool(t SubPoolType, limit int, ordering txpoolcfg.Ordering) *PendingPool {
+	return &PendingPool{limit: limit, t: t, best: &bestSlice{ms: []*metaTx{}, ordering: newOrderingPolicy(ordering)}, worst: &WorstQueue{ms: []*metaTx{}}}
 }
 
 // bestSlice - is similar to best queue, but uses a linear structure with O(n log n) sort complexity and
@@ -2606,6 +2897,7 @@ func NewPendingSubPool(t SubPoolType, limit int) *PendingPool {
 type bestSlice struct {
 	ms             []*metaTx
 	pendingBaseFee uint64
+	ordering       orderingPolicy
 }
 
 func (s *bestSlice) Len() int { return len(s.ms) }
@@ -2614,7 +2906,7 @@ func (s *bestSlice) Swap(i, j int) {
 	s.ms[i].bestIndex, s.ms[j].bestIndex = i, j
 }
 func (s *bestSlice) Less(i, j int) bool {
-	return s.ms[i].better(s.ms[j], *uint256.NewInt(s.pendingBaseFee))
+	return s.ordering.better(s.ms[i], s.ms[j], *uint256.NewInt(s.pendingBaseFee))
 }
 func (s *bestSlice) UnsafeRemove(i *metaTx) {
 	s.Swap(i.bestIndex, len(s.ms)-1)
@@ -2631,6 +2923,7 @@ func (p *PendingPool) EnforceWorstInvariants() {
 	heap.Init(p.worst)
 }
 func (p *PendingPool) EnforceBestInvariants() {
+	p.best.ordering.prepare(p.best.ms)
 	sort.Sort(p.best)
 }
 
@@ -2770,8 +3063,11 @@ type BestQueue struct {
 // it compares the effective tip (for P), nonceDistance (for both P,Q)
 // minFeeCap (for B), and cumulative balance distance (for P, Q)
 func (mt *metaTx) better(than *metaTx, pendingBaseFee uint256.Int) bool {
-	subPool := mt.subPool
-	thanSubPool := than.subPool
+	return mt.betterByMarkers(than, mt.subPool, than.subPool, pendingBaseFee)
+}
+
+// betterByMarkers is better with the given sub-pool markers of mt and than
+func (mt *metaTx) betterByMarkers(than *metaTx, subPool, thanSubPool SubPoolMarker, pendingBaseFee uint256.Int) bool {
 	if mt.minFeeCap.Cmp(&pendingBaseFee) >= 0 {
 		subPool |= EnoughFeeCapBlock
 	}
@@ -23,7 +23,6 @@ import (
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/kvcache"
 	"github.com/ledgerwatch/erigon-lib/kv/memdb"
-	"github.com/ledgerwatch/erigon-lib/rlp"
 	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
 	"github.com/ledgerwatch/erigon-lib/types"
 )
@@ -49,7 +48,7 @@ func init() {
 			t.Parallel()
 			assert := assert.New(t)
 			{
-				sub := NewPendingSubPool(PendingSubPool, 1024)
+				sub := NewPendingSubPool(PendingSubPool, 1024, txpoolcfg.LocalsFirstOrdering)
 				for _, i := range in {
 					sub.Add(&metaTx{subPool: SubPoolMarker(i & 0b1111), Tx: &TxSlot{nonce: 1, value: *uint256.NewInt(1)}})
 				}
@@ -222,43 +221,6 @@ func poolsFromFuzzBytes(rawTxNonce, rawValues, rawTips, rawFeeCap, rawSender []b
 	return sendersInfo, senderIDs, txs, true
 }
 
-// fakeRlpTx add anything what identifying tx to `data` to make hash unique
-func fakeRlpTx(slot *types.TxSlot, data []byte) []byte {
-	dataLen := rlp.U64Len(1) + //chainID
-		rlp.U64Len(slot.Nonce) + rlp.U256Len(&slot.Tip) + rlp.U256Len(&slot.FeeCap) +
-		rlp.U64Len(0) + // gas
-		rlp.StringLen([]byte{}) + // dest addr
-		rlp.U256Len(&slot.Value) +
-		rlp.StringLen(data) + // data
-		rlp.ListPrefixLen(0) + //access list
-		+3 // v,r,s
-
-	buf := make([]byte, 1+rlp.ListPrefixLen(dataLen)+dataLen)
-	buf[0] = types.DynamicFeeTxType
-	p := 1
-	p += rlp.EncodeListPrefix(dataLen, buf[p:])
-	p += rlp.EncodeU64(1, buf[p:]) //chainID
-	p += rlp.EncodeU64(slot.Nonce, buf[p:])
-	bb := bytes.NewBuffer(buf[p:p])
-	_ = slot.Tip.EncodeRLP(bb)
-	p += rlp.U256Len(&slot.Tip)
-	bb = bytes.NewBuffer(buf[p:p])
-	_ = slot.FeeCap.EncodeRLP(bb)
-	p += rlp.U256Len(&slot.FeeCap)
-	p += rlp.EncodeU64(0, buf[p:])           //gas
-	p += rlp.EncodeString([]byte{}, buf[p:]) //destrination addr
-	bb = bytes.NewBuffer(buf[p:p])
-	_ = slot.Value.EncodeRLP(bb)
-	p += rlp.U256Len(&slot.Value)
-	p += rlp.EncodeString(data, buf[p:])  //data
-	p += rlp.EncodeListPrefix(0, buf[p:]) // access list
-	p += rlp.EncodeU64(1, buf[p:])        //v
-	p += rlp.EncodeU64(1, buf[p:])        //r
-	p += rlp.EncodeU64(1, buf[p:])        //s
-	_ = p
-	return buf[:]
-}
-
 func iterateSubPoolUnordered(subPool *SubPool, f func(tx *metaTx)) {
 	for i := 0; i < subPool.best.Len(); i++ {
 		f((subPool.best.ms)[i])
@@ -318,7 +280,7 @@ func FuzzOnNewBlocks(f *testing.F) {
 
 		cfg := txpoolcfg.DefaultConfig
 		sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-		pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+		pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
 		assert.NoError(err)
 
 		err = pool.Start(ctx, db)
@@ -544,7 +506,7 @@ func FuzzOnNewBlocks(f *testing.F) {
 		check(p2pReceived, types.TxSlots{}, "after_flush")
 		checkNotify(p2pReceived, types.TxSlots{}, "after_flush")
 
-		p2, err := New(ch, coreDB, txpoolcfg.DefaultConfig, sendersCache, *u256.N1, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+		p2, err := New(ch, coreDB, txpoolcfg.DefaultConfig, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
 		assert.NoError(err)
 
 		p2.senders = pool.senders // senders are not persisted
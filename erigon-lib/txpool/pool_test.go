@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"testing"
 
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
@@ -42,6 +43,7 @@ import (
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/kvcache"
 	"github.com/ledgerwatch/erigon-lib/kv/memdb"
+	"github.com/ledgerwatch/erigon-lib/rlp"
 	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
 	"github.com/ledgerwatch/erigon-lib/types"
 )
@@ -55,7 +57,7 @@ func TestNonceFromAddress(t *testing.T) {
 
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
 	assert.NoError(err)
 	require.True(pool != nil)
 	ctx := context.Background()
@@ -176,7 +178,7 @@ func TestReplaceWithHigherFee(t *testing.T) {
 
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
 	assert.NoError(err)
 	require.NotEqual(nil, pool)
 	ctx := context.Background()
@@ -295,7 +297,7 @@ func TestReverseNonces(t *testing.T) {
 
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
 	assert.NoError(err)
 	require.True(pool != nil)
 	ctx := context.Background()
@@ -424,7 +426,7 @@ func TestTxPoke(t *testing.T) {
 
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
 	assert.NoError(err)
 	require.True(pool != nil)
 	ctx := context.Background()
@@ -635,7 +637,7 @@ func TestShanghaiIntrinsicGas(t *testing.T) {
 
 	for name, c := range cases {
 		t.Run(name, func(t *testing.T) {
-			gas, reason := txpoolcfg.CalcIntrinsicGas(c.dataLen, c.dataNonZeroLen, nil, c.creation, true, true, c.isShanghai)
+			gas, reason := txpoolcfg.CalcIntrinsicGas(c.dataLen, c.dataNonZeroLen, 0, nil, c.creation, true, true, c.isShanghai)
 			if reason != txpoolcfg.Success {
 				t.Errorf("expected success but got reason %v", reason)
 			}
@@ -690,7 +692,7 @@ func TestShanghaiValidateTx(t *testing.T) {
 			}
 
 			cache := &kvcache.DummyCache{}
-			pool, err := New(ch, coreDB, cfg, cache, *u256.N1, shanghaiTime, nil /* agraBlock */, nil /* cancunTime */, fixedgas.DefaultMaxBlobsPerBlock, nil, logger)
+			pool, err := New(ch, coreDB, cfg, cache, *u256.N1, shanghaiTime, nil /* agraBlock */, nil /* cancunTime */, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, logger)
 			asrt.NoError(err)
 			ctx := context.Background()
 			tx, err := coreDB.BeginRw(ctx)
@@ -729,6 +731,136 @@ func TestShanghaiValidateTx(t *testing.T) {
 	}
 }
 
+func TestSetCodeValidateTx(t *testing.T) {
+	asrt := assert.New(t)
+	authority := common.Address{0x7a}
+	validAuth := func() types.Authorization {
+		return types.Authorization{ChainID: *u256.N1, Address: common.Address{0xaa}, Nonce: 3, Authority: &authority}
+	}
+	tests := map[string]struct {
+		expected txpoolcfg.DiscardReason
+		isPrague bool
+		creation bool
+		auths    func() []types.Authorization
+	}{
+		"valid": {
+			expected: txpoolcfg.Success,
+			isPrague: true,
+			auths:    func() []types.Authorization { return []types.Authorization{validAuth()} },
+		},
+		"valid on any chain": {
+			expected: txpoolcfg.Success,
+			isPrague: true,
+			auths: func() []types.Authorization {
+				auth := validAuth()
+				auth.ChainID.Clear()
+				return []types.Authorization{auth}
+			},
+		},
+		"no prague": {
+			expected: txpoolcfg.TypeNotActivated,
+			auths:    func() []types.Authorization { return []types.Authorization{validAuth()} },
+		},
+		"creation": {
+			expected: txpoolcfg.CreateSetCodeTxn,
+			isPrague: true,
+			creation: true,
+			auths:    func() []types.Authorization { return []types.Authorization{validAuth()} },
+		},
+		"no authorizations": {
+			expected: txpoolcfg.NoAuthorizations,
+			isPrague: true,
+			auths:    func() []types.Authorization { return nil },
+		},
+		"wrong chain is skipped": {
+			expected: txpoolcfg.Success,
+			isPrague: true,
+			auths: func() []types.Authorization {
+				auth := validAuth()
+				auth.ChainID.SetUint64(2)
+				return []types.Authorization{auth}
+			},
+		},
+		"invalid signature is skipped": {
+			expected: txpoolcfg.Success,
+			isPrague: true,
+			auths: func() []types.Authorization {
+				auth := validAuth()
+				auth.Authority = nil
+				return []types.Authorization{validAuth(), auth}
+			},
+		},
+		"nonce too low is skipped": {
+			expected: txpoolcfg.Success,
+			isPrague: true,
+			auths: func() []types.Authorization {
+				auth := validAuth()
+				auth.Nonce = 2
+				return []types.Authorization{auth}
+			},
+		},
+	}
+
+	logger := log.New()
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ch := make(chan types.Announcements, 100)
+			coreDB, _ := temporaltest.NewTestDB(t, datadir.New(t.TempDir()))
+
+			cfg := txpoolcfg.DefaultConfig
+
+			var pragueTime *big.Int
+			if test.isPrague {
+				pragueTime = big.NewInt(0)
+			}
+
+			cache := &kvcache.DummyCache{}
+			pool, err := New(ch, coreDB, cfg, cache, *u256.N1, big.NewInt(0) /* shanghaiTime */, nil /* agraBlock */, big.NewInt(0) /* cancunTime */, pragueTime, fixedgas.DefaultMaxBlobsPerBlock, nil, logger)
+			asrt.NoError(err)
+			ctx := context.Background()
+			tx, err := coreDB.BeginRw(ctx)
+			defer tx.Rollback()
+			asrt.NoError(err)
+
+			for _, acc := range []sender{{nonce: 0, balance: *uint256.NewInt(math.MaxUint64)}, {nonce: 3}} {
+				sndrBytes := make([]byte, types.EncodeSenderLengthForStorage(acc.nonce, acc.balance))
+				types.EncodeSender(acc.nonce, acc.balance, sndrBytes)
+				addr := common.Address{}
+				if acc.nonce == 3 {
+					addr = authority
+				}
+				err = tx.Put(kv.PlainState, addr[:], sndrBytes)
+				asrt.NoError(err)
+			}
+
+			txn := &types.TxSlot{
+				Type:           types.SetCodeTxType,
+				FeeCap:         *uint256.NewInt(21000),
+				Gas:            500000,
+				SenderID:       0,
+				Creation:       test.creation,
+				Authorizations: test.auths(),
+			}
+
+			txns := types.TxSlots{
+				Txs:     append([]*types.TxSlot{}, txn),
+				Senders: types.Addresses{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+			}
+			err = pool.senders.registerNewSenders(&txns, logger)
+			asrt.NoError(err)
+			view, err := cache.View(ctx, tx)
+			asrt.NoError(err)
+
+			reason := pool.validateTx(txn, false, view)
+
+			if reason != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, reason)
+			}
+		})
+	}
+}
+
 // Blob gas price bump + other requirements to replace existing txns in the pool
 func TestBlobTxReplacement(t *testing.T) {
 	t.Skip("TODO")
@@ -739,7 +871,7 @@ func TestBlobTxReplacement(t *testing.T) {
 
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
 	assert.NoError(err)
 	require.True(pool != nil)
 	ctx := context.Background()
@@ -958,7 +1090,7 @@ func TestDropRemoteAtNoGossip(t *testing.T) {
 	logger := log.New()
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
 
-	txPool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, big.NewInt(0), big.NewInt(0), nil, fixedgas.DefaultMaxBlobsPerBlock, nil, logger)
+	txPool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, big.NewInt(0), big.NewInt(0), nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, logger)
 	assert.NoError(err)
 	require.True(txPool != nil)
 
@@ -1066,7 +1198,7 @@ func TestBlobSlots(t *testing.T) {
 	cfg.TotalBlobPoolLimit = 20
 
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
 	assert.NoError(err)
 	require.True(pool != nil)
 	ctx := context.Background()
@@ -1142,7 +1274,7 @@ func TestGasLimitChanged(t *testing.T) {
 	db := memdb.NewTestPoolDB(t)
 	cfg := txpoolcfg.DefaultConfig
 	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
-	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
 	assert.NoError(err)
 	require.True(pool != nil)
 	ctx := context.Background()
@@ -1207,3 +1339,327 @@ func TestGasLimitChanged(t *testing.T) {
 
 	assert.Zero(mtx.subPool&NotTooMuchGas, "Should now have block space (again) for the tx")
 }
+
+func TestOrderingPolicies(t *testing.T) {
+	newTx := func(idHash byte, senderID, nonce, arrival, tip uint64, isLocal bool) *metaTx {
+		txSlot := &types.TxSlot{SenderID: senderID, Nonce: nonce, Tip: *uint256.NewInt(tip), FeeCap: *uint256.NewInt(100)}
+		txSlot.IDHash[0] = idHash
+		mt := newMetaTx(txSlot, isLocal, 1)
+		mt.subPool |= BaseFeePoolBits
+		mt.minFeeCap, mt.minTip, mt.arrival = txSlot.FeeCap, tip, arrival
+		return mt
+	}
+	for _, test := range []struct {
+		ordering txpoolcfg.Ordering
+		expected []byte
+	}{
+		{txpoolcfg.LocalsFirstOrdering, []byte{'b', 'c', 'a', 'e', 'd'}},
+		{txpoolcfg.TipOrdering, []byte{'c', 'b', 'a', 'e', 'd'}},
+		// 'd' arrived first, but waits for the lower nonce 'e' of its sender
+		{txpoolcfg.FIFOOrdering, []byte{'a', 'b', 'c', 'e', 'd'}},
+	} {
+		t.Run(test.ordering.String(), func(t *testing.T) {
+			pending := NewPendingSubPool(PendingSubPool, 1024, test.ordering)
+			pending.best.pendingBaseFee = 10
+			for _, mt := range []*metaTx{
+				newTx('d', 4, 1, 1, 1, false),
+				newTx('a', 1, 0, 2, 3, false),
+				newTx('b', 2, 0, 3, 5, true),
+				newTx('c', 3, 0, 4, 9, false),
+				newTx('e', 4, 0, 5, 2, false),
+			} {
+				pending.Add(mt, log.New())
+			}
+			pending.EnforceBestInvariants()
+			yielded := make([]byte, 0, pending.Len())
+			for _, mt := range pending.best.ms {
+				yielded = append(yielded, mt.Tx.IDHash[0])
+			}
+			require.Equal(t, test.expected, yielded)
+		})
+	}
+}
+
+func TestArrivalsSurviveRestart(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ch := make(chan types.Announcements, 100)
+	coreDB, _ := temporaltest.NewTestDB(t, datadir.New(t.TempDir()))
+	db := memdb.NewTestPoolDB(t)
+	cfg := txpoolcfg.DefaultConfig
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	newPool := func() *TxPool {
+		pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+		require.NoError(err)
+		return pool
+	}
+	pool := newPool()
+	ctx := context.Background()
+	change := &remote.StateChangeBatch{
+		PendingBlockBaseFee: 200000,
+		BlockGasLimit:       1000000,
+		ChangeBatch:         []*remote.StateChange{{BlockHeight: 0, BlockHash: gointerfaces.ConvertHashToH256([32]byte{})}},
+	}
+	senders := make([][20]byte, 4)
+	for i := range senders {
+		senders[i][0] = byte(i + 1)
+		change.ChangeBatch[0].Changes = append(change.ChangeBatch[0].Changes, &remote.AccountChange{
+			Action:  remote.Action_UPSERT,
+			Address: gointerfaces.ConvertAddressToH160(senders[i]),
+			Data:    types.EncodeAccountBytesV3(0, uint256.NewInt(1*common.Ether), make([]byte, 32), 1),
+		})
+	}
+	tx, err := db.BeginRw(ctx)
+	require.NoError(err)
+	defer tx.Rollback()
+	require.NoError(pool.OnNewBlock(ctx, change, types.TxSlots{}, types.TxSlots{}, types.TxSlots{}, tx))
+
+	txSlots := make([]types.TxSlots, len(senders))
+	parseCtx := types.NewTxParseContext(*u256.N1)
+	parseCtx.WithSender(false)
+	for i, sender := range senders {
+		txSlot := &types.TxSlot{Tip: *uint256.NewInt(300000), FeeCap: *uint256.NewInt(300000), Gas: 100000}
+		_, err := parseCtx.ParseTransaction(fakeRlpTx(txSlot, sender[:]), 0, txSlot, nil, false /* hasEnvelope */, true /* wrappedWithBlobs */, nil)
+		require.NoError(err)
+		txSlots[i].Append(txSlot, sender[:], true)
+	}
+	// the pool is restored in the hash order, so the transactions arrive in the opposite one
+	sort.Slice(txSlots, func(i, j int) bool {
+		return bytes.Compare(txSlots[i].Txs[0].IDHash[:], txSlots[j].Txs[0].IDHash[:]) > 0
+	})
+	checkArrivals := func(pool *TxPool, txSlots []types.TxSlots) {
+		var prev uint64
+		for _, txs := range txSlots {
+			mt, ok := pool.byHash[string(txs.Txs[0].IDHash[:])]
+			require.True(ok)
+			assert.Greater(mt.arrival, prev)
+			prev = mt.arrival
+		}
+	}
+
+	for _, txs := range txSlots[:3] {
+		reasons, err := pool.AddLocalTxs(ctx, txs, tx)
+		require.NoError(err)
+		require.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+	}
+	require.NoError(pool.flushLocked(tx))
+
+	restarted := newPool()
+	require.NoError(coreDB.View(ctx, func(coreTx kv.Tx) error { return restarted.fromDB(ctx, tx, coreTx) }))
+	checkArrivals(restarted, txSlots[:3])
+
+	// arrivals after the restart keep going after the restored ones
+	reasons, err := restarted.AddLocalTxs(ctx, txSlots[3], tx)
+	require.NoError(err)
+	require.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+	require.NoError(restarted.flushLocked(tx))
+
+	restarted = newPool()
+	require.NoError(coreDB.View(ctx, func(coreTx kv.Tx) error { return restarted.fromDB(ctx, tx, coreTx) }))
+	checkArrivals(restarted, txSlots)
+}
+
+func TestPrivateTxs(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ch := make(chan types.Announcements, 100)
+
+	coreDB, _ := temporaltest.NewTestDB(t, datadir.New(t.TempDir()))
+	db := memdb.NewTestPoolDB(t)
+
+	cfg := txpoolcfg.DefaultConfig
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, nil, nil, nil, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+	assert.NoError(err)
+	require.True(pool != nil)
+	ctx := context.Background()
+	var stateVersionID uint64 = 0
+	pendingBaseFee := uint64(200000)
+	// start blocks from 0, set empty hash - then kvcache will also work on this
+	h1 := gointerfaces.ConvertHashToH256([32]byte{})
+	change := &remote.StateChangeBatch{
+		StateVersionId:      stateVersionID,
+		PendingBlockBaseFee: pendingBaseFee,
+		BlockGasLimit:       1000000,
+		ChangeBatch: []*remote.StateChange{
+			{BlockHeight: 0, BlockHash: h1},
+		},
+	}
+	var addr [20]byte
+	addr[0] = 1
+	v := types.EncodeAccountBytesV3(2, uint256.NewInt(1*common.Ether), make([]byte, 32), 1)
+	change.ChangeBatch[0].Changes = append(change.ChangeBatch[0].Changes, &remote.AccountChange{
+		Action:  remote.Action_UPSERT,
+		Address: gointerfaces.ConvertAddressToH160(addr),
+		Data:    v,
+	})
+	tx, err := db.BeginRw(ctx)
+	require.NoError(err)
+	defer tx.Rollback()
+	err = pool.OnNewBlock(ctx, change, types.TxSlots{}, types.TxSlots{}, types.TxSlots{}, tx)
+	assert.NoError(err)
+
+	newTxSlots := func(idHash byte, nonce uint64) types.TxSlots {
+		var txSlots types.TxSlots
+		txSlot := &types.TxSlot{
+			Tip:    *uint256.NewInt(300000),
+			FeeCap: *uint256.NewInt(300000),
+			Gas:    100000,
+			Nonce:  nonce,
+		}
+		txSlot.IDHash[0] = idHash
+		txSlots.Append(txSlot, addr[:], true)
+		return txSlots
+	}
+
+	// the deadline is already reached
+	reasons, err := pool.AddPrivateTxs(ctx, newTxSlots(1, 2), 0, tx)
+	assert.NoError(err)
+	assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.PrivateTxExpired}, reasons)
+
+	privateTxs := newTxSlots(1, 2)
+	reasons, err = pool.AddPrivateTxs(ctx, privateTxs, 2, tx)
+	assert.NoError(err)
+	assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+	reasons, err = pool.AddLocalTxs(ctx, newTxSlots(2, 3), tx)
+	assert.NoError(err)
+	assert.Equal([]txpoolcfg.DiscardReason{txpoolcfg.Success}, reasons)
+
+	privateHash := privateTxs.Txs[0].IDHash[:]
+	assert.True(pool.IsPrivate(privateHash))
+	assert.False(pool.isLocalLRU.Contains(string(privateHash)))
+	assert.Equal(PendingSubPool, pool.byHash[string(privateHash)].currentSubPool)
+	_, _, hashes := pool.AppendLocalAnnouncements(nil, nil, nil)
+	assert.Equal(1, len(hashes)/32)
+	assert.Equal(byte(2), hashes[0])
+
+	// private transaction is not persisted
+	assert.NoError(pool.flushLocked(tx))
+	has, err := tx.Has(kv.PoolTransaction, privateHash)
+	assert.NoError(err)
+	assert.False(has)
+
+	for block := uint64(1); block <= 2; block++ {
+		stateVersionID++
+		change = &remote.StateChangeBatch{
+			StateVersionId:      stateVersionID,
+			PendingBlockBaseFee: pendingBaseFee,
+			BlockGasLimit:       1000000,
+			ChangeBatch: []*remote.StateChange{
+				{BlockHeight: block, BlockHash: gointerfaces.ConvertHashToH256([32]byte{byte(block)})},
+			},
+		}
+		err = pool.OnNewBlock(ctx, change, types.TxSlots{}, types.TxSlots{}, types.TxSlots{}, tx)
+		assert.NoError(err)
+		_, ok := pool.byHash[string(privateHash)]
+		assert.Equal(block < 2, ok)
+	}
+	assert.False(pool.IsPrivate(privateHash))
+	reason, ok := pool.discardReasonsLRU.Get(string(privateHash))
+	assert.True(ok)
+	assert.Equal(txpoolcfg.PrivateTxExpired, reason)
+}
+
+func TestGetBlobs(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ch := make(chan types.Announcements, 5)
+	coreDB, _ := temporaltest.NewTestDB(t, datadir.New(t.TempDir()))
+	cfg := txpoolcfg.DefaultConfig
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+	require.NoError(err)
+
+	pooled, mined := makeBlobTx(), makeBlobTx()
+	pooled.IDHash[0], mined.IDHash[0] = 1, 2
+	require.Len(pooled.Blobs, 2)
+	require.Len(pooled.Proofs, 2)
+	mined.BlobHashes = []common.Hash{{0xaa}}
+	mined.Blobs, mined.Proofs = mined.Blobs[:1], mined.Proofs[:1]
+	pooledMt := newMetaTx(&pooled, false, 0)
+	pool.byHash[string(pooled.IDHash[:])] = pooledMt
+	pool.addBlobsLocked(pooledMt)
+	// mined transactions are usually known without blobs
+	withoutBlobs := makeBlobTx()
+	withoutBlobs.IDHash[0] = 3
+	withoutBlobs.BlobHashes = []common.Hash{{0xbb}}
+	withoutBlobs.Blobs, withoutBlobs.Proofs = nil, nil
+	pool.lastSeenBlock.Store(1)
+	require.NoError(pool.processMinedFinalizedBlobs(nil, []*types.TxSlot{&mined, &withoutBlobs}, 0))
+
+	blobs, proofs := pool.GetBlobs([]common.Hash{pooled.BlobHashes[1], {0xbb}, {0xaa}, pooled.BlobHashes[0], {0xcc}, pooled.BlobHashes[1]})
+	assert.Equal([][]byte{pooled.Blobs[1], nil, mined.Blobs[0], pooled.Blobs[0], nil, pooled.Blobs[1]}, blobs)
+	assert.Equal([][]byte{pooled.Proofs[1][:], nil, mined.Proofs[0][:], pooled.Proofs[0][:], nil, pooled.Proofs[1][:]}, proofs)
+
+	// discarded and finalized transactions leave the index
+	pool.discardLocked(pooledMt, txpoolcfg.Mined)
+	require.NoError(pool.processMinedFinalizedBlobs(nil, nil, 1))
+	blobs, proofs = pool.GetBlobs([]common.Hash{pooled.BlobHashes[0], {0xaa}})
+	assert.Equal([][]byte{nil, nil}, blobs)
+	assert.Equal([][]byte{nil, nil}, proofs)
+	assert.Empty(pool.blobsByHash)
+}
+
+func TestGetBlobsSharedBlob(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	ch := make(chan types.Announcements, 5)
+	coreDB, _ := temporaltest.NewTestDB(t, datadir.New(t.TempDir()))
+	cfg := txpoolcfg.DefaultConfig
+	sendersCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	pool, err := New(ch, coreDB, cfg, sendersCache, *u256.N1, common.Big0, nil, common.Big0, nil, fixedgas.DefaultMaxBlobsPerBlock, nil, log.New())
+	require.NoError(err)
+
+	// both transactions carry the same blobs
+	older, newer := makeBlobTx(), makeBlobTx()
+	older.IDHash[0], newer.IDHash[0] = 1, 2
+	olderMt, newerMt := newMetaTx(&older, false, 0), newMetaTx(&newer, false, 0)
+	pool.byHash[string(older.IDHash[:])] = olderMt
+	pool.addBlobsLocked(olderMt)
+	pool.byHash[string(newer.IDHash[:])] = newerMt
+	pool.addBlobsLocked(newerMt)
+
+	// removing the newer transaction keeps the blobs of the older one
+	pool.discardLocked(newerMt, txpoolcfg.ReplacedByHigherTip)
+	blobs, proofs := pool.GetBlobs([]common.Hash{older.BlobHashes[0], older.BlobHashes[1]})
+	assert.Equal([][]byte{older.Blobs[0], older.Blobs[1]}, blobs)
+	assert.Equal([][]byte{older.Proofs[0][:], older.Proofs[1][:]}, proofs)
+
+	pool.discardLocked(olderMt, txpoolcfg.Mined)
+	blobs, _ = pool.GetBlobs([]common.Hash{older.BlobHashes[0]})
+	assert.Equal([][]byte{nil}, blobs)
+	assert.Empty(pool.blobsByHash)
+}
+
+// fakeRlpTx add anything what identifying tx to `data` to make hash unique
+func fakeRlpTx(slot *types.TxSlot, data []byte) []byte {
+	dataLen := rlp.U64Len(1) + //chainID
+		rlp.U64Len(slot.Nonce) + rlp.U256Len(&slot.Tip) + rlp.U256Len(&slot.FeeCap) +
+		rlp.U64Len(slot.Gas) + // gas
+		rlp.StringLen([]byte{}) + // dest addr
+		rlp.U256Len(&slot.Value) +
+		rlp.StringLen(data) + // data
+		rlp.ListPrefixLen(0) + //access list
+		+3 // v,r,s
+
+	buf := make([]byte, 1+rlp.ListPrefixLen(dataLen)+dataLen)
+	buf[0] = types.DynamicFeeTxType
+	p := 1
+	p += rlp.EncodeListPrefix(dataLen, buf[p:])
+	p += rlp.EncodeU64(1, buf[p:]) //chainID
+	p += rlp.EncodeU64(slot.Nonce, buf[p:])
+	bb := bytes.NewBuffer(buf[p:p])
+	_ = slot.Tip.EncodeRLP(bb)
+	p += rlp.U256Len(&slot.Tip)
+	bb = bytes.NewBuffer(buf[p:p])
+	_ = slot.FeeCap.EncodeRLP(bb)
+	p += rlp.U256Len(&slot.FeeCap)
+	p += rlp.EncodeU64(slot.Gas, buf[p:])    //gas
+	p += rlp.EncodeString([]byte{}, buf[p:]) //destrination addr
+	bb = bytes.NewBuffer(buf[p:p])
+	_ = slot.Value.EncodeRLP(bb)
+	p += rlp.U256Len(&slot.Value)
+	p += rlp.EncodeString(data, buf[p:])  //data
+	p += rlp.EncodeListPrefix(0, buf[p:]) // access list
+	p += rlp.EncodeU64(1, buf[p:])        //v
+	p += rlp.EncodeU64(1, buf[p:])        //r
+	p += rlp.EncodeU64(1, buf[p:])        //s
+	_ = p
+	return buf[:]
+}
@@ -55,10 +55,13 @@ type txPool interface {
 	PeekBest(n uint16, txs *types.TxsRlp, tx kv.Tx, onTopOf, availableGas, availableBlobGas uint64) (bool, error)
 	GetRlp(tx kv.Tx, hash []byte) ([]byte, error)
 	AddLocalTxs(ctx context.Context, newTxs types.TxSlots, tx kv.Tx) ([]txpoolcfg.DiscardReason, error)
+	AddPrivateTxs(ctx context.Context, newTxs types.TxSlots, maxBlockNum uint64, tx kv.Tx) ([]txpoolcfg.DiscardReason, error)
 	deprecatedForEach(_ context.Context, f func(rlp []byte, sender common.Address, t SubPoolType), tx kv.Tx)
 	CountContent() (int, int, int)
 	IdHashKnown(tx kv.Tx, hash []byte) (bool, error)
 	NonceFromAddress(addr [20]byte) (nonce uint64, inPool bool)
+	TransactionStatusHistory(ctx context.Context, db kv.RoDB, hash []byte) ([]JournalEvent, error)
+	GetBlobs(blobHashes []common.Hash) (blobs [][]byte, proofs [][]byte)
 }
 
 var _ txpool_proto.TxpoolServer = (*GrpcServer)(nil)   // compile-time interface check
@@ -97,6 +100,12 @@ func (*GrpcDisabled) Status(ctx context.Context, request *txpool_proto.StatusReq
 func (*GrpcDisabled) Nonce(ctx context.Context, request *txpool_proto.NonceRequest) (*txpool_proto.NonceReply, error) {
 	return nil, ErrPoolDisabled
 }
+func (*GrpcDisabled) TransactionStatusHistory(ctx context.Context, request *txpool_proto.TransactionStatusHistoryRequest) (*txpool_proto.TransactionStatusHistoryReply, error) {
+	return nil, ErrPoolDisabled
+}
+func (*GrpcDisabled) GetBlobs(ctx context.Context, request *txpool_proto.GetBlobsRequest) (*txpool_proto.GetBlobsReply, error) {
+	return nil, ErrPoolDisabled
+}
 
 type GrpcServer struct {
 	txpool_proto.UnimplementedTxpoolServer
@@ -212,7 +221,12 @@ func (s *GrpcServer) Add(ctx context.Context, in *txpool_proto.AddRequest) (*txp
 		}
 	}
 
-	discardReasons, err := s.txPool.AddLocalTxs(ctx, slots, tx)
+	var discardReasons []txpoolcfg.DiscardReason
+	if in.PrivateMaxBlock != 0 {
+		discardReasons, err = s.txPool.AddPrivateTxs(ctx, slots, in.PrivateMaxBlock, tx)
+	} else {
+		discardReasons, err = s.txPool.AddLocalTxs(ctx, slots, tx)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -239,7 +253,10 @@ func mapDiscardReasonToProto(reason txpoolcfg.DiscardReason) txpool_proto.Import
 		return txpool_proto.ImportResult_ALREADY_EXISTS
 	case txpoolcfg.UnderPriced, txpoolcfg.ReplaceUnderpriced, txpoolcfg.FeeTooLow:
 		return txpool_proto.ImportResult_FEE_TOO_LOW
-	case txpoolcfg.InvalidSender, txpoolcfg.NegativeValue, txpoolcfg.OversizedData, txpoolcfg.InitCodeTooLarge, txpoolcfg.RLPTooLong, txpoolcfg.CreateBlobTxn, txpoolcfg.NoBlobs, txpoolcfg.TooManyBlobs, txpoolcfg.TypeNotActivated, txpoolcfg.UnequalBlobTxExt, txpoolcfg.BlobHashCheckFail, txpoolcfg.UnmatchedBlobTxExt:
+	case txpoolcfg.PrivateTxExpired:
+		return txpool_proto.ImportResult_STALE
+	case txpoolcfg.InvalidSender, txpoolcfg.NegativeValue, txpoolcfg.OversizedData, txpoolcfg.InitCodeTooLarge, txpoolcfg.RLPTooLong, txpoolcfg.CreateBlobTxn, txpoolcfg.NoBlobs, txpoolcfg.TooManyBlobs, txpoolcfg.TypeNotActivated, txpoolcfg.UnequalBlobTxExt, txpoolcfg.BlobHashCheckFail, txpoolcfg.UnmatchedBlobTxExt,
+		txpoolcfg.CreateSetCodeTxn, txpoolcfg.NoAuthorizations:
 		// TODO(eip-4844) TypeNotActivated may be transient (e.g. a blob transaction is submitted 1 sec prior to Cancun activation)
 		return txpool_proto.ImportResult_INVALID
 	default:
@@ -303,6 +320,52 @@ func (s *GrpcServer) Nonce(ctx context.Context, in *txpool_proto.NonceRequest) (
 	}, nil
 }
 
+func (s *GrpcServer) TransactionStatusHistory(ctx context.Context, in *txpool_proto.TransactionStatusHistoryRequest) (*txpool_proto.TransactionStatusHistoryReply, error) {
+	hash := gointerfaces.ConvertH256ToHash(in.Hash)
+	events, err := s.txPool.TransactionStatusHistory(ctx, s.db, hash[:])
+	if err != nil {
+		return nil, err
+	}
+	reply := &txpool_proto.TransactionStatusHistoryReply{Events: make([]*txpool_proto.TxStatusEvent, len(events))}
+	for i, event := range events {
+		eventType, err := convertJournalEventType(event.Type)
+		if err != nil {
+			return nil, err
+		}
+		reply.Events[i] = &txpool_proto.TxStatusEvent{
+			Type:      eventType,
+			BlockNum:  event.BlockNum,
+			Timestamp: event.Time,
+		}
+		if event.SubPool != 0 {
+			reply.Events[i].SubPool = event.SubPool.String()
+		}
+		if event.Reason != txpoolcfg.NotSet {
+			reply.Events[i].Reason = event.Reason.String()
+		}
+	}
+	return reply, nil
+}
+
+func convertJournalEventType(t JournalEventType) (txpool_proto.TxStatusEvent_Type, error) {
+	switch t {
+	case TxAdded:
+		return txpool_proto.TxStatusEvent_ADDED, nil
+	case TxPromoted:
+		return txpool_proto.TxStatusEvent_PROMOTED, nil
+	case TxDemoted:
+		return txpool_proto.TxStatusEvent_DEMOTED, nil
+	case TxReplaced:
+		return txpool_proto.TxStatusEvent_REPLACED, nil
+	case TxDiscarded:
+		return txpool_proto.TxStatusEvent_DISCARDED, nil
+	case TxMined:
+		return txpool_proto.TxStatusEvent_MINED, nil
+	default:
+		return 0, fmt.Errorf("unknown pool event type: %d", t)
+	}
+}
+
 // NewSlotsStreams - it's safe to use this class as non-pointer
 type NewSlotsStreams struct {
 	chans map[uint]txpool_proto.Txpool_OnAddServer
@@ -410,3 +473,16 @@ func StartGrpc(txPoolServer txpool_proto.TxpoolServer, miningServer txpool_proto
 	logger.Info("Started gRPC server", "on", addr)
 	return grpcServer, nil
 }
+
+func (s *GrpcServer) GetBlobs(ctx context.Context, in *txpool_proto.GetBlobsRequest) (*txpool_proto.GetBlobsReply, error) {
+	hashes := make([]common.Hash, len(in.BlobHashes))
+	for i := range in.BlobHashes {
+		hashes[i] = gointerfaces.ConvertH256ToHash(in.BlobHashes[i])
+	}
+	blobs, proofs := s.txPool.GetBlobs(hashes)
+	reply := &txpool_proto.GetBlobsReply{BlobsAndProofs: make([]*txpool_proto.BlobAndProof, len(hashes))}
+	for i := range hashes {
+		reply.BlobsAndProofs[i] = &txpool_proto.BlobAndProof{Blob: blobs[i], Proof: proofs[i]}
+	}
+	return reply, nil
+}
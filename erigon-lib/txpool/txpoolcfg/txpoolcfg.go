@@ -57,6 +57,13 @@ type Config struct {
 	MdbxGrowthStep  datasize.ByteSize
 
 	NoGossip bool // this mode doesn't broadcast any txs, and if receive remote-txn - skip it
+
+	Ordering Ordering // order in which the pending transactions are yielded for block building
+
+	// journal of the pool events of every transaction, see txpool_getTransactionStatusHistory
+	Journal          bool
+	JournalRetention time.Duration // events older than this are pruned
+	JournalLimit     uint64        // max amount of kept events, the oldest are pruned
 }
 
 var DefaultConfig = Config{
@@ -77,6 +84,43 @@ var DefaultConfig = Config{
 	BlobPriceBump:      100,
 
 	NoGossip: false,
+	Ordering: LocalsFirstOrdering,
+
+	Journal:          false,
+	JournalRetention: 24 * time.Hour,
+	JournalLimit:     1_000_000,
+}
+
+// Ordering selects the order in which YieldBest and PeekBest return the pending transactions
+type Ordering uint8
+
+const (
+	LocalsFirstOrdering Ordering = iota // transactions of local senders first, then by effective tip
+	TipOrdering                         // by effective tip only
+	FIFOOrdering                        // by arrival to the pool, keeping the nonce order of each sender
+)
+
+func (o Ordering) String() string {
+	switch o {
+	case LocalsFirstOrdering:
+		return "locals"
+	case TipOrdering:
+		return "tip"
+	case FIFOOrdering:
+		return "fifo"
+	default:
+		return fmt.Sprintf("unknown ordering: %d", uint8(o))
+	}
+}
+
+// ParseOrdering is the inverse of Ordering.String
+func ParseOrdering(s string) (Ordering, error) {
+	for _, o := range []Ordering{LocalsFirstOrdering, TipOrdering, FIFOOrdering} {
+		if o.String() == s {
+			return o, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown txpool ordering %q, expected one of: locals, tip, fifo", s)
 }
 
 type DiscardReason uint8
@@ -114,6 +158,9 @@ const (
 	UnmatchedBlobTxExt  DiscardReason = 29 // KZGcommitments must match the corresponding blobs and proofs
 	BlobTxReplace       DiscardReason = 30 // Cannot replace type-3 blob txn with another type of txn
 	BlobPoolOverflow    DiscardReason = 31 // The total number of blobs (through blob txs) in the pool has reached its limit
+	CreateSetCodeTxn    DiscardReason = 32 // Set code transactions cannot have the form of a create transaction
+	NoAuthorizations    DiscardReason = 33 // Set code transactions must have at least one authorization
+	PrivateTxExpired    DiscardReason = 34 // Private transaction wasn't mined until its max block
 
 )
 
@@ -177,13 +224,19 @@ func (r DiscardReason) String() string {
 		return "can't replace blob-txn with a non-blob-txn"
 	case BlobPoolOverflow:
 		return "blobs limit in txpool is full"
+	case CreateSetCodeTxn:
+		return "set code transactions cannot have the form of a create transaction"
+	case NoAuthorizations:
+		return "set code transactions must have at least one authorization"
+	case PrivateTxExpired:
+		return "private transaction wasn't mined until its max block"
 	default:
 		panic(fmt.Sprintf("discard reason: %d", r))
 	}
 }
 
 // CalcIntrinsicGas computes the 'intrinsic gas' for a message with the given data.
-func CalcIntrinsicGas(dataLen, dataNonZeroLen uint64, accessList types.AccessList, isContractCreation, isHomestead, isEIP2028, isShanghai bool) (uint64, DiscardReason) {
+func CalcIntrinsicGas(dataLen, dataNonZeroLen, authorizationsLen uint64, accessList types.AccessList, isContractCreation, isHomestead, isEIP2028, isShanghai bool) (uint64, DiscardReason) {
 	// Set the starting gas for the raw transaction
 	var gas uint64
 	if isContractCreation && isHomestead {
@@ -252,6 +305,17 @@ func CalcIntrinsicGas(dataLen, dataNonZeroLen uint64, accessList types.AccessLis
 			return 0, GasUintOverflow
 		}
 	}
+	// EIP-7702: every authorization is charged as if it created a new account
+	if authorizationsLen > 0 {
+		product, overflow := emath.SafeMul(authorizationsLen, fixedgas.PerEmptyAccountCost)
+		if overflow {
+			return 0, GasUintOverflow
+		}
+		gas, overflow = emath.SafeAdd(gas, product)
+		if overflow {
+			return 0, GasUintOverflow
+		}
+	}
 	return gas, Success
 }
 
@@ -108,6 +108,20 @@ type TxSlot struct {
 	Blobs       [][]byte
 	Commitments []gokzg4844.KZGCommitment
 	Proofs      []gokzg4844.KZGProof
+
+	// EIP-7702: Set EOA account code
+	Authorizations []Authorization
+}
+
+// Authorization is a tuple of the authorization list of a set code transaction
+type Authorization struct {
+	ChainID   uint256.Int
+	Address   common.Address
+	Nonce     uint64
+	YParity   uint8
+	R         uint256.Int
+	S         uint256.Int
+	Authority *common.Address // Recovered signer, nil if the signature is invalid
 }
 
 const (
@@ -115,8 +129,12 @@ const (
 	AccessListTxType byte = 1 // EIP-2930
 	DynamicFeeTxType byte = 2 // EIP-1559
 	BlobTxType       byte = 3 // EIP-4844
+	SetCodeTxType    byte = 4 // EIP-7702
 )
 
+// AuthorizationMagic is prepended to the RLP of [chain_id, address, nonce] to get the hash signed by an authorization
+const AuthorizationMagic byte = 0x05
+
 var ErrParseTxn = fmt.Errorf("%w transaction", rlp.ErrParse)
 
 var ErrRejected = errors.New("rejected")
@@ -184,7 +202,7 @@ func (ctx *TxParseContext) ParseTransaction(payload []byte, pos int, slot *TxSlo
 	// If it is non-legacy transaction, the transaction type follows, and then the list
 	if !legacy {
 		slot.Type = payload[p]
-		if slot.Type > BlobTxType {
+		if slot.Type > SetCodeTxType {
 			return 0, fmt.Errorf("%w: unknown transaction type: %d", ErrParseTxn, slot.Type)
 		}
 		p++
@@ -465,6 +483,25 @@ func (ctx *TxParseContext) parseTransactionBody(payload []byte, pos, p0 int, slo
 		}
 		p = dataPos + dataLen
 	}
+	if slot.Type == SetCodeTxType {
+		dataPos, dataLen, err = rlp.List(payload, p)
+		if err != nil {
+			return 0, fmt.Errorf("%w: authorization list len: %s", ErrParseTxn, err) //nolint
+		}
+		authPos := dataPos
+		for authPos < dataPos+dataLen {
+			var auth Authorization
+			authPos, err = ctx.parseAuthorization(payload, authPos, &auth)
+			if err != nil {
+				return 0, err
+			}
+			slot.Authorizations = append(slot.Authorizations, auth)
+		}
+		if authPos != dataPos+dataLen {
+			return 0, fmt.Errorf("%w: extraneous space in the authorization list", ErrParseTxn)
+		}
+		p = dataPos + dataLen
+	}
 	// This is where the data for Sighash ends
 	// Next follows V of the signature
 	var vByte byte
@@ -621,6 +658,77 @@ func (ctx *TxParseContext) parseTransactionBody(payload []byte, pos, p0 int, slo
 	return p, nil
 }
 
+// parseAuthorization parses a [chain_id, address, nonce, y_parity, r, s] tuple and recovers the authority which signed it.
+// Authorities are recovered even without ctx.withSender, because the pool re-validates them when it is loaded from the db.
+// An authorization with an invalid signature is not a parse error, it's skipped during execution.
+func (ctx *TxParseContext) parseAuthorization(payload []byte, pos int, auth *Authorization) (p int, err error) {
+	dataPos, dataLen, err := rlp.List(payload, pos)
+	if err != nil {
+		return 0, fmt.Errorf("%w: authorization len: %s", ErrParseTxn, err) //nolint
+	}
+	p, err = rlp.U256(payload, dataPos, &auth.ChainID)
+	if err != nil {
+		return 0, fmt.Errorf("%w: authorization chainId: %s", ErrParseTxn, err) //nolint
+	}
+	p, err = rlp.StringOfLen(payload, p, 20)
+	if err != nil {
+		return 0, fmt.Errorf("%w: authorization address: %s", ErrParseTxn, err) //nolint
+	}
+	copy(auth.Address[:], payload[p:p+20])
+	p += 20
+	p, auth.Nonce, err = rlp.U64(payload, p)
+	if err != nil {
+		return 0, fmt.Errorf("%w: authorization nonce: %s", ErrParseTxn, err) //nolint
+	}
+	sigHashEnd := p
+	var yParity uint64
+	p, yParity, err = rlp.U64(payload, p)
+	if err != nil {
+		return 0, fmt.Errorf("%w: authorization y parity: %s", ErrParseTxn, err) //nolint
+	}
+	if yParity > 255 {
+		return 0, fmt.Errorf("%w: authorization y parity is too large: %d", ErrParseTxn, yParity)
+	}
+	auth.YParity = uint8(yParity)
+	p, err = rlp.U256(payload, p, &auth.R)
+	if err != nil {
+		return 0, fmt.Errorf("%w: authorization R: %s", ErrParseTxn, err) //nolint
+	}
+	p, err = rlp.U256(payload, p, &auth.S)
+	if err != nil {
+		return 0, fmt.Errorf("%w: authorization S: %s", ErrParseTxn, err) //nolint
+	}
+	if p != dataPos+dataLen {
+		return 0, fmt.Errorf("%w: extraneous space in the authorization", ErrParseTxn)
+	}
+
+	if !crypto.TransactionSignatureIsValid(auth.YParity, &auth.R, &auth.S, false) {
+		return p, nil
+	}
+	// keccak256(MAGIC || rlp([chain_id, address, nonce]))
+	var buf [65]byte
+	keccak := sha3.NewLegacyKeccak256()
+	buf[0] = AuthorizationMagic
+	prefixLen := rlp.EncodeListPrefix(sigHashEnd-dataPos, buf[1:])
+	keccak.Write(buf[:1+prefixLen])
+	keccak.Write(payload[dataPos:sigHashEnd])
+	var sighash, sig [65]byte
+	_, _ = keccak.(io.Reader).Read(sighash[:32])
+	auth.R.WriteToSlice(sig[:32])
+	auth.S.WriteToSlice(sig[32:64])
+	sig[64] = auth.YParity
+	pubkey, err := secp256k1.RecoverPubkeyWithContext(secp256k1.DefaultContext, sighash[:32], sig[:], buf[:0])
+	if err != nil {
+		return p, nil
+	}
+	keccak.Reset()
+	keccak.Write(pubkey[1:65])
+	_, _ = keccak.(io.Reader).Read(sighash[:32])
+	authority := common.BytesToAddress(sighash[12:32])
+	auth.Authority = &authority
+	return p, nil
+}
+
 type PeerID *types.H512
 
 type Hashes []byte // flatten list of 32-byte hashes
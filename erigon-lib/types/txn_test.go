@@ -19,6 +19,7 @@ package types
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/hex"
 	"strconv"
 	"testing"
 
@@ -292,3 +293,38 @@ func TestBlobTxParsing(t *testing.T) {
 	assert.Equal(t, proof0, fatTx.Proofs[0])
 	assert.Equal(t, proof1, fatTx.Proofs[1])
 }
+
+func TestSetCodeTxParsing(t *testing.T) {
+	// Set code transaction with one authorization of 0x703c...46c7 delegating to 0x0aaa on chain 5
+	txRlpHex := "04f8c10507010a830186a094703c4b2bd70c169f5717101caee543299fc946c78080c0f85cf85a05940000" +
+		"000000000000000000000000000000000aaa0180a070663e2f4bd06609b4415196e966136ff69ccc667af8cceab477f6" +
+		"287b5b9be0a061c3822c1dd674e780027c5fd17ab0e28e5f71a4561ccb2941933059100c96b480a0deab8df457d69217" +
+		"c22adc03b07d87b459a22fae979aecb335e9bfa64afb9a77a03b0e6d8de20e8d349d72e8c79dea5d8ef16f9469a4298e" +
+		"1fe3acb05df94a894d"
+	txRlp := hexutility.MustDecodeHex(txRlpHex)
+
+	ctx := NewTxParseContext(*uint256.NewInt(5))
+	var tx TxSlot
+	sender := make([]byte, 20)
+	p, err := ctx.ParseTransaction(txRlp, 0, &tx, sender, false /* hasEnvelope */, false /* wrappedWithBlobs */, nil)
+	require.NoError(t, err)
+	assert.Equal(t, len(txRlp), p)
+	assert.Equal(t, SetCodeTxType, tx.Type)
+	assert.Equal(t, "89ebb81f98dd1df76018ae3bf545e4e1ad5b87916effc8b040dee12f014f24e1", hex.EncodeToString(tx.IDHash[:]))
+	assert.Equal(t, "71562b71999873db5b286df957af199ec94617f7", hex.EncodeToString(sender))
+	require.Equal(t, 1, len(tx.Authorizations))
+	auth := tx.Authorizations[0]
+	assert.Equal(t, uint64(5), auth.ChainID.Uint64())
+	assert.Equal(t, "0000000000000000000000000000000000000aaa", hex.EncodeToString(auth.Address[:]))
+	assert.Equal(t, uint64(1), auth.Nonce)
+	require.NotNil(t, auth.Authority)
+	assert.Equal(t, "703c4b2bd70c169f5717101caee543299fc946c7", hex.EncodeToString(auth.Authority[:]))
+
+	// an empty authorization list is parsed, it's up to the pool to reject it
+	emptyAuthsRlp := hexutility.MustDecodeHex("04f84a0507010a830186a094703c4b2bd70c169f5717101caee543299fc946c78080c0c0" +
+		"80a0deab8df457d69217c22adc03b07d87b459a22fae979aecb335e9bfa64afb9a77a03b0e6d8de20e8d349d72e8c79dea5d8ef16f9469a4298e1fe3acb05df94a894d")
+	var emptyAuthsTx TxSlot
+	_, err = ctx.ParseTransaction(emptyAuthsRlp, 0, &emptyAuthsTx, sender, false /* hasEnvelope */, false /* wrappedWithBlobs */, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(emptyAuthsTx.Authorizations))
+}
@@ -18,6 +18,7 @@
 package ethconfig
 
 import (
+	"fmt"
 	"math/big"
 	"os"
 	"os/user"
@@ -32,6 +33,7 @@ import (
 	"github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/datadir"
 	"github.com/ledgerwatch/erigon-lib/downloader/downloadercfg"
+	"github.com/ledgerwatch/erigon-lib/downloader/snaptype"
 	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
 	"github.com/ledgerwatch/erigon/cl/beacon/beacon_router_configuration"
 	"github.com/ledgerwatch/erigon/cl/clparams"
@@ -140,6 +142,7 @@ type BlocksFreezing struct {
 	NoDownloader   bool // possible to use snapshots without calling Downloader
 	Verify         bool // verify snapshots on startup
 	DownloaderAddr string
+	Selection      snaptype.Selection // partial-history node: download and serve only this part of snapshots
 }
 
 func (s BlocksFreezing) String() string {
@@ -153,12 +156,25 @@ func (s BlocksFreezing) String() string {
 	if !s.Produce {
 		out = append(out, "--"+FlagSnapStop+"=true")
 	}
+	if s.Selection.Enabled() {
+		to := ""
+		if s.Selection.ToBlock > 0 {
+			to = fmt.Sprint(s.Selection.ToBlock)
+		}
+		out = append(out, fmt.Sprintf("--%s=%d-%s", FlagSnapPartialBlocks, s.Selection.FromBlock, to))
+		if len(s.Selection.Types) > 0 {
+			out = append(out, "--"+FlagSnapPartialTypes+"="+strings.Join(s.Selection.Types, ","))
+		}
+	}
 	return strings.Join(out, " ")
 }
 
 var (
 	FlagSnapKeepBlocks = "snap.keepblocks"
 	FlagSnapStop       = "snap.stop"
+
+	FlagSnapPartialBlocks = "snap.partial.blocks"
+	FlagSnapPartialTypes  = "snap.partial.types"
 )
 
 func NewSnapCfg(enabled, keepBlocks, produce bool) BlocksFreezing {
@@ -265,6 +281,8 @@ type Config struct {
 	SilkwormRpcJsonCompatibility bool
 
 	DisableTxPoolGossip bool
+
+	ExtraIndices []string // user-defined inverted indices, see state.ExtraIndices
 }
 
 type Sync struct {
@@ -19,8 +19,11 @@ package gasprice_test
 import (
 	"context"
 	"errors"
+	"math/big"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/ledgerwatch/erigon/eth/gasprice"
 	"github.com/ledgerwatch/erigon/eth/gasprice/gaspricecfg"
 	"github.com/ledgerwatch/erigon/rpc"
@@ -62,7 +65,7 @@ func TestFeeHistory(t *testing.T) {
 		cache := jsonrpc.NewGasPriceCache()
 		oracle := gasprice.NewOracle(backend, config, cache)
 
-		first, reward, baseFee, ratio, err := oracle.FeeHistory(context.Background(), c.count, c.last, c.percent)
+		first, reward, baseFee, ratio, blobBaseFee, blobRatio, err := oracle.FeeHistory(context.Background(), c.count, c.last, c.percent)
 
 		expReward := c.expCount
 		if len(c.percent) == 0 {
@@ -85,8 +88,38 @@ func TestFeeHistory(t *testing.T) {
 		if len(ratio) != c.expCount {
 			t.Fatalf("Test case %d: gasUsedRatio array length mismatch, want %d, got %d", i, c.expCount, len(ratio))
 		}
+		if len(blobBaseFee) != expBaseFee {
+			t.Fatalf("Test case %d: blobBaseFee array length mismatch, want %d, got %d", i, expBaseFee, len(blobBaseFee))
+		}
+		if len(blobRatio) != c.expCount {
+			t.Fatalf("Test case %d: blobGasUsedRatio array length mismatch, want %d, got %d", i, c.expCount, len(blobRatio))
+		}
 		if err != c.expErr && !errors.Is(err, c.expErr) {
 			t.Fatalf("Test case %d: error mismatch, want %v, got %v", i, c.expErr, err)
 		}
 	}
 }
+
+// the block after the last pre-Cancun block is a Cancun block, which has the minimal blob base fee
+func TestFeeHistoryNextBlobBaseFeeAtCancun(t *testing.T) {
+	backend := newTestBackend(t)
+	head := backend.CurrentHeader()
+	require.Nil(t, head.ExcessBlobGas)
+
+	for _, c := range []struct {
+		cancunTime  uint64
+		nextBlobFee int64
+	}{
+		{head.Time + 1, int64(backend.cfg.GetMinBlobGasPrice())},
+		{head.Time + 100, 0},
+	} {
+		cfg := *backend.cfg
+		cfg.CancunTime = new(big.Int).SetUint64(c.cancunTime)
+		backend := &testBackend{db: backend.db, cfg: &cfg, blockReader: backend.blockReader}
+		oracle := gasprice.NewOracle(backend, gaspricecfg.Config{}, jsonrpc.NewGasPriceCache())
+
+		_, _, _, _, blobBaseFee, _, err := oracle.FeeHistory(context.Background(), 1, rpc.LatestBlockNumber, nil)
+		require.NoError(t, err)
+		require.Equal(t, []*big.Int{big.NewInt(0), big.NewInt(c.nextBlobFee)}, blobBaseFee)
+	}
+}
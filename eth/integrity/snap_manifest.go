@@ -0,0 +1,296 @@
+package integrity
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ledgerwatch/log/v3"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon-lib/downloader/snaptype"
+	"github.com/ledgerwatch/erigon-lib/recsplit"
+	"github.com/ledgerwatch/erigon-lib/seg"
+
+	"github.com/ledgerwatch/erigon/crypto"
+)
+
+// SnapManifestFileName - name of the snapshots manifest in the datadir
+const SnapManifestFileName = "snapshots-manifest.json"
+
+// SnapManifestEntry - one file of the snapshots dir. Accessors (.idx, .kvi, .bt, ...) are paired with the data file
+// they were opened for, so an accessor left from another version of the data file is detected even if it still parses.
+type SnapManifestEntry struct {
+	Name     string           `json:"name"` // relative to the snapshots dir
+	Size     int64            `json:"size"`
+	Hash     hexutility.Bytes `json:"sha256"`
+	Words    uint64           `json:"words"`              // words of data files, keys of accessors
+	DataFile string           `json:"dataFile,omitempty"` // accessors only
+}
+
+// SnapManifest - files opened by the Aggregator and RoSnapshots of the datadir, signed by the node key.
+// The node key lives in the same datadir, so whoever can change the files can re-sign the manifest as well:
+// the signature only catches accidental edits (a corrupted or hand-edited manifest), not tampering.
+type SnapManifest struct {
+	Files     []SnapManifestEntry `json:"files"`
+	Signature hexutility.Bytes    `json:"signature"`
+}
+
+// NewSnapManifest - hashes the opened files in parallel, `files` are paths inside snapDir
+func NewSnapManifest(ctx context.Context, snapDir string, files []snaptype.OpenedFile, workers int, logger log.Logger) (*SnapManifest, error) {
+	m := &SnapManifest{}
+	for _, f := range files {
+		dataName, err := filepath.Rel(snapDir, f.Path)
+		if err != nil {
+			return nil, err
+		}
+		m.Files = append(m.Files, SnapManifestEntry{Name: dataName, Words: f.Words})
+		for _, a := range f.Accessors {
+			name, err := filepath.Rel(snapDir, a.Path)
+			if err != nil {
+				return nil, err
+			}
+			m.Files = append(m.Files, SnapManifestEntry{Name: name, Words: a.Keys, DataFile: dataName})
+		}
+	}
+	slices.SortFunc(m.Files, func(a, b SnapManifestEntry) int { return strings.Compare(a.Name, b.Name) })
+	m.Files = slices.CompactFunc(m.Files, func(a, b SnapManifestEntry) bool { return a.Name == b.Name })
+
+	err := forEachSnapFile(ctx, "build manifest", m.Files, workers, logger, func(e *SnapManifestEntry) error {
+		size, hash, err := hashSnapFile(filepath.Join(snapDir, e.Name))
+		if err != nil {
+			return err
+		}
+		e.Size, e.Hash = size, hash
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *SnapManifest) digest() ([]byte, error) {
+	files, err := json.Marshal(m.Files)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(files), nil
+}
+
+func (m *SnapManifest) Sign(key *ecdsa.PrivateKey) (err error) {
+	digest, err := m.digest()
+	if err != nil {
+		return err
+	}
+	m.Signature, err = crypto.Sign(digest, key)
+	return err
+}
+
+// CheckSignature - the manifest was not changed since it was signed by the owner of `pub`
+func (m *SnapManifest) CheckSignature(pub *ecdsa.PublicKey) error {
+	digest, err := m.digest()
+	if err != nil {
+		return err
+	}
+	signer, err := crypto.SigToPub(digest, m.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid manifest signature: %w", err)
+	}
+	if crypto.PubkeyToAddress(*signer) != crypto.PubkeyToAddress(*pub) {
+		return errors.New("manifest signature doesn't match: the manifest was changed or signed by another key")
+	}
+	return nil
+}
+
+func WriteSnapManifest(fPath string, m *SnapManifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first: interrupted write must not leave a truncated manifest
+	tmpPath := fPath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, fPath)
+}
+
+func ReadSnapManifest(fPath string) (*SnapManifest, error) {
+	data, err := os.ReadFile(fPath)
+	if err != nil {
+		return nil, err
+	}
+	m := &SnapManifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", fPath, err)
+	}
+	return m, nil
+}
+
+// VerifySnapManifest - checks the signature of the manifest, then checks in parallel that every file of the manifest
+// is on disk with the same size, words count and content hash. Doesn't need the db and opened snapshots.
+// Files of the snapshots dir which are not in the manifest are only logged: they may be downloaded or built after
+// the manifest was created.
+func VerifySnapManifest(ctx context.Context, snapDir string, m *SnapManifest, pub *ecdsa.PublicKey, workers int, logger log.Logger) error {
+	if err := m.CheckSignature(pub); err != nil {
+		return err
+	}
+
+	known := make(map[string]struct{}, len(m.Files))
+	for _, e := range m.Files {
+		known[e.Name] = struct{}{}
+	}
+	var problems []string
+	for _, e := range m.Files {
+		if _, ok := known[e.DataFile]; e.DataFile != "" && !ok {
+			problems = append(problems, fmt.Sprintf("%s: data file %s is not in the manifest", e.Name, e.DataFile))
+		}
+	}
+
+	var mu sync.Mutex
+	err := forEachSnapFile(ctx, "verify manifest", m.Files, workers, logger, func(e *SnapManifestEntry) error {
+		if problem := verifySnapFile(filepath.Join(snapDir, e.Name), e); problem != "" {
+			logger.Error("[integrity] snapshot file doesn't match manifest", "file", e.Name, "problem", problem)
+			mu.Lock()
+			problems = append(problems, e.Name+": "+problem)
+			mu.Unlock()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = filepath.WalkDir(snapDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) == ".torrent" || strings.HasSuffix(path, ".tmp") {
+			return err
+		}
+		name, err := filepath.Rel(snapDir, path)
+		if err != nil {
+			return err
+		}
+		if _, ok := known[name]; !ok {
+			if _, _, ok := snaptype.ParseFileName("", filepath.Base(name)); ok {
+				logger.Warn("[integrity] snapshot file is not in the manifest", "file", name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(problems) > 0 {
+		slices.Sort(problems)
+		return fmt.Errorf("%d snapshot files don't match manifest: %s", len(problems), strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// verifySnapFile - description of the mismatch, empty if the file matches the entry
+func verifySnapFile(fPath string, e *SnapManifestEntry) string {
+	info, err := os.Stat(fPath)
+	if err != nil {
+		return err.Error()
+	}
+	if info.Size() != e.Size {
+		return fmt.Sprintf("size %d, expected %d", info.Size(), e.Size)
+	}
+	// words are cheap to check, and they give a clearer error than a hash mismatch
+	if words, ok, err := snapFileWords(fPath); err != nil {
+		return err.Error()
+	} else if ok && words != e.Words {
+		return fmt.Sprintf("words %d, expected %d", words, e.Words)
+	}
+	_, hash, err := hashSnapFile(fPath)
+	if err != nil {
+		return err.Error()
+	}
+	if !slices.Equal(hash, e.Hash) {
+		return fmt.Sprintf("sha256 %x, expected %x", hash, e.Hash)
+	}
+	return ""
+}
+
+// snapFileWords - words of data files and keys of recsplit indices, false for other files
+func snapFileWords(fPath string) (uint64, bool, error) {
+	switch filepath.Ext(fPath) {
+	case ".seg", ".kv", ".v", ".ef":
+		d, err := seg.NewDecompressor(fPath)
+		if err != nil {
+			return 0, false, err
+		}
+		defer d.Close()
+		return uint64(d.Count()), true, nil
+	case ".idx", ".kvi", ".vi", ".efi":
+		idx, err := recsplit.OpenIndex(fPath)
+		if err != nil {
+			return 0, false, err
+		}
+		defer idx.Close()
+		return idx.KeyCount(), true, nil
+	}
+	return 0, false, nil
+}
+
+func hashSnapFile(fPath string) (size int64, hash []byte, err error) {
+	f, err := os.Open(fPath)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if size, err = io.Copy(h, f); err != nil {
+		return 0, nil, err
+	}
+	return size, h.Sum(nil), nil
+}
+
+// forEachSnapFile - runs `f` for every entry on `workers` goroutines, stops submitting at the first error and
+// returns it; logs progress until all the submitted tasks are finished
+func forEachSnapFile(ctx context.Context, logPrefix string, entries []SnapManifestEntry, workers int, logger log.Logger, f func(e *SnapManifestEntry) error) error {
+	logEvery := time.NewTicker(20 * time.Second)
+	defer logEvery.Stop()
+
+	var done atomic.Uint64
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(workers)
+	finished := make(chan error, 1)
+	go func() {
+		for i := range entries {
+			if gCtx.Err() != nil {
+				break
+			}
+			e := &entries[i]
+			g.Go(func() error {
+				defer done.Add(1)
+				return f(e)
+			})
+		}
+		finished <- g.Wait()
+	}()
+	for {
+		select {
+		case err := <-finished:
+			if err != nil {
+				return err
+			}
+			return ctx.Err()
+		case <-logEvery.C:
+			logger.Info(fmt.Sprintf("[integrity] %s", logPrefix), "progress", fmt.Sprintf("%d/%d", done.Load(), len(entries)))
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package integrity
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ledgerwatch/log/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon-lib/downloader/snaptype"
+	"github.com/ledgerwatch/erigon-lib/seg"
+
+	"github.com/ledgerwatch/erigon/crypto"
+)
+
+func TestSnapManifest(t *testing.T) {
+	require := require.New(t)
+	ctx, logger := context.Background(), log.New()
+	snapDir := t.TempDir()
+
+	segPath := filepath.Join(snapDir, "v1-000000-000500-headers.seg")
+	c, err := seg.NewCompressor(ctx, "test", segPath, t.TempDir(), seg.MinPatternScore, 1, log.LvlDebug, logger)
+	require.NoError(err)
+	for _, w := range []string{"a", "b", "c"} {
+		require.NoError(c.AddWord([]byte(w)))
+	}
+	require.NoError(c.Compress())
+	c.Close()
+	require.NoError(os.MkdirAll(filepath.Join(snapDir, "domain"), 0755))
+	btPath := filepath.Join(snapDir, "domain", "v1-accounts.0-32.bt")
+	require.NoError(os.WriteFile(btPath, []byte{1, 2, 3}, 0644))
+
+	files := []snaptype.OpenedFile{{Path: segPath, Words: 3, Accessors: []snaptype.OpenedAccessor{{Path: btPath, Keys: 1}}}}
+	m, err := NewSnapManifest(ctx, snapDir, files, 2, logger)
+	require.NoError(err)
+	require.Len(m.Files, 2)
+	require.Equal(filepath.Join("domain", "v1-accounts.0-32.bt"), m.Files[0].Name)
+	require.Equal("v1-000000-000500-headers.seg", m.Files[0].DataFile)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(err)
+	require.NoError(m.Sign(key))
+	manifestPath := filepath.Join(t.TempDir(), SnapManifestFileName)
+	require.NoError(WriteSnapManifest(manifestPath, m))
+	m, err = ReadSnapManifest(manifestPath)
+	require.NoError(err)
+	require.NoError(VerifySnapManifest(ctx, snapDir, m, &key.PublicKey, 2, logger))
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(err)
+	require.Error(VerifySnapManifest(ctx, snapDir, m, &otherKey.PublicKey, 2, logger))
+
+	// accessor was rebuilt after the manifest was created
+	require.NoError(os.WriteFile(btPath, []byte{3, 2, 1}, 0644))
+	require.ErrorContains(VerifySnapManifest(ctx, snapDir, m, &key.PublicKey, 2, logger), "sha256")
+	require.NoError(os.Remove(btPath))
+	require.ErrorContains(VerifySnapManifest(ctx, snapDir, m, &key.PublicKey, 2, logger), "no such file")
+
+	// manifest was edited
+	m.Files[1].Words = 4
+	require.ErrorContains(VerifySnapManifest(ctx, snapDir, m, &key.PublicKey, 2, logger), "signature doesn't match")
+}
+
+func TestForEachSnapFile(t *testing.T) {
+	require := require.New(t)
+	logger := log.New()
+	entries := make([]SnapManifestEntry, 100)
+
+	// the error of the worker is returned, not the cancellation it caused, and only after all started tasks finish
+	errBroken := errors.New("broken")
+	var running atomic.Int32
+	err := forEachSnapFile(context.Background(), "test", entries, 4, logger, func(e *SnapManifestEntry) error {
+		running.Add(1)
+		defer running.Add(-1)
+		if e == &entries[10] {
+			return errBroken
+		}
+		time.Sleep(time.Millisecond)
+		return nil
+	})
+	require.ErrorIs(err, errBroken)
+	require.Zero(running.Load())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = forEachSnapFile(ctx, "test", entries, 4, logger, func(e *SnapManifestEntry) error { return nil })
+	require.ErrorIs(err, context.Canceled)
+}
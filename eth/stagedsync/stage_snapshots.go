@@ -10,6 +10,7 @@ import (
 	"io"
 	"io/fs"
 	"math/big"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -615,7 +616,7 @@ type snapshotUploader struct {
 	files           map[string]*uploadState
 	uploadFs        string
 	rclone          *downloader.RCloneClient
-	uploadSession   *downloader.RCloneSession
+	uploadSession   downloader.UploadSession
 	uploadScheduled atomic.Bool
 	uploading       atomic.Bool
 	manifestMutex   sync.Mutex
@@ -1008,14 +1009,14 @@ func isLocalFs(ctx context.Context, rclient *downloader.RCloneClient, fs string)
 	return true
 }
 
-func (u *snapshotUploader) start(ctx context.Context, logger log.Logger) {
+func (u *snapshotUploader) startRClone(ctx context.Context, logger log.Logger) bool {
 	var err error
 
 	u.rclone, err = downloader.NewRCloneClient(logger)
 
 	if err != nil {
 		logger.Warn("[uploader] Uploading disabled: rclone start failed", "err", err)
-		return
+		return false
 	}
 
 	uploadFs := u.uploadFs
@@ -1027,19 +1028,42 @@ func (u *snapshotUploader) start(ctx context.Context, logger log.Logger) {
 
 		if err != nil {
 			logger.Warn("[uploader] Uploading disabled: invalid upload fs", "err", err, "fs", u.uploadFs)
-			return
+			return false
 		}
 
 		if err := os.MkdirAll(uploadFs, 0755); err != nil {
 			logger.Warn("[uploader] Uploading disabled: can't create upload fs", "err", err, "fs", u.uploadFs)
-			return
+			return false
 		}
 	}
 
-	u.uploadSession, err = u.rclone.NewSession(ctx, u.cfg.dirs.Snap, uploadFs, nil)
+	session, err := u.rclone.NewSession(ctx, u.cfg.dirs.Snap, uploadFs, nil)
 
 	if err != nil {
 		logger.Warn("[uploader] Uploading disabled: rclone session failed", "err", err)
+		return false
+	}
+
+	u.uploadSession = session
+	return true
+}
+
+func (u *snapshotUploader) start(ctx context.Context, logger log.Logger) {
+	var err error
+
+	if strings.HasPrefix(u.uploadFs, "s3://") {
+		// native object store client, doesn't need rclone
+		var s3Url *url.URL
+		var s3Cfg *downloader.S3Config
+		if s3Url, err = url.Parse(u.uploadFs); err == nil {
+			s3Cfg, err = downloader.ParseS3URL(s3Url)
+		}
+		if err != nil {
+			logger.Warn("[uploader] Uploading disabled: invalid upload fs", "err", err, "fs", u.uploadFs)
+			return
+		}
+		u.uploadSession = downloader.NewS3Client(*s3Cfg, nil).NewSession(u.cfg.dirs.Snap)
+	} else if !u.startRClone(ctx, logger) {
 		return
 	}
 
@@ -1298,7 +1322,7 @@ func (u *snapshotUploader) upload(ctx context.Context, logger log.Logger) {
 			err := func() error {
 				state.Lock()
 				defer state.Unlock()
-				if !state.remote && state.torrent != nil && len(state.uploads) == 0 && u.rclone != nil {
+				if !state.remote && state.torrent != nil && len(state.uploads) == 0 && u.uploadSession != nil {
 					state.uploads = []string{state.file, state.file + ".torrent"}
 					uploadList = append(uploadList, state)
 				}
@@ -15,6 +15,7 @@ import (
 	"github.com/ledgerwatch/erigon-lib/state"
 	"github.com/ledgerwatch/erigon-lib/wrap"
 
+	"github.com/ledgerwatch/erigon/core/rawdb"
 	"github.com/ledgerwatch/erigon/eth/ethconfig"
 	"github.com/ledgerwatch/erigon/eth/stagedsync/stages"
 )
@@ -34,6 +35,8 @@ type Sync struct {
 	logPrefixes   []string
 	logger        log.Logger
 	stagesIdsList []string
+
+	onStageProgress func() // called after a stage committed its own progress
 }
 
 type Timing struct {
@@ -162,11 +165,32 @@ func (s *Sync) UnwindTo(unwindPoint uint64, reason UnwindReason, tx kv.Tx) error
 		s.logger.Debug("UnwindTo", "block", unwindPoint, "stack", dbg.Stack())
 	}
 
+	if reason.IsBadBlock() && reason.Block != nil {
+		if rwTx, ok := tx.(kv.RwTx); ok {
+			if err := writeBadBlock(rwTx, *reason.Block); err != nil {
+				s.logger.Warn("UnwindTo: can't persist bad block", "block_hash", reason.Block.String(), "err", err)
+			}
+		}
+	}
+
 	s.unwindPoint = &unwindPoint
 	s.unwindReason = reason
 	return nil
 }
 
+// writeBadBlock persists the rejected block, so it can be queried later by debug_getBadBlocks
+func writeBadBlock(tx kv.RwTx, hash libcommon.Hash) error {
+	number := rawdb.ReadHeaderNumber(tx, hash)
+	if number == nil {
+		return nil
+	}
+	block := rawdb.ReadBlock(tx, hash, *number)
+	if block == nil {
+		return nil
+	}
+	return rawdb.WriteBadBlock(tx, block)
+}
+
 func (s *Sync) IsDone() bool {
 	return s.currentStage >= uint(len(s.stages)) && s.unwindPoint == nil
 }
@@ -185,6 +209,12 @@ func (s *Sync) StagesIdsList() []string {
 	return s.stagesIdsList
 }
 
+// SetOnStageProgress sets a callback invoked each time a stage running in its own transaction commits progress,
+// so that subscribers don't have to wait for the end of the cycle to observe it.
+func (s *Sync) SetOnStageProgress(f func()) {
+	s.onStageProgress = f
+}
+
 func (s *Sync) SetCurrentStage(id stages.SyncStage) error {
 	for i, stage := range s.stages {
 		if stage.ID == id {
@@ -537,6 +567,9 @@ func (s *Sync) runStage(stage *Stage, db kv.RwDB, txc wrap.TxContainer, firstCyc
 		s.logger.Debug("Error while executing stage", "err", wrappedError)
 		return wrappedError
 	}
+	if txc.Tx == nil && s.onStageProgress != nil {
+		s.onStageProgress()
+	}
 
 	took := time.Since(start)
 	logPrefix := s.LogPrefix()
@@ -0,0 +1,464 @@
+package tracetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/dir"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+
+	"github.com/ledgerwatch/erigon/common"
+	"github.com/ledgerwatch/erigon/core"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/core/vm/evmtypes"
+	"github.com/ledgerwatch/erigon/eth/tracers"
+	"github.com/ledgerwatch/erigon/params"
+	"github.com/ledgerwatch/erigon/tests"
+	"github.com/ledgerwatch/erigon/turbo/stages/mock"
+)
+
+// flatCallTrace is a single frame of a flatCallTracer run.
+type flatCallTrace struct {
+	Action struct {
+		CallType string             `json:"callType"`
+		From     *libcommon.Address `json:"from"`
+		To       *libcommon.Address `json:"to"`
+		Gas      *hexutil.Uint64    `json:"gas"`
+		Input    hexutility.Bytes   `json:"input"`
+		Init     hexutility.Bytes   `json:"init"`
+	} `json:"action"`
+	BlockHash           *libcommon.Hash `json:"blockHash"`
+	BlockNumber         uint64          `json:"blockNumber"`
+	Error               string          `json:"error"`
+	Subtraces           int             `json:"subtraces"`
+	TraceAddress        []int           `json:"traceAddress"`
+	TransactionHash     *libcommon.Hash `json:"transactionHash"`
+	TransactionPosition uint64          `json:"transactionPosition"`
+	Type                string          `json:"type"`
+}
+
+// runCallTracerTest executes the transaction of a call tracer test case with the given tracer.
+func runCallTracerTest(t *testing.T, test *callTracerTest, tracerName string, ctx *tracers.Context, cfg json.RawMessage) json.RawMessage {
+	tx, err := types.UnmarshalTransactionFromBinary(common.FromHex(test.Input), false /* blobTxnsAreWrappedWithBlobs */)
+	require.NoError(t, err)
+	var (
+		signer    = types.MakeSigner(test.Genesis.Config, uint64(test.Context.Number), uint64(test.Context.Time))
+		origin, _ = signer.Sender(tx)
+		txContext = evmtypes.TxContext{
+			Origin:   origin,
+			GasPrice: tx.GetPrice(),
+		}
+		context = evmtypes.BlockContext{
+			CanTransfer: core.CanTransfer,
+			Transfer:    core.Transfer,
+			Coinbase:    test.Context.Miner,
+			BlockNumber: uint64(test.Context.Number),
+			Time:        uint64(test.Context.Time),
+			Difficulty:  (*big.Int)(test.Context.Difficulty),
+			GasLimit:    uint64(test.Context.GasLimit),
+		}
+		rules = test.Genesis.Config.Rules(context.BlockNumber, context.Time)
+	)
+	m := mock.Mock(t)
+	dbTx, err := m.DB.BeginRw(m.Ctx)
+	require.NoError(t, err)
+	defer dbTx.Rollback()
+	statedb, _ := tests.MakePreState(rules, dbTx, test.Genesis.Alloc, uint64(test.Context.Number), m.HistoryV3)
+	if test.Genesis.BaseFee != nil {
+		context.BaseFee, _ = uint256.FromBig(test.Genesis.BaseFee)
+	}
+	tracer, err := tracers.New(tracerName, ctx, cfg)
+	require.NoError(t, err)
+	evm := vm.NewEVM(context, txContext, statedb, test.Genesis.Config, vm.Config{Debug: true, Tracer: tracer})
+	msg, err := tx.AsMessage(*signer, test.Genesis.BaseFee, rules)
+	require.NoError(t, err)
+	_, err = core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(tx.GetGas()).AddBlobGas(tx.GetBlobGas()), true /* refunds */, false /* gasBailout */)
+	require.NoError(t, err)
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	return res
+}
+
+// readCallTracerTests loads all call tracer test cases of the given testdata directory.
+func readCallTracerTests(t *testing.T, dirPath string) map[string]*callTracerTest {
+	files, err := dir.ReadDir(filepath.Join("testdata", dirPath))
+	require.NoError(t, err)
+	res := make(map[string]*callTracerTest)
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		blob, err := os.ReadFile(filepath.Join("testdata", dirPath, file.Name()))
+		require.NoError(t, err)
+		test := new(callTracerTest)
+		require.NoError(t, json.Unmarshal(blob, test))
+		res[camel(strings.TrimSuffix(file.Name(), ".json"))] = test
+	}
+	return res
+}
+
+// flattenCallTrace lists the frames of a nested call trace in the depth-first order used by the flat tracer.
+func flattenCallTrace(call *callTrace, traceAddress []int, res []*callTrace, addresses [][]int) ([]*callTrace, [][]int) {
+	res = append(res, call)
+	addresses = append(addresses, traceAddress)
+	for i := range call.Calls {
+		child := append(append([]int{}, traceAddress...), i)
+		res, addresses = flattenCallTrace(&call.Calls[i], child, res, addresses)
+	}
+	return res, addresses
+}
+
+// dropPrecompileCalls removes the plain and static calls to precompiles from a nested call trace,
+// mirroring what the flat tracer does unless includePrecompiles is set.
+func dropPrecompileCalls(call *callTrace, precompiles map[libcommon.Address]struct{}) {
+	calls := call.Calls[:0]
+	for i := range call.Calls {
+		if _, ok := precompiles[call.Calls[i].To]; ok && (call.Calls[i].Type == "CALL" || call.Calls[i].Type == "STATICCALL") {
+			continue
+		}
+		dropPrecompileCalls(&call.Calls[i], precompiles)
+		calls = append(calls, call.Calls[i])
+	}
+	call.Calls = calls
+}
+
+// TestFlatCallTracerNative checks the flat traces against the nested ones of the call tracer test suites,
+// both with and without precompile calls.
+func TestFlatCallTracerNative(t *testing.T) {
+	for _, dirPath := range []string{"call_tracer", "call_tracer_withLog"} {
+		for name, test := range readCallTracerTests(t, dirPath) {
+			test := test
+			for _, includePrecompiles := range []bool{false, true} {
+				includePrecompiles := includePrecompiles
+				t.Run(fmt.Sprintf("%s/%s/includePrecompiles=%t", dirPath, name, includePrecompiles), func(t *testing.T) {
+					testFlatCallTracer(t, test, includePrecompiles)
+				})
+			}
+		}
+	}
+}
+
+func testFlatCallTracer(t *testing.T, test *callTracerTest, includePrecompiles bool) {
+	var (
+		blockHash = libcommon.HexToHash("0x01")
+		txHash    = libcommon.HexToHash("0x02")
+		ctx       = &tracers.Context{BlockHash: blockHash, BlockNumber: big.NewInt(int64(test.Context.Number)), TxIndex: 3, TxHash: txHash}
+		cfg       json.RawMessage
+	)
+	if includePrecompiles {
+		cfg = json.RawMessage(`{"includePrecompiles":true}`)
+	}
+	var flat []flatCallTrace
+	require.NoError(t, json.Unmarshal(runCallTracerTest(t, test, "flatCallTracer", ctx, cfg), &flat))
+	var nested callTrace
+	require.NoError(t, json.Unmarshal(runCallTracerTest(t, test, "callTracer", new(tracers.Context), nil), &nested))
+	if !includePrecompiles {
+		precompiles := make(map[libcommon.Address]struct{})
+		for _, addr := range vm.ActivePrecompiles(test.Genesis.Config.Rules(uint64(test.Context.Number), uint64(test.Context.Time))) {
+			precompiles[addr] = struct{}{}
+		}
+		dropPrecompileCalls(&nested, precompiles)
+	}
+
+	frames, addresses := flattenCallTrace(&nested, []int{}, nil, nil)
+	require.Len(t, flat, len(frames))
+	for i, frame := range flat {
+		require.Equal(t, addresses[i], frame.TraceAddress)
+		require.Equal(t, len(frames[i].Calls), frame.Subtraces)
+		require.Equal(t, frames[i].Error, frame.Error)
+		require.Equal(t, blockHash, *frame.BlockHash)
+		require.Equal(t, uint64(test.Context.Number), frame.BlockNumber)
+		require.Equal(t, txHash, *frame.TransactionHash)
+		require.Equal(t, uint64(3), frame.TransactionPosition)
+		switch frames[i].Type {
+		case "CREATE", "CREATE2":
+			require.Equal(t, "create", frame.Type)
+			require.Equal(t, frames[i].Input, frame.Action.Init)
+		case "SELFDESTRUCT":
+			require.Equal(t, "suicide", frame.Type)
+		default:
+			require.Equal(t, "call", frame.Type)
+			require.Equal(t, strings.ToLower(frames[i].Type), frame.Action.CallType)
+			require.Equal(t, frames[i].From, *frame.Action.From)
+			require.Equal(t, frames[i].To, *frame.Action.To)
+			require.Equal(t, frames[i].Input, frame.Action.Input)
+		}
+	}
+}
+
+// runErc7562Tracer applies a plain call from origin to to on top of alloc and returns the erc7562 tracer result.
+func runErc7562Tracer(t *testing.T, alloc types.GenesisAlloc, origin, to libcommon.Address) json.RawMessage {
+	tracer, err := tracers.New("erc7562Tracer", nil, nil)
+	require.NoError(t, err)
+	applyTracedMessage(t, tracer, alloc, origin, to)
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	return res
+}
+
+// applyTracedMessage executes a plain call from origin to to on top of alloc with the given tracer.
+func applyTracedMessage(t *testing.T, tracer vm.EVMLogger, alloc types.GenesisAlloc, origin, to libcommon.Address) {
+	context := evmtypes.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: 8000000,
+		Time:        5,
+		Difficulty:  big.NewInt(0x30000),
+		GasLimit:    uint64(6000000),
+	}
+	rules := params.MainnetChainConfig.Rules(context.BlockNumber, context.Time)
+	m := mock.Mock(t)
+	dbTx, err := m.DB.BeginRw(m.Ctx)
+	require.NoError(t, err)
+	defer dbTx.Rollback()
+	statedb, _ := tests.MakePreState(rules, dbTx, alloc, context.BlockNumber, m.HistoryV3)
+
+	evm := vm.NewEVM(context, evmtypes.TxContext{Origin: origin, GasPrice: uint256.NewInt(1)}, statedb, params.MainnetChainConfig, vm.Config{Debug: true, Tracer: tracer})
+	msg := types.NewMessage(origin, &to, 0, uint256.NewInt(0), 100000, uint256.NewInt(1), uint256.NewInt(1), uint256.NewInt(1), nil, nil, false, false, nil)
+	_, err = core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(msg.Gas()), true /* refunds */, false /* gasBailout */)
+	require.NoError(t, err)
+}
+
+// TestErc7562TracerStorageAccess checks the storage, opcode and contract size usage recorded by the erc7562 tracer.
+func TestErc7562TracerStorageAccess(t *testing.T) {
+	var (
+		to     = libcommon.HexToAddress("0x00000000000000000000000000000000deadbeef")
+		callee = libcommon.HexToAddress("0x00000000000000000000000000000000cafebabe")
+		origin = libcommon.HexToAddress("0x682a80a6f560eec50d54e63cbeda1c324c5f8d1b")
+	)
+	var code = []byte{
+		byte(vm.PUSH1), 0x1, byte(vm.SLOAD), byte(vm.POP), // read slot 1
+		byte(vm.PUSH1), 0x7, byte(vm.PUSH1), 0x2, byte(vm.SSTORE), // write 7 to slot 2
+		byte(vm.PUSH1), 0x2, byte(vm.SLOAD), byte(vm.POP), // read slot 2 after the write
+		byte(vm.TIMESTAMP), byte(vm.POP),
+		byte(vm.PUSH1), 0x0, byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1), // in and outs zero
+		byte(vm.PUSH20),
+	}
+	code = append(code, callee.Bytes()...)
+	code = append(code, byte(vm.GAS), byte(vm.STATICCALL), byte(vm.POP))
+	alloc := types.GenesisAlloc{
+		to: types.GenesisAccount{
+			Nonce:   1,
+			Code:    code,
+			Storage: map[libcommon.Hash]libcommon.Hash{libcommon.HexToHash("0x01"): libcommon.HexToHash("0x2a")},
+		},
+		callee: types.GenesisAccount{
+			Nonce: 1,
+			Code:  []byte{byte(vm.NUMBER), byte(vm.POP)},
+		},
+		origin: types.GenesisAccount{
+			Balance: big.NewInt(500000000000000),
+		},
+	}
+	res := runErc7562Tracer(t, alloc, origin, to)
+
+	var frame struct {
+		AccessedSlots struct {
+			Reads  map[string][]string `json:"reads"`
+			Writes map[string]uint64   `json:"writes"`
+		} `json:"accessedSlots"`
+		UsedOpcodes  map[string]uint64 `json:"usedOpcodes"`
+		ContractSize map[libcommon.Address]struct {
+			ContractSize int       `json:"contractSize"`
+			Opcode       vm.OpCode `json:"opcode"`
+		} `json:"contractSize"`
+		Calls []struct {
+			UsedOpcodes map[string]uint64 `json:"usedOpcodes"`
+			Type        string            `json:"type"`
+		} `json:"calls"`
+	}
+	require.NoError(t, json.Unmarshal(res, &frame))
+
+	slot1, slot2 := libcommon.HexToHash("0x01").Hex(), libcommon.HexToHash("0x02").Hex()
+	require.Equal(t, map[string][]string{slot1: {libcommon.HexToHash("0x2a").Hex()}}, frame.AccessedSlots.Reads)
+	require.Equal(t, map[string]uint64{slot2: 1}, frame.AccessedSlots.Writes)
+	// GAS followed by a call is allowed and thus not recorded
+	require.Equal(t, map[string]uint64{
+		hexutil.EncodeUint64(uint64(vm.SLOAD)):      2,
+		hexutil.EncodeUint64(uint64(vm.SSTORE)):     1,
+		hexutil.EncodeUint64(uint64(vm.TIMESTAMP)):  1,
+		hexutil.EncodeUint64(uint64(vm.STATICCALL)): 1,
+	}, frame.UsedOpcodes)
+	require.Equal(t, 2, frame.ContractSize[callee].ContractSize)
+	require.Equal(t, vm.STATICCALL, frame.ContractSize[callee].Opcode)
+	require.Len(t, frame.Calls, 1)
+	require.Equal(t, "STATICCALL", frame.Calls[0].Type)
+	require.Equal(t, map[string]uint64{hexutil.EncodeUint64(uint64(vm.NUMBER)): 1}, frame.Calls[0].UsedOpcodes)
+}
+
+// TestErc7562TracerCallFailures checks that reverted and out of gas sub-calls, keccak preimages and
+// code accesses are recorded on the right frames.
+func TestErc7562TracerCallFailures(t *testing.T) {
+	var (
+		to       = libcommon.HexToAddress("0x00000000000000000000000000000000deadbeef")
+		reverter = libcommon.HexToAddress("0x00000000000000000000000000000000000beef1")
+		looper   = libcommon.HexToAddress("0x00000000000000000000000000000000000beef2")
+		origin   = libcommon.HexToAddress("0x682a80a6f560eec50d54e63cbeda1c324c5f8d1b")
+	)
+	var code = []byte{
+		byte(vm.PUSH1), 0x2a, byte(vm.PUSH1), 0x0, byte(vm.MSTORE8),
+		byte(vm.PUSH1), 0x1, byte(vm.PUSH1), 0x0, byte(vm.KECCAK256), byte(vm.POP), // hash a single 0x2a byte
+		byte(vm.PUSH20),
+	}
+	code = append(code, reverter.Bytes()...)
+	code = append(code, byte(vm.EXTCODESIZE), byte(vm.POP))
+	code = append(code, byte(vm.PUSH1), 0x0, byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1), byte(vm.PUSH20))
+	code = append(code, reverter.Bytes()...)
+	code = append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.POP))
+	code = append(code, byte(vm.PUSH1), 0x0, byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1), byte(vm.PUSH20))
+	code = append(code, looper.Bytes()...)
+	code = append(code, byte(vm.PUSH2), 0x03, 0xe8, byte(vm.CALL), byte(vm.POP)) // call the looper with 1000 gas
+	alloc := types.GenesisAlloc{
+		to: types.GenesisAccount{
+			Nonce: 1,
+			Code:  code,
+		},
+		reverter: types.GenesisAccount{
+			Nonce: 1,
+			Code:  []byte{byte(vm.PUSH1), 0x0, byte(vm.DUP1), byte(vm.REVERT)},
+		},
+		looper: types.GenesisAccount{
+			Nonce: 1,
+			Code:  []byte{byte(vm.JUMPDEST), byte(vm.PUSH1), 0x0, byte(vm.JUMP)},
+		},
+		origin: types.GenesisAccount{
+			Balance: big.NewInt(500000000000000),
+		},
+	}
+	res := runErc7562Tracer(t, alloc, origin, to)
+
+	type callFrame struct {
+		Error    string `json:"error"`
+		OutOfGas bool   `json:"outOfGas"`
+	}
+	var frame struct {
+		ExtCodeAccessInfo []libcommon.Address `json:"extCodeAccessInfo"`
+		KeccakPreimages   []hexutility.Bytes  `json:"keccak"`
+		ContractSize      map[libcommon.Address]struct {
+			ContractSize int       `json:"contractSize"`
+			Opcode       vm.OpCode `json:"opcode"`
+		} `json:"contractSize"`
+		OutOfGas bool        `json:"outOfGas"`
+		Calls    []callFrame `json:"calls"`
+	}
+	require.NoError(t, json.Unmarshal(res, &frame))
+
+	require.Equal(t, []hexutility.Bytes{{0x2a}}, frame.KeccakPreimages)
+	require.Equal(t, []libcommon.Address{reverter}, frame.ExtCodeAccessInfo)
+	// the first access to the reverter is the EXTCODESIZE, the later CALL doesn't overwrite it
+	require.Equal(t, 4, frame.ContractSize[reverter].ContractSize)
+	require.Equal(t, vm.EXTCODESIZE, frame.ContractSize[reverter].Opcode)
+	require.Equal(t, vm.CALL, frame.ContractSize[looper].Opcode)
+	require.False(t, frame.OutOfGas)
+	require.Equal(t, []callFrame{
+		{Error: vm.ErrExecutionReverted.Error()},
+		{Error: vm.ErrOutOfGas.Error(), OutOfGas: true},
+	}, frame.Calls)
+}
+
+// TestErc7562TracerKeccakFreshMemory checks that keccak preimages in memory which is not expanded yet are zeroes.
+func TestErc7562TracerKeccakFreshMemory(t *testing.T) {
+	var (
+		to     = libcommon.HexToAddress("0x00000000000000000000000000000000deadbeef")
+		origin = libcommon.HexToAddress("0x682a80a6f560eec50d54e63cbeda1c324c5f8d1b")
+	)
+	var code = []byte{
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x0, byte(vm.KECCAK256), byte(vm.POP), // hash 32 bytes of empty memory
+		byte(vm.PUSH1), 0x2a, byte(vm.PUSH1), 0x3f, byte(vm.MSTORE8), // memory is 64 bytes now
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x3f, byte(vm.KECCAK256), byte(vm.POP), // hash 0x2a and 31 bytes beyond the memory
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x80, byte(vm.KECCAK256), byte(vm.POP), // hash 32 bytes after the memory
+	}
+	alloc := types.GenesisAlloc{
+		to:     types.GenesisAccount{Nonce: 1, Code: code},
+		origin: types.GenesisAccount{Balance: big.NewInt(500000000000000)},
+	}
+	res := runErc7562Tracer(t, alloc, origin, to)
+
+	var frame struct {
+		KeccakPreimages []hexutility.Bytes `json:"keccak"`
+	}
+	require.NoError(t, json.Unmarshal(res, &frame))
+	zeroes := make(hexutility.Bytes, 32)
+	require.Equal(t, []hexutility.Bytes{zeroes, append(hexutility.Bytes{0x2a}, zeroes[1:]...), zeroes}, frame.KeccakPreimages)
+}
+
+// stoppingTracer stops the wrapped tracer right before the first execution of the given opcode.
+type stoppingTracer struct {
+	tracers.Tracer
+	op     vm.OpCode
+	reason error
+}
+
+func (t *stoppingTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if op == t.op && t.reason != nil {
+		t.Tracer.Stop(t.reason)
+		t.reason = nil
+	}
+	t.Tracer.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+// TestTracersInterruptedInNestedCall checks that a tracer stopped inside a nested call, which then
+// makes another call, still returns the frames traced until then together with the interruption reason.
+func TestTracersInterruptedInNestedCall(t *testing.T) {
+	var (
+		to     = libcommon.HexToAddress("0x00000000000000000000000000000000deadbeef")
+		callee = libcommon.HexToAddress("0x00000000000000000000000000000000cafebabe")
+		inner  = libcommon.HexToAddress("0x00000000000000000000000000000000000beef1")
+		origin = libcommon.HexToAddress("0x682a80a6f560eec50d54e63cbeda1c324c5f8d1b")
+	)
+	call := func(addr libcommon.Address) []byte {
+		code := []byte{byte(vm.PUSH1), 0x0, byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1), byte(vm.DUP1), byte(vm.PUSH20)}
+		code = append(code, addr.Bytes()...)
+		return append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.POP))
+	}
+	// the tracer is stopped at the NUMBER of the callee, before its call to inner
+	alloc := types.GenesisAlloc{
+		to:     types.GenesisAccount{Nonce: 1, Code: call(callee)},
+		callee: types.GenesisAccount{Nonce: 1, Code: append([]byte{byte(vm.NUMBER), byte(vm.POP)}, call(inner)...)},
+		inner:  types.GenesisAccount{Nonce: 1, Code: []byte{byte(vm.STOP)}},
+		origin: types.GenesisAccount{Balance: big.NewInt(500000000000000)},
+	}
+	reason := fmt.Errorf("execution timeout")
+
+	for _, tracerName := range []string{"callTracer", "flatCallTracer", "erc7562Tracer"} {
+		t.Run(tracerName, func(t *testing.T) {
+			tracer, err := tracers.New(tracerName, nil, nil)
+			require.NoError(t, err)
+			applyTracedMessage(t, &stoppingTracer{Tracer: tracer, op: vm.NUMBER, reason: reason}, alloc, origin, to)
+			res, err := tracer.GetResult()
+			require.Equal(t, reason, err)
+
+			if tracerName == "flatCallTracer" {
+				var traces []flatCallTrace
+				require.NoError(t, json.Unmarshal(res, &traces))
+				require.Len(t, traces, 2)
+				require.Equal(t, []int{0}, traces[1].TraceAddress)
+				require.Equal(t, callee, *traces[1].Action.To)
+				return
+			}
+			var frame struct {
+				To    libcommon.Address `json:"to"`
+				Calls []struct {
+					To    libcommon.Address `json:"to"`
+					Calls []json.RawMessage `json:"calls"`
+				} `json:"calls"`
+			}
+			require.NoError(t, json.Unmarshal(res, &frame))
+			require.Equal(t, to, frame.To)
+			require.Len(t, frame.Calls, 1)
+			require.Equal(t, callee, frame.Calls[0].To)
+			require.Empty(t, frame.Calls[0].Calls)
+		})
+	}
+}
@@ -108,6 +108,7 @@ type callTracer struct {
 	gasLimit  uint64
 	interrupt uint32 // Atomic flag to signal execution interruption
 	reason    error  // Textual reason for the interruption
+	skipped   int    // Number of scopes entered after the interruption, which have no frame on the callstack
 	logIndex  uint64
 	logGaps   map[uint64]int
 }
@@ -199,8 +200,9 @@ func (t *callTracer) CaptureEnter(typ vm.OpCode, from libcommon.Address, to libc
 	if t.config.OnlyTopCall {
 		return
 	}
-	// Skip if tracing was interrupted
+	// Skip if tracing was interrupted, the matching exit must not pop a frame
 	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.skipped++
 		return
 	}
 
@@ -223,6 +225,12 @@ func (t *callTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
 	if t.config.OnlyTopCall {
 		return
 	}
+	// Scopes entered after the interruption have no frame, the ones entered before are still popped
+	// to keep the callstack balanced, so that the partial trace can be returned
+	if t.skipped > 0 {
+		t.skipped--
+		return
+	}
 	size := len(t.callstack)
 	if size <= 1 {
 		return
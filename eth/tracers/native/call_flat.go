@@ -0,0 +1,375 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/holiman/uint256"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/eth/tracers"
+)
+
+//go:generate gencodec -type flatCallAction -field-override flatCallActionMarshaling -out gen_flatcallaction_json.go
+//go:generate gencodec -type flatCallResult -field-override flatCallResultMarshaling -out gen_flatcallresult_json.go
+
+func init() {
+	register("flatCallTracer", newFlatCallTracer)
+}
+
+var parityErrorMapping = map[string]string{
+	"contract creation code storage out of gas": "Out of gas",
+	"out of gas":                      "Out of gas",
+	"gas uint64 overflow":             "Out of gas",
+	"max code size exceeded":          "Out of gas",
+	"invalid jump destination":        "Bad jump destination",
+	"execution reverted":              "Reverted",
+	"return data out of bounds":       "Out of bounds",
+	"stack limit reached 1024 (1023)": "Out of stack",
+	"precompiled failed":              "Built-in failed",
+	"invalid input length":            "Built-in failed",
+}
+
+var parityErrorMappingStartingWith = map[string]string{
+	"invalid opcode:": "Bad instruction",
+	"stack underflow": "Stack underflow",
+}
+
+// flatCallFrame is a standalone callframe.
+type flatCallFrame struct {
+	Action              flatCallAction  `json:"action"`
+	BlockHash           *libcommon.Hash `json:"blockHash"`
+	BlockNumber         uint64          `json:"blockNumber"`
+	Error               string          `json:"error,omitempty"`
+	Result              *flatCallResult `json:"result,omitempty"`
+	Subtraces           int             `json:"subtraces"`
+	TraceAddress        []int           `json:"traceAddress"`
+	TransactionHash     *libcommon.Hash `json:"transactionHash"`
+	TransactionPosition uint64          `json:"transactionPosition"`
+	Type                string          `json:"type"`
+}
+
+type flatCallAction struct {
+	Author         *libcommon.Address `json:"author,omitempty"`
+	RewardType     string             `json:"rewardType,omitempty"`
+	SelfDestructed *libcommon.Address `json:"address,omitempty"`
+	Balance        *big.Int           `json:"balance,omitempty"`
+	CallType       string             `json:"callType,omitempty"`
+	CreationMethod string             `json:"creationMethod,omitempty"`
+	From           *libcommon.Address `json:"from,omitempty"`
+	Gas            *uint64            `json:"gas,omitempty"`
+	Init           *[]byte            `json:"init,omitempty"`
+	Input          *[]byte            `json:"input,omitempty"`
+	RefundAddress  *libcommon.Address `json:"refundAddress,omitempty"`
+	To             *libcommon.Address `json:"to,omitempty"`
+	Value          *big.Int           `json:"value,omitempty"`
+}
+
+type flatCallActionMarshaling struct {
+	Balance *hexutil.Big
+	Gas     *hexutil.Uint64
+	Init    *hexutility.Bytes
+	Input   *hexutility.Bytes
+	Value   *hexutil.Big
+}
+
+type flatCallResult struct {
+	Address *libcommon.Address `json:"address,omitempty"`
+	Code    *[]byte            `json:"code,omitempty"`
+	GasUsed *uint64            `json:"gasUsed,omitempty"`
+	Output  *[]byte            `json:"output,omitempty"`
+}
+
+type flatCallResultMarshaling struct {
+	Code    *hexutility.Bytes
+	GasUsed *hexutil.Uint64
+	Output  *hexutility.Bytes
+}
+
+// flatCallTracer reports call frame information of a tx in a flat format, i.e.
+// as opposed to the nested format of `callTracer`.
+type flatCallTracer struct {
+	tracer      *callTracer
+	config      flatCallTracerConfig
+	ctx         *tracers.Context // Holds tracer context data
+	reason      error            // Textual reason for the interruption
+	precompiles []bool           // Whether each entered (and not yet exited) call frame targets a precompile
+}
+
+type flatCallTracerConfig struct {
+	ConvertParityErrors bool `json:"convertParityErrors"` // If true, call tracer converts errors to parity format
+	IncludePrecompiles  bool `json:"includePrecompiles"`  // If true, call tracer includes calls to precompiled contracts
+}
+
+// newFlatCallTracer returns a new flatCallTracer.
+func newFlatCallTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config flatCallTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create inner call tracer with default configuration, don't forward
+	// the OnlyTopCall or WithLog to inner for now
+	tracer, err := newCallTracer(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	t, ok := tracer.(*callTracer)
+	if !ok {
+		return nil, errors.New("internal error: embedded tracer has wrong type")
+	}
+
+	return &flatCallTracer{tracer: t, ctx: ctx, config: config}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *flatCallTracer) CaptureStart(env *vm.EVM, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	t.tracer.CaptureStart(env, from, to, precompile, create, input, gas, value, code)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *flatCallTracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	t.tracer.CaptureEnd(output, gasUsed, err)
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *flatCallTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	t.tracer.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *flatCallTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	t.tracer.CaptureFault(pc, op, gas, cost, scope, depth, err)
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *flatCallTracer) CaptureEnter(typ vm.OpCode, from libcommon.Address, to libcommon.Address, precompile, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	skipped := t.tracer.skipped
+	t.tracer.CaptureEnter(typ, from, to, precompile, create, input, gas, value, code)
+	// Skip if tracing was interrupted, the call tracer only counts the scope then
+	if t.tracer.skipped > skipped {
+		return
+	}
+	t.precompiles = append(t.precompiles, precompile)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *flatCallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	// Scopes entered after the interruption have no frame
+	skipped := t.tracer.skipped > 0
+	t.tracer.CaptureExit(output, gasUsed, err)
+	if skipped {
+		return
+	}
+
+	size := len(t.precompiles)
+	if size == 0 {
+		return
+	}
+	precompile := t.precompiles[size-1]
+	t.precompiles = t.precompiles[:size-1]
+	if t.config.IncludePrecompiles || !precompile {
+		return
+	}
+	// Parity traces don't include precompile calls, so we remove them from the result.
+	parent := &t.tracer.callstack[len(t.tracer.callstack)-1]
+	if len(parent.Calls) == 0 {
+		return
+	}
+	if typ := parent.Calls[len(parent.Calls)-1].Type; typ == vm.CALL || typ == vm.STATICCALL {
+		parent.Calls = parent.Calls[:len(parent.Calls)-1]
+	}
+}
+
+func (t *flatCallTracer) CaptureTxStart(gasLimit uint64) {
+	t.tracer.CaptureTxStart(gasLimit)
+}
+
+func (t *flatCallTracer) CaptureTxEnd(restGas uint64) {
+	t.tracer.CaptureTxEnd(restGas)
+}
+
+// GetResult returns an empty json object.
+func (t *flatCallTracer) GetResult() (json.RawMessage, error) {
+	if len(t.tracer.callstack) < 1 {
+		return nil, errors.New("invalid number of calls")
+	}
+
+	flat, err := flatFromNested(&t.tracer.callstack[0], []int{}, t.config.ConvertParityErrors, t.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := json.Marshal(flat)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *flatCallTracer) Stop(err error) {
+	t.tracer.Stop(err)
+	t.reason = err
+}
+
+func flatFromNested(input *callFrame, traceAddress []int, convertErrs bool, ctx *tracers.Context) (output []flatCallFrame, err error) {
+	var frame *flatCallFrame
+	switch input.Type {
+	case vm.CREATE, vm.CREATE2:
+		frame = newFlatCreate(input)
+	case vm.SELFDESTRUCT:
+		frame = newFlatSuicide(input)
+	case vm.CALL, vm.STATICCALL, vm.CALLCODE, vm.DELEGATECALL:
+		frame = newFlatCall(input)
+	default:
+		return nil, fmt.Errorf("unrecognized call frame type: %s", input.Type)
+	}
+
+	frame.Error = input.Error
+	frame.Subtraces = len(input.Calls)
+	fillCallFrameFromContext(frame, ctx)
+	frame.TraceAddress = traceAddress
+
+	// Revert output contains useful information (revert reason).
+	// Otherwise discard result.
+	if input.Error != "" && input.Error != vm.ErrExecutionReverted.Error() {
+		frame.Result = nil
+	}
+
+	if convertErrs {
+		convertErrorToParity(frame)
+	}
+
+	output = append(output, *frame)
+	for i := range input.Calls {
+		childAddr := childTraceAddress(traceAddress, i)
+		flat, err := flatFromNested(&input.Calls[i], childAddr, convertErrs, ctx)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, flat...)
+	}
+
+	return output, nil
+}
+
+func newFlatCreate(input *callFrame) *flatCallFrame {
+	var (
+		actionInit = input.Input[:]
+		resultCode = input.Output[:]
+	)
+
+	return &flatCallFrame{
+		Type: strings.ToLower(vm.CREATE.String()),
+		Action: flatCallAction{
+			From:  &input.From,
+			Gas:   &input.Gas,
+			Value: input.Value,
+			Init:  &actionInit,
+		},
+		Result: &flatCallResult{
+			GasUsed: &input.GasUsed,
+			Address: &input.To,
+			Code:    &resultCode,
+		},
+	}
+}
+
+func newFlatCall(input *callFrame) *flatCallFrame {
+	var (
+		actionInput  = input.Input[:]
+		resultOutput = input.Output[:]
+	)
+
+	return &flatCallFrame{
+		Type: strings.ToLower(vm.CALL.String()),
+		Action: flatCallAction{
+			From:     &input.From,
+			To:       &input.To,
+			Gas:      &input.Gas,
+			Value:    input.Value,
+			CallType: strings.ToLower(input.Type.String()),
+			Input:    &actionInput,
+		},
+		Result: &flatCallResult{
+			GasUsed: &input.GasUsed,
+			Output:  &resultOutput,
+		},
+	}
+}
+
+func newFlatSuicide(input *callFrame) *flatCallFrame {
+	return &flatCallFrame{
+		Type: "suicide",
+		Action: flatCallAction{
+			SelfDestructed: &input.From,
+			Balance:        input.Value,
+			RefundAddress:  &input.To,
+		},
+	}
+}
+
+func fillCallFrameFromContext(callFrame *flatCallFrame, ctx *tracers.Context) {
+	if ctx == nil {
+		return
+	}
+	if ctx.BlockHash != (libcommon.Hash{}) {
+		callFrame.BlockHash = &ctx.BlockHash
+	}
+	if ctx.BlockNumber != nil {
+		callFrame.BlockNumber = ctx.BlockNumber.Uint64()
+	}
+	if ctx.TxHash != (libcommon.Hash{}) {
+		callFrame.TransactionHash = &ctx.TxHash
+	}
+	callFrame.TransactionPosition = uint64(ctx.TxIndex)
+}
+
+func convertErrorToParity(call *flatCallFrame) {
+	if call.Error == "" {
+		return
+	}
+
+	if parityError, ok := parityErrorMapping[call.Error]; ok {
+		call.Error = parityError
+	} else {
+		for gethError, parityError := range parityErrorMappingStartingWith {
+			if strings.HasPrefix(call.Error, gethError) {
+				call.Error = parityError
+			}
+		}
+	}
+}
+
+func childTraceAddress(a []int, i int) []int {
+	child := make([]int, 0, len(a)+1)
+	child = append(child, a...)
+	child = append(child, i)
+	return child
+}
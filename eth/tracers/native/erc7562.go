@@ -0,0 +1,369 @@
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/holiman/uint256"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/eth/tracers"
+)
+
+//go:generate gencodec -type opcodeCallFrame -field-override opcodeCallFrameMarshaling -out gen_opcodecallframe_json.go
+
+func init() {
+	register("erc7562Tracer", newErc7562Tracer)
+}
+
+// accessedSlots holds the storage slots accessed by a call frame. Reads maps each slot
+// to the values read from it before it was first written, Writes and the transient
+// maps count the number of accesses.
+type accessedSlots struct {
+	Reads           map[string][]string `json:"reads"`
+	Writes          map[string]uint64   `json:"writes"`
+	TransientReads  map[string]uint64   `json:"transientReads"`
+	TransientWrites map[string]uint64   `json:"transientWrites"`
+}
+
+// contractSizeWithOpcode is the code size of a contract and the opcode by which it was first accessed.
+type contractSizeWithOpcode struct {
+	ContractSize int       `json:"contractSize"`
+	Opcode       vm.OpCode `json:"opcode"`
+}
+
+// opcodeCallFrame is a call frame annotated with the opcode, storage and contract size
+// usage needed by ERC-4337 bundlers to enforce the ERC-7562 validation rules.
+type opcodeCallFrame struct {
+	Type              vm.OpCode                                     `json:"-"`
+	From              libcommon.Address                             `json:"from"`
+	Gas               uint64                                        `json:"gas"`
+	GasUsed           uint64                                        `json:"gasUsed"`
+	To                libcommon.Address                             `json:"to,omitempty"`
+	Input             []byte                                        `json:"input"`
+	Output            []byte                                        `json:"output,omitempty"`
+	Error             string                                        `json:"error,omitempty"`
+	Revertal          string                                        `json:"revertReason,omitempty"`
+	Logs              []callLog                                     `json:"logs,omitempty"`
+	Value             *big.Int                                      `json:"value,omitempty"`
+	AccessedSlots     accessedSlots                                 `json:"accessedSlots"`
+	ExtCodeAccessInfo []libcommon.Address                           `json:"extCodeAccessInfo"`
+	UsedOpcodes       map[hexutil.Uint64]uint64                     `json:"usedOpcodes"`
+	ContractSize      map[libcommon.Address]*contractSizeWithOpcode `json:"contractSize"`
+	OutOfGas          bool                                          `json:"outOfGas"`
+	KeccakPreimages   []hexutility.Bytes                            `json:"keccak,omitempty"`
+	Calls             []opcodeCallFrame                             `json:"calls,omitempty"`
+}
+
+func (f opcodeCallFrame) TypeString() string {
+	return f.Type.String()
+}
+
+type opcodeCallFrameMarshaling struct {
+	TypeString string `json:"type"`
+	Gas        hexutil.Uint64
+	GasUsed    hexutil.Uint64
+	Value      *hexutil.Big
+	Input      hexutility.Bytes
+	Output     hexutility.Bytes
+}
+
+func newOpcodeCallFrame(typ vm.OpCode, from, to libcommon.Address, input []byte, gas uint64, value *uint256.Int) opcodeCallFrame {
+	f := opcodeCallFrame{
+		Type:  typ,
+		From:  from,
+		To:    to,
+		Input: libcommon.CopyBytes(input),
+		Gas:   gas,
+		AccessedSlots: accessedSlots{
+			Reads:           map[string][]string{},
+			Writes:          map[string]uint64{},
+			TransientReads:  map[string]uint64{},
+			TransientWrites: map[string]uint64{},
+		},
+		ExtCodeAccessInfo: []libcommon.Address{},
+		UsedOpcodes:       map[hexutil.Uint64]uint64{},
+		ContractSize:      map[libcommon.Address]*contractSizeWithOpcode{},
+	}
+	if value != nil {
+		f.Value = value.ToBig()
+	}
+	return f
+}
+
+// processOutput records the output and the error of the frame the same way callFrame does.
+func (f *opcodeCallFrame) processOutput(output []byte, err error) {
+	cf := callFrame{Type: f.Type, To: f.To}
+	cf.processOutput(output, err)
+	f.To, f.Output, f.Error, f.Revertal = cf.To, cf.Output, cf.Error, cf.Revertal
+}
+
+type erc7562TracerConfig struct {
+	IgnoredOpcodes map[hexutil.Uint64]struct{} `json:"ignoredOpcodes"` // Opcodes which are not recorded in usedOpcodes
+	WithLog        bool                        `json:"withLog"`        // If true, erc7562 tracer will collect event logs
+}
+
+// erc7562Tracer is a native tracer which records, per call frame, the opcodes used, the
+// storage slots accessed and the size of the contracts touched, so that ERC-4337 bundlers
+// can validate user operations according to ERC-7562.
+type erc7562Tracer struct {
+	noopTracer
+	env       *vm.EVM
+	callstack []opcodeCallFrame
+	config    erc7562TracerConfig
+	ignored   map[vm.OpCode]bool // Opcodes which are not recorded in usedOpcodes
+	gasLimit  uint64
+	lastOpGas bool   // whether the last executed opcode was a GAS not yet recorded
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+	skipped   int    // Number of scopes entered after the interruption, which have no frame on the callstack
+}
+
+// newErc7562Tracer returns a native go tracer which records the ERC-7562
+// validation data of a tx, and implements vm.EVMLogger.
+func newErc7562Tracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config erc7562TracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	ignored := defaultIgnoredOpcodes()
+	if config.IgnoredOpcodes != nil {
+		ignored = make(map[vm.OpCode]bool, len(config.IgnoredOpcodes))
+		for op := range config.IgnoredOpcodes {
+			ignored[vm.OpCode(op)] = true
+		}
+	}
+	return &erc7562Tracer{callstack: make([]opcodeCallFrame, 1), config: config, ignored: ignored}, nil
+}
+
+// defaultIgnoredOpcodes returns the opcodes which are pure functions of the stack and
+// memory and are thus irrelevant for the validation rules.
+func defaultIgnoredOpcodes() map[vm.OpCode]bool {
+	ignored := make(map[vm.OpCode]bool)
+	// PUSHx, DUPx and SWAPx opcodes have sequential codes
+	for op := vm.PUSH0; op <= vm.SWAP16; op++ {
+		ignored[op] = true
+	}
+	for _, op := range []vm.OpCode{
+		vm.POP, vm.ADD, vm.SUB, vm.MUL, vm.DIV, vm.SDIV, vm.MOD, vm.SMOD, vm.ADDMOD, vm.MULMOD, vm.EXP, vm.SIGNEXTEND,
+		vm.LT, vm.GT, vm.SLT, vm.SGT, vm.EQ, vm.ISZERO, vm.AND, vm.OR, vm.XOR, vm.NOT, vm.BYTE, vm.SHL, vm.SHR, vm.SAR,
+		vm.MLOAD, vm.MSTORE, vm.MSTORE8, vm.MCOPY, vm.JUMP, vm.JUMPI, vm.JUMPDEST, vm.PC, vm.MSIZE,
+		vm.CALLDATALOAD, vm.CALLDATASIZE, vm.CALLDATACOPY, vm.RETURNDATASIZE, vm.RETURNDATACOPY,
+		vm.CODESIZE, vm.CODECOPY, vm.KECCAK256, vm.RETURN, vm.REVERT, vm.STOP,
+	} {
+		ignored[op] = true
+	}
+	return ignored
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *erc7562Tracer) CaptureStart(env *vm.EVM, from libcommon.Address, to libcommon.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	t.env = env
+	typ := vm.CALL
+	if create {
+		typ = vm.CREATE
+	}
+	t.callstack[0] = newOpcodeCallFrame(typ, from, to, input, t.gasLimit, value)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *erc7562Tracer) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	t.callstack[0].processOutput(output, err)
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *erc7562Tracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+	frame := &t.callstack[len(t.callstack)-1]
+	stack := scope.Stack
+
+	// The opcode running out of gas is reported together with the error, so this is checked first.
+	if gas < cost || (op == vm.SSTORE && gas < 2300) {
+		frame.OutOfGas = true
+	}
+	if err != nil {
+		return
+	}
+
+	// GAS is only allowed when immediately followed by a call, so it is
+	// recorded once the next opcode turns out not to be one.
+	if t.lastOpGas && !isCallOpcode(op) {
+		frame.UsedOpcodes[hexutil.Uint64(vm.GAS)]++
+	}
+	t.lastOpGas = op == vm.GAS
+	if op != vm.GAS && !t.ignored[op] {
+		frame.UsedOpcodes[hexutil.Uint64(op)]++
+	}
+
+	switch op {
+	case vm.SLOAD:
+		if stack.Len() < 1 {
+			return
+		}
+		slot := libcommon.Hash(stack.Peek().Bytes32())
+		key := slot.Hex()
+		if _, written := frame.AccessedSlots.Writes[key]; written {
+			return
+		}
+		if _, read := frame.AccessedSlots.Reads[key]; read {
+			return
+		}
+		var value uint256.Int
+		t.env.IntraBlockState().GetState(scope.Contract.Address(), &slot, &value)
+		frame.AccessedSlots.Reads[key] = append(frame.AccessedSlots.Reads[key], libcommon.Hash(value.Bytes32()).Hex())
+	case vm.SSTORE:
+		if stack.Len() < 1 {
+			return
+		}
+		frame.AccessedSlots.Writes[libcommon.Hash(stack.Peek().Bytes32()).Hex()]++
+	case vm.TLOAD:
+		if stack.Len() < 1 {
+			return
+		}
+		frame.AccessedSlots.TransientReads[libcommon.Hash(stack.Peek().Bytes32()).Hex()]++
+	case vm.TSTORE:
+		if stack.Len() < 1 {
+			return
+		}
+		frame.AccessedSlots.TransientWrites[libcommon.Hash(stack.Peek().Bytes32()).Hex()]++
+	case vm.EXTCODESIZE, vm.EXTCODEHASH, vm.EXTCODECOPY:
+		if stack.Len() < 1 {
+			return
+		}
+		addr := libcommon.Address(stack.Peek().Bytes20())
+		frame.ExtCodeAccessInfo = append(frame.ExtCodeAccessInfo, addr)
+		t.recordContractSize(frame, addr, op)
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		if stack.Len() < 2 {
+			return
+		}
+		t.recordContractSize(frame, libcommon.Address(stack.Back(1).Bytes20()), op)
+	case vm.KECCAK256:
+		if stack.Len() < 2 {
+			return
+		}
+		offset, size := stack.Peek(), stack.Back(1)
+		if !offset.IsUint64() || !size.IsUint64() {
+			return
+		}
+		frame.KeccakPreimages = append(frame.KeccakPreimages, memoryCopy(scope.Memory, offset.Uint64(), size.Uint64()))
+	case vm.LOG0, vm.LOG1, vm.LOG2, vm.LOG3, vm.LOG4:
+		if !t.config.WithLog {
+			return
+		}
+		size := int(op - vm.LOG0)
+		if stack.Len() < 2+size {
+			return
+		}
+		mStart, mSize := stack.Peek(), stack.Back(1)
+		if !mStart.IsUint64() || !mSize.IsUint64() {
+			return
+		}
+		topics := make([]libcommon.Hash, size)
+		for i := 0; i < size; i++ {
+			topics[i] = libcommon.Hash(stack.Back(2 + i).Bytes32())
+		}
+		data := memoryCopy(scope.Memory, mStart.Uint64(), mSize.Uint64())
+		frame.Logs = append(frame.Logs, callLog{Address: scope.Contract.Address(), Topics: topics, Data: data})
+	}
+}
+
+// memoryCopy returns a copy of size bytes of memory at offset. The tracer is called before the memory of
+// the opcode is expanded, so the bytes beyond the current memory are zeroes. The size is paid by the gas of the opcode.
+func memoryCopy(mem *vm.Memory, offset, size uint64) []byte {
+	cpy := make([]byte, size)
+	if offset < uint64(mem.Len()) {
+		copy(cpy, mem.Data()[offset:])
+	}
+	return cpy
+}
+
+// recordContractSize remembers the code size of the first access to addr within the frame.
+// Precompiles are skipped as they don't have code.
+func (t *erc7562Tracer) recordContractSize(frame *opcodeCallFrame, addr libcommon.Address, op vm.OpCode) {
+	if _, ok := frame.ContractSize[addr]; ok {
+		return
+	}
+	for _, p := range vm.ActivePrecompiles(t.env.ChainRules()) {
+		if p == addr {
+			return
+		}
+	}
+	frame.ContractSize[addr] = &contractSizeWithOpcode{
+		ContractSize: t.env.IntraBlockState().GetCodeSize(addr),
+		Opcode:       op,
+	}
+}
+
+func isCallOpcode(op vm.OpCode) bool {
+	return op == vm.CALL || op == vm.CALLCODE || op == vm.DELEGATECALL || op == vm.STATICCALL
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *erc7562Tracer) CaptureEnter(typ vm.OpCode, from libcommon.Address, to libcommon.Address, precompile, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	// Skip if tracing was interrupted, the matching exit must not pop a frame
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.skipped++
+		return
+	}
+	t.callstack = append(t.callstack, newOpcodeCallFrame(typ, from, to, input, gas, value))
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *erc7562Tracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	// Scopes entered after the interruption have no frame, the ones entered before are still popped
+	// to keep the callstack balanced, so that the partial trace can be returned
+	if t.skipped > 0 {
+		t.skipped--
+		return
+	}
+	size := len(t.callstack)
+	if size <= 1 {
+		return
+	}
+	// pop call
+	call := t.callstack[size-1]
+	t.callstack = t.callstack[:size-1]
+	size -= 1
+
+	call.GasUsed = gasUsed
+	call.processOutput(output, err)
+	t.callstack[size-1].Calls = append(t.callstack[size-1].Calls, call)
+}
+
+func (t *erc7562Tracer) CaptureTxStart(gasLimit uint64) {
+	t.gasLimit = gasLimit
+}
+
+func (t *erc7562Tracer) CaptureTxEnd(restGas uint64) {
+	t.callstack[0].GasUsed = t.gasLimit - restGas
+}
+
+// GetResult returns the json-encoded nested list of call frames, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *erc7562Tracer) GetResult() (json.RawMessage, error) {
+	if len(t.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+	res, err := json.Marshal(t.callstack[0])
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *erc7562Tracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
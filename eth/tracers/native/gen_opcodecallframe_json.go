@@ -0,0 +1,144 @@
+// Code generated by github.com/fjl/gencodec. DO NOT EDIT.
+
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon/core/vm"
+)
+
+var _ = (*opcodeCallFrameMarshaling)(nil)
+
+// MarshalJSON marshals as JSON.
+func (o opcodeCallFrame) MarshalJSON() ([]byte, error) {
+	type opcodeCallFrame0 struct {
+		Type              vm.OpCode                                  `json:"-"`
+		From              common.Address                             `json:"from"`
+		Gas               hexutil.Uint64                             `json:"gas"`
+		GasUsed           hexutil.Uint64                             `json:"gasUsed"`
+		To                common.Address                             `json:"to,omitempty"`
+		Input             hexutility.Bytes                           `json:"input"`
+		Output            hexutility.Bytes                           `json:"output,omitempty"`
+		Error             string                                     `json:"error,omitempty"`
+		Revertal          string                                     `json:"revertReason,omitempty"`
+		Logs              []callLog                                  `json:"logs,omitempty"`
+		Value             *hexutil.Big                               `json:"value,omitempty"`
+		AccessedSlots     accessedSlots                              `json:"accessedSlots"`
+		ExtCodeAccessInfo []common.Address                           `json:"extCodeAccessInfo"`
+		UsedOpcodes       map[hexutil.Uint64]uint64                  `json:"usedOpcodes"`
+		ContractSize      map[common.Address]*contractSizeWithOpcode `json:"contractSize"`
+		OutOfGas          bool                                       `json:"outOfGas"`
+		KeccakPreimages   []hexutility.Bytes                         `json:"keccak,omitempty"`
+		Calls             []opcodeCallFrame                          `json:"calls,omitempty"`
+		TypeString        string                                     `json:"type"`
+	}
+	var enc opcodeCallFrame0
+	enc.Type = o.Type
+	enc.From = o.From
+	enc.Gas = hexutil.Uint64(o.Gas)
+	enc.GasUsed = hexutil.Uint64(o.GasUsed)
+	enc.To = o.To
+	enc.Input = o.Input
+	enc.Output = o.Output
+	enc.Error = o.Error
+	enc.Revertal = o.Revertal
+	enc.Logs = o.Logs
+	enc.Value = (*hexutil.Big)(o.Value)
+	enc.AccessedSlots = o.AccessedSlots
+	enc.ExtCodeAccessInfo = o.ExtCodeAccessInfo
+	enc.UsedOpcodes = o.UsedOpcodes
+	enc.ContractSize = o.ContractSize
+	enc.OutOfGas = o.OutOfGas
+	enc.KeccakPreimages = o.KeccakPreimages
+	enc.Calls = o.Calls
+	enc.TypeString = o.TypeString()
+	return json.Marshal(&enc)
+}
+
+// UnmarshalJSON unmarshals from JSON.
+func (o *opcodeCallFrame) UnmarshalJSON(input []byte) error {
+	type opcodeCallFrame0 struct {
+		Type              *vm.OpCode                                 `json:"-"`
+		From              *common.Address                            `json:"from"`
+		Gas               *hexutil.Uint64                            `json:"gas"`
+		GasUsed           *hexutil.Uint64                            `json:"gasUsed"`
+		To                *common.Address                            `json:"to,omitempty"`
+		Input             *hexutility.Bytes                          `json:"input"`
+		Output            *hexutility.Bytes                          `json:"output,omitempty"`
+		Error             *string                                    `json:"error,omitempty"`
+		Revertal          *string                                    `json:"revertReason,omitempty"`
+		Logs              []callLog                                  `json:"logs,omitempty"`
+		Value             *hexutil.Big                               `json:"value,omitempty"`
+		AccessedSlots     *accessedSlots                             `json:"accessedSlots"`
+		ExtCodeAccessInfo []common.Address                           `json:"extCodeAccessInfo"`
+		UsedOpcodes       map[hexutil.Uint64]uint64                  `json:"usedOpcodes"`
+		ContractSize      map[common.Address]*contractSizeWithOpcode `json:"contractSize"`
+		OutOfGas          *bool                                      `json:"outOfGas"`
+		KeccakPreimages   []hexutility.Bytes                         `json:"keccak,omitempty"`
+		Calls             []opcodeCallFrame                          `json:"calls,omitempty"`
+	}
+	var dec opcodeCallFrame0
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	if dec.Type != nil {
+		o.Type = *dec.Type
+	}
+	if dec.From != nil {
+		o.From = *dec.From
+	}
+	if dec.Gas != nil {
+		o.Gas = uint64(*dec.Gas)
+	}
+	if dec.GasUsed != nil {
+		o.GasUsed = uint64(*dec.GasUsed)
+	}
+	if dec.To != nil {
+		o.To = *dec.To
+	}
+	if dec.Input != nil {
+		o.Input = *dec.Input
+	}
+	if dec.Output != nil {
+		o.Output = *dec.Output
+	}
+	if dec.Error != nil {
+		o.Error = *dec.Error
+	}
+	if dec.Revertal != nil {
+		o.Revertal = *dec.Revertal
+	}
+	if dec.Logs != nil {
+		o.Logs = dec.Logs
+	}
+	if dec.Value != nil {
+		o.Value = (*big.Int)(dec.Value)
+	}
+	if dec.AccessedSlots != nil {
+		o.AccessedSlots = *dec.AccessedSlots
+	}
+	if dec.ExtCodeAccessInfo != nil {
+		o.ExtCodeAccessInfo = dec.ExtCodeAccessInfo
+	}
+	if dec.UsedOpcodes != nil {
+		o.UsedOpcodes = dec.UsedOpcodes
+	}
+	if dec.ContractSize != nil {
+		o.ContractSize = dec.ContractSize
+	}
+	if dec.OutOfGas != nil {
+		o.OutOfGas = *dec.OutOfGas
+	}
+	if dec.KeccakPreimages != nil {
+		o.KeccakPreimages = dec.KeccakPreimages
+	}
+	if dec.Calls != nil {
+		o.Calls = dec.Calls
+	}
+	return nil
+}
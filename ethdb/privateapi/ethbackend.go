@@ -153,6 +153,8 @@ func (s *EthBackendServer) Subscribe(r *remote.SubscribeRequest, subscribeServer
 	defer clean()
 	newSnCh, newSnClean := s.events.AddNewSnapshotSubscription()
 	defer newSnClean()
+	chainStateCh, chainStateClean := s.events.AddChainStateSubscription()
+	defer chainStateClean()
 	s.logger.Info("new subscription to newHeaders established")
 	defer func() {
 		if err != nil {
@@ -183,6 +185,10 @@ func (s *EthBackendServer) Subscribe(r *remote.SubscribeRequest, subscribeServer
 			if err = subscribeServer.Send(&remote.SubscribeReply{Type: remote.Event_NEW_SNAPSHOT}); err != nil {
 				return err
 			}
+		case <-chainStateCh:
+			if err = subscribeServer.Send(&remote.SubscribeReply{Type: remote.Event_CHAIN_STATE}); err != nil {
+				return err
+			}
 		}
 	}
 }
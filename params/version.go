@@ -39,6 +39,12 @@ const (
 	VersionKeyFinished = "ErigonVersionFinished"
 )
 
+// Client identification, see https://github.com/ethereum/execution-apis/blob/main/src/engine/identification.md
+const (
+	ClientName = "erigon"
+	ClientCode = "EG"
+)
+
 // Version holds the textual version string.
 var Version = func() string {
 	return fmt.Sprintf("%d.%02d.%d", VersionMajor, VersionMinor, VersionMicro)
@@ -61,6 +67,14 @@ func VersionWithCommit(gitCommit string) string {
 	return vsn
 }
 
+// ShortCommit - first 4 bytes of the commit hash as 0x-prefixed hex, zeroes if the commit isn't known
+func ShortCommit(gitCommit string) string {
+	if len(gitCommit) < 8 {
+		return "0x00000000"
+	}
+	return "0x" + gitCommit[:8]
+}
+
 func SetErigonVersion(tx kv.RwTx, versionKey string) error {
 	versionKeyByte := []byte(versionKey)
 	hasVersion, err := tx.Has(kv.DatabaseInfo, versionKeyByte)
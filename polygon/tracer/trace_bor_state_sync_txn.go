@@ -2,6 +2,7 @@ package tracer
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/holiman/uint256"
@@ -45,7 +46,12 @@ func TraceBorStateSyncTxnDebugAPI(
 	}
 
 	txCtx := initStateSyncTxContext(blockNum, blockHash)
-	tracer, streaming, cancel, err := transactions.AssembleTracer(ctx, traceConfig, txCtx.TxHash, stream, callTimeout)
+	tracer, streaming, cancel, err := transactions.AssembleTracer(ctx, traceConfig, &tracers.Context{
+		BlockHash:   blockHash,
+		BlockNumber: new(big.Int).SetUint64(blockNum),
+		TxIndex:     ibs.TxIndex(),
+		TxHash:      txCtx.TxHash,
+	}, stream, callTimeout)
 	if err != nil {
 		stream.WriteNil()
 		return err
@@ -78,6 +78,8 @@ type Client struct {
 	isHTTP          bool
 	services        *serviceRegistry
 	methodAllowList AllowList
+	rateLimiter     RateLimiter
+	connCtx         context.Context // parent context of the handlers, carries the rate limit client key
 
 	idCounter uint32
 
@@ -112,8 +114,8 @@ type clientConn struct {
 }
 
 func (c *Client) newClientConn(conn ServerCodec) *clientConn {
-	ctx := context.WithValue(context.Background(), clientContextKey{}, c)
-	handler := newHandler(ctx, conn, c.idgen, c.services, c.methodAllowList, 50, false /* traceRequests */, c.logger, 0)
+	ctx := context.WithValue(c.connCtx, clientContextKey{}, c)
+	handler := newHandler(ctx, conn, c.idgen, c.services, c.methodAllowList, c.rateLimiter, 50, false /* traceRequests */, c.logger, 0)
 	return &clientConn{conn, handler}
 }
 
@@ -198,17 +200,19 @@ func newClient(initctx context.Context, connect reconnectFunc, logger log.Logger
 	if err != nil {
 		return nil, err
 	}
-	c := initClient(conn, randomIDGenerator(), &serviceRegistry{logger: logger}, logger)
+	c := initClient(context.Background(), conn, randomIDGenerator(), &serviceRegistry{logger: logger}, nil, logger)
 	c.reconnectFunc = connect
 	return c, nil
 }
 
-func initClient(conn ServerCodec, idgen func() ID, services *serviceRegistry, logger log.Logger) *Client {
+func initClient(connCtx context.Context, conn ServerCodec, idgen func() ID, services *serviceRegistry, rateLimiter RateLimiter, logger log.Logger) *Client {
 	_, isHTTP := conn.(*httpConn)
 	c := &Client{
 		idgen:       idgen,
 		isHTTP:      isHTTP,
 		services:    services,
+		rateLimiter: rateLimiter,
+		connCtx:     connCtx,
 		writeConn:   conn,
 		close:       make(chan struct{}),
 		closing:     make(chan struct{}),
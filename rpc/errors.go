@@ -26,6 +26,7 @@ var (
 	_ Error = new(invalidMessageError)
 	_ Error = new(InvalidParamsError)
 	_ Error = new(CustomError)
+	_ Error = new(RateLimitedError)
 )
 
 const defaultErrorCode = -32000
@@ -81,6 +82,15 @@ func (e *UnsupportedForkError) ErrorCode() int { return -38005 }
 
 func (e *UnsupportedForkError) Error() string { return e.Message }
 
+// the client has run out of its quota, see RateLimiter
+type RateLimitedError struct{ Method string }
+
+func (e *RateLimitedError) ErrorCode() int { return -32005 }
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("rate limit exceeded for method %s", e.Method)
+}
+
 type CustomError struct {
 	Code    int
 	Message string
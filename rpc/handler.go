@@ -67,6 +67,7 @@ type handler struct {
 
 	allowList     AllowList // a list of explicitly allowed methods, if empty -- everything is allowed
 	forbiddenList ForbiddenList
+	rateLimiter   RateLimiter // charges clients for every call, if nil -- nothing is limited
 
 	subLock             sync.Mutex
 	serverSubs          map[ID]*Subscription
@@ -114,7 +115,7 @@ func HandleError(err error, stream *jsoniter.Stream) {
 	}
 }
 
-func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *serviceRegistry, allowList AllowList, maxBatchConcurrency uint, traceRequests bool, logger log.Logger, rpcSlowLogThreshold time.Duration) *handler {
+func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *serviceRegistry, allowList AllowList, rateLimiter RateLimiter, maxBatchConcurrency uint, traceRequests bool, logger log.Logger, rpcSlowLogThreshold time.Duration) *handler {
 	rootCtx, cancelRoot := context.WithCancel(connCtx)
 	forbiddenList := newForbiddenList()
 
@@ -131,6 +132,7 @@ func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *
 		logger:         logger,
 		allowList:      allowList,
 		forbiddenList:  forbiddenList,
+		rateLimiter:    rateLimiter,
 
 		maxBatchConcurrency: maxBatchConcurrency,
 		traceRequests:       traceRequests,
@@ -460,6 +462,14 @@ func (h *handler) isMethodAllowedByGranularControl(method string) bool {
 	return ok
 }
 
+// waitRateLimit charges the client which sent the call, see RateLimiter
+func (h *handler) waitRateLimit(ctx context.Context, method string) error {
+	if h.rateLimiter == nil {
+		return nil
+	}
+	return h.rateLimiter.Wait(ctx, rateLimitClientFromContext(ctx), method)
+}
+
 // handleCall processes method calls.
 func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage, stream *jsoniter.Stream) *jsonrpcMessage {
 	if msg.isSubscribe() {
@@ -474,6 +484,11 @@ func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage, stream *jsoniter
 	if callb == nil {
 		return msg.errorResponse(&methodNotFoundError{method: msg.Method})
 	}
+	if callb != h.unsubscribeCb {
+		if err := h.waitRateLimit(cp.ctx, msg.Method); err != nil {
+			return msg.errorResponse(err)
+		}
+	}
 	args, err := parsePositionalArguments(msg.Params, callb.argTypes)
 	if err != nil {
 		return msg.errorResponse(&InvalidParamsError{err.Error()})
@@ -509,6 +524,9 @@ func (h *handler) handleSubscribe(cp *callProc, msg *jsonrpcMessage, stream *jso
 	if callb == nil {
 		return msg.errorResponse(&subscriptionNotFoundError{namespace, name})
 	}
+	if err := h.waitRateLimit(cp.ctx, msg.Method); err != nil {
+		return msg.errorResponse(err)
+	}
 
 	// Parse subscription name arg too, but remove it before calling the callback.
 	argTypes := append([]reflect.Type{stringType}, callb.argTypes...)
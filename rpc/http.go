@@ -257,6 +257,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if origin := r.Header.Get("Origin"); origin != "" {
 		ctx = context.WithValue(ctx, "Origin", origin)
 	}
+	if s.rateLimiter != nil {
+		ctx = contextWithRateLimitClient(ctx, s.rateLimiter.ClientKey(r))
+	}
 	if s.debugSingleRequest {
 		if v := r.Header.Get(dbg.HTTPHeader); v == "true" {
 			ctx = dbg.ContextWithDebug(ctx, true)
@@ -313,6 +316,15 @@ func CheckJwtSecret(w http.ResponseWriter, r *http.Request, jwtSecret []byte) bo
 		return false
 	}
 
+	if _, err := verifyJwt(tokenStr, jwtSecret); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+// verifyJwt checks the signature and the issued-at time of the token, and returns its claims
+func verifyJwt(tokenStr string, jwtSecret []byte) (*jwt.RegisteredClaims, error) {
 	keyFunc := func(token *jwt.Token) (interface{}, error) {
 		return jwtSecret, nil
 	}
@@ -326,20 +338,17 @@ func CheckJwtSecret(w http.ResponseWriter, r *http.Request, jwtSecret []byte) bo
 
 	switch {
 	case err != nil:
-		http.Error(w, err.Error(), http.StatusForbidden)
+		return nil, err
 	case !token.Valid:
-		http.Error(w, "invalid token", http.StatusForbidden)
+		return nil, errors.New("invalid token")
 	case !claims.VerifyExpiresAt(time.Now(), false): // optional
-		http.Error(w, "token is expired", http.StatusForbidden)
+		return nil, errors.New("token is expired")
 	case claims.IssuedAt == nil:
-		http.Error(w, "missing issued-at", http.StatusForbidden)
+		return nil, errors.New("missing issued-at")
 	case time.Since(claims.IssuedAt.Time) > jwtTokenExpiry:
-		http.Error(w, "stale token", http.StatusForbidden)
+		return nil, errors.New("stale token")
 	case time.Until(claims.IssuedAt.Time) > jwtTokenExpiry:
-		http.Error(w, "future token", http.StatusForbidden)
-	default:
-		return true
+		return nil, errors.New("future token")
 	}
-
-	return false
+	return &claims, nil
 }
@@ -0,0 +1,236 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/ledgerwatch/erigon-lib/metrics"
+)
+
+// RateLimiter decides how much of its quota a client spends on every method call.
+type RateLimiter interface {
+	// ClientKey identifies the client behind an http request (or a websocket upgrade request).
+	ClientKey(r *http.Request) string
+	// Wait blocks until client can afford a call of method, or returns an error if the call is rejected.
+	Wait(ctx context.Context, client, method string) error
+}
+
+const (
+	RateLimitKeyIP     = "ip"      // client is identified by its remote ip address
+	RateLimitKeyJWT    = "jwt"     // client is identified by the "sub" claim of the bearer token signed with JwtSecret
+	RateLimitKeyHeader = "header:" // client is identified by the value of the given header, e.g. "header:X-Api-Key"
+)
+
+// ClientRateLimit is the quota of a single client: cost units refilled per second and the bucket size.
+type ClientRateLimit struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+// RateLimitConfig configures CostRateLimiter
+type RateLimitConfig struct {
+	ClientKey   string                     `json:"clientKey"`   // one of RateLimitKeyIP (default), RateLimitKeyJWT or RateLimitKeyHeader+name
+	Default     ClientRateLimit            `json:"default"`     // quota of every client which has no entry in Clients
+	Clients     map[string]ClientRateLimit `json:"clients"`     // per-client quotas, by client key
+	DefaultCost int                        `json:"defaultCost"` // cost of methods which have no entry in Costs
+	Costs       map[string]int             `json:"costs"`       // per-method costs, e.g. {"debug_traceBlockByNumber": 500}
+	MaxWait     string                     `json:"maxWait"`     // how long a call may be throttled before it is rejected, e.g. "2s"
+	JwtSecret   []byte                     `json:"-"`           // secret verifying bearer tokens in RateLimitKeyJWT mode
+}
+
+var (
+	rateLimitThrottledLabels sync.Map
+	rateLimitRejectedLabels  sync.Map
+)
+
+func rateLimitCounter(labels *sync.Map, name, method string) metrics.Counter {
+	if c, ok := labels.Load(method); ok {
+		return c.(metrics.Counter)
+	}
+	c := metrics.GetOrCreateCounter(fmt.Sprintf(`%s{method="%s"}`, name, method))
+	labels.Store(method, c)
+	return c
+}
+
+// rateLimitClientKey is the context key of the client key computed when the connection was accepted
+type rateLimitClientKey struct{}
+
+func contextWithRateLimitClient(ctx context.Context, client string) context.Context {
+	return context.WithValue(ctx, rateLimitClientKey{}, client)
+}
+
+func rateLimitClientFromContext(ctx context.Context) string {
+	client, _ := ctx.Value(rateLimitClientKey{}).(string)
+	return client
+}
+
+// how often idle clients are dropped from CostRateLimiter
+const rateLimitPruneInterval = time.Minute
+
+// CostRateLimiter is a token bucket per client, where every method call takes as many tokens as the method costs.
+// A call which can't be afforded right away is delayed for up to MaxWait and rejected after that.
+type CostRateLimiter struct {
+	cfg     RateLimitConfig
+	maxWait time.Duration
+
+	lock      sync.Mutex
+	limiters  map[string]*rate.Limiter
+	lastPrune time.Time
+}
+
+func NewCostRateLimiter(cfg RateLimitConfig) (*CostRateLimiter, error) {
+	if cfg.ClientKey == "" {
+		cfg.ClientKey = RateLimitKeyIP
+	}
+	if cfg.ClientKey != RateLimitKeyIP && cfg.ClientKey != RateLimitKeyJWT &&
+		(!strings.HasPrefix(cfg.ClientKey, RateLimitKeyHeader) || len(cfg.ClientKey) == len(RateLimitKeyHeader)) {
+		return nil, fmt.Errorf("unknown rate limit client key %q, expected %q, %q or %q", cfg.ClientKey, RateLimitKeyIP, RateLimitKeyJWT, RateLimitKeyHeader+"<name>")
+	}
+	if cfg.ClientKey == RateLimitKeyJWT && len(cfg.JwtSecret) == 0 {
+		return nil, fmt.Errorf("rate limit client key %q requires a jwt secret", RateLimitKeyJWT)
+	}
+	if cfg.DefaultCost <= 0 {
+		cfg.DefaultCost = 1
+	}
+	if err := validateClientRateLimit(cfg.Default); err != nil {
+		return nil, fmt.Errorf("default: %w", err)
+	}
+	for client, limit := range cfg.Clients {
+		if err := validateClientRateLimit(limit); err != nil {
+			return nil, fmt.Errorf("client %s: %w", client, err)
+		}
+	}
+	for method, cost := range cfg.Costs {
+		if cost < 0 {
+			return nil, fmt.Errorf("negative cost %d of method %s", cost, method)
+		}
+	}
+	var maxWait time.Duration
+	if cfg.MaxWait != "" {
+		var err error
+		if maxWait, err = time.ParseDuration(cfg.MaxWait); err != nil {
+			return nil, fmt.Errorf("maxWait: %w", err)
+		}
+	}
+	return &CostRateLimiter{cfg: cfg, maxWait: maxWait, limiters: map[string]*rate.Limiter{}, lastPrune: time.Now()}, nil
+}
+
+func validateClientRateLimit(limit ClientRateLimit) error {
+	if limit.Rate <= 0 || limit.Burst <= 0 {
+		return fmt.Errorf("rate and burst must be positive, got rate=%v burst=%d", limit.Rate, limit.Burst)
+	}
+	return nil
+}
+
+func (l *CostRateLimiter) ClientKey(r *http.Request) string {
+	switch {
+	case l.cfg.ClientKey == RateLimitKeyJWT:
+		if sub := jwtSubject(r, l.cfg.JwtSecret); l.knownClient(sub) {
+			return sub
+		}
+	case strings.HasPrefix(l.cfg.ClientKey, RateLimitKeyHeader):
+		if v := r.Header.Get(l.cfg.ClientKey[len(RateLimitKeyHeader):]); l.knownClient(v) {
+			return v
+		}
+	}
+	// clients without a valid token or a known api key share the quota of their ip address,
+	// otherwise a client could get a fresh bucket by sending a new key with every request
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func (l *CostRateLimiter) knownClient(client string) bool {
+	if client == "" {
+		return false
+	}
+	_, ok := l.cfg.Clients[client]
+	return ok
+}
+
+// jwtSubject returns the "sub" claim of the bearer token, or "" if the token is missing or not valid
+// (see CheckJwtSecret for the checks).
+func jwtSubject(r *http.Request, jwtSecret []byte) string {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return ""
+	}
+	claims, err := verifyJwt(strings.TrimPrefix(auth, "Bearer "), jwtSecret)
+	if err != nil {
+		return ""
+	}
+	return claims.Subject
+}
+
+// Cost returns how many tokens a call of method takes
+func (l *CostRateLimiter) Cost(method string) int {
+	if cost, ok := l.cfg.Costs[method]; ok {
+		return cost
+	}
+	return l.cfg.DefaultCost
+}
+
+func (l *CostRateLimiter) limiter(client string, now time.Time) *rate.Limiter {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if now.Sub(l.lastPrune) > rateLimitPruneInterval {
+		// a client whose bucket is full again is indistinguishable from a new one
+		for key, lim := range l.limiters {
+			if lim.TokensAt(now) >= float64(lim.Burst()) {
+				delete(l.limiters, key)
+			}
+		}
+		l.lastPrune = now
+	}
+	lim, ok := l.limiters[client]
+	if !ok {
+		limit, ok := l.cfg.Clients[client]
+		if !ok {
+			limit = l.cfg.Default
+		}
+		lim = rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)
+		l.limiters[client] = lim
+	}
+	return lim
+}
+
+func (l *CostRateLimiter) Wait(ctx context.Context, client, method string) error {
+	cost := l.Cost(method)
+	if cost == 0 {
+		return nil
+	}
+	now := time.Now()
+	reservation := l.limiter(client, now).ReserveN(now, cost)
+	if !reservation.OK() { // the method costs more than the whole bucket
+		rateLimitCounter(&rateLimitRejectedLabels, "rpc_rate_limit_rejected", method).Inc()
+		return &RateLimitedError{Method: method}
+	}
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return nil
+	}
+	if delay > l.maxWait {
+		reservation.CancelAt(now)
+		rateLimitCounter(&rateLimitRejectedLabels, "rpc_rate_limit_rejected", method).Inc()
+		return &RateLimitedError{Method: method}
+	}
+	rateLimitCounter(&rateLimitThrottledLabels, "rpc_rate_limit_throttled", method).Inc()
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		reservation.Cancel()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,131 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/ledgerwatch/log/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+	logger := log.New()
+	server := newTestServer(logger)
+	defer server.Stop()
+	limiter, err := NewCostRateLimiter(RateLimitConfig{
+		ClientKey: RateLimitKeyHeader + "X-Api-Key",
+		Default:   ClientRateLimit{Rate: 0.001, Burst: 10},
+		Clients:   map[string]ClientRateLimit{"vip": {Rate: 0.001, Burst: 100}},
+		Costs:     map[string]int{"test_echo": 4, "test_returnError": 0},
+	})
+	require.NoError(t, err)
+	server.SetRateLimiter(limiter)
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	dial := func(apiKey string) *Client {
+		c, err := DialHTTP(ts.URL, logger)
+		require.NoError(t, err)
+		c.SetHeader("X-Api-Key", apiKey)
+		return c
+	}
+	echo := func(c *Client) error {
+		var result echoResult
+		return c.Call(&result, "test_echo", "hello", 10, &echoArgs{"world"})
+	}
+	requireRateLimited := func(err error) {
+		var rpcErr Error
+		require.True(t, errors.As(err, &rpcErr), err)
+		require.Equal(t, -32005, rpcErr.ErrorCode())
+	}
+
+	alice := dial("alice")
+	defer alice.Close()
+	require.NoError(t, echo(alice))
+	require.NoError(t, echo(alice))
+	// 8 of 10 tokens are spent, so another echo is rejected but a cheaper call still fits
+	requireRateLimited(echo(alice))
+	require.NoError(t, alice.Call(nil, "test_noArgsRets"))
+	// free methods are never limited
+	for i := 0; i < 3; i++ {
+		require.EqualError(t, alice.Call(nil, "test_returnError"), testError{}.Error())
+	}
+
+	// unknown api keys share the quota of their ip address
+	bob := dial("bob")
+	defer bob.Close()
+	requireRateLimited(echo(bob))
+
+	vip := dial("vip")
+	defer vip.Close()
+	for i := 0; i < 25; i++ {
+		require.NoError(t, echo(vip))
+	}
+	requireRateLimited(echo(vip))
+}
+
+func TestRateLimitThrottle(t *testing.T) {
+	limiter, err := NewCostRateLimiter(RateLimitConfig{
+		Default: ClientRateLimit{Rate: 100, Burst: 5},
+		Costs:   map[string]int{"debug_traceBlockByNumber": 5, "debug_traceTransaction": 50},
+		MaxWait: "1s",
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, limiter.Wait(ctx, "client", "debug_traceBlockByNumber"))
+	// the bucket is empty, so the next call is delayed until 5 tokens are refilled
+	start := time.Now()
+	require.NoError(t, limiter.Wait(ctx, "client", "debug_traceBlockByNumber"))
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	// costs more than the whole bucket
+	require.ErrorAs(t, limiter.Wait(ctx, "client", "debug_traceTransaction"), new(*RateLimitedError))
+
+	// cancelled while throttled
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, limiter.Wait(cancelled, "client", "debug_traceBlockByNumber"), context.Canceled)
+}
+
+func TestRateLimitConfig(t *testing.T) {
+	_, err := NewCostRateLimiter(RateLimitConfig{ClientKey: "cookie", Default: ClientRateLimit{Rate: 1, Burst: 1}})
+	require.Error(t, err)
+	_, err = NewCostRateLimiter(RateLimitConfig{})
+	require.Error(t, err)
+	_, err = NewCostRateLimiter(RateLimitConfig{Default: ClientRateLimit{Rate: 1, Burst: 1}, MaxWait: "soon"})
+	require.Error(t, err)
+	_, err = NewCostRateLimiter(RateLimitConfig{ClientKey: RateLimitKeyJWT, Default: ClientRateLimit{Rate: 1, Burst: 1}})
+	require.Error(t, err)
+}
+
+func TestRateLimitJwtClientKey(t *testing.T) {
+	secret := make([]byte, 32)
+	limiter, err := NewCostRateLimiter(RateLimitConfig{
+		ClientKey: RateLimitKeyJWT,
+		Default:   ClientRateLimit{Rate: 1, Burst: 1},
+		Clients:   map[string]ClientRateLimit{"vip": {Rate: 1, Burst: 1}},
+		JwtSecret: secret,
+	})
+	require.NoError(t, err)
+
+	clientKey := func(sub string, secret []byte) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+			Subject:  sub,
+			IssuedAt: jwt.NewNumericDate(time.Now()),
+		}).SignedString(secret)
+		require.NoError(t, err)
+		r := httptest.NewRequest("POST", "/", nil)
+		r.RemoteAddr = "10.0.0.1:30303"
+		r.Header.Set("Authorization", "Bearer "+token)
+		return limiter.ClientKey(r)
+	}
+	require.Equal(t, "vip", clientKey("vip", secret))
+	// forged tokens and unknown subjects share the quota of their ip address
+	require.Equal(t, "10.0.0.1", clientKey("vip", []byte("forged")))
+	require.Equal(t, "10.0.0.1", clientKey("alice", secret))
+}
@@ -47,6 +47,7 @@ const (
 type Server struct {
 	services        serviceRegistry
 	methodAllowList AllowList
+	rateLimiter     RateLimiter
 	idgen           func() ID
 	run             int32
 	codecs          mapset.Set // mapset.Set[ServerCodec] requires go 1.20
@@ -76,6 +77,11 @@ func (s *Server) SetAllowList(allowList AllowList) {
 	s.methodAllowList = allowList
 }
 
+// SetRateLimiter sets the limiter which charges clients for the methods they call
+func (s *Server) SetRateLimiter(rateLimiter RateLimiter) {
+	s.rateLimiter = rateLimiter
+}
+
 // SetBatchLimit sets limit of number of requests in a batch
 func (s *Server) SetBatchLimit(limit int) {
 	s.batchLimit = limit
@@ -95,6 +101,11 @@ func (s *Server) RegisterName(name string, receiver interface{}) error {
 //
 // Note that codec options are no longer supported.
 func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
+	s.serveCodec(context.Background(), codec)
+}
+
+// serveCodec is ServeCodec with a connection context, which carries the rate limit client key.
+func (s *Server) serveCodec(connCtx context.Context, codec ServerCodec) {
 	defer codec.Close()
 
 	// Don't serve if server is stopped.
@@ -106,7 +117,7 @@ func (s *Server) ServeCodec(codec ServerCodec, options CodecOption) {
 	s.codecs.Add(codec)
 	defer s.codecs.Remove(codec)
 
-	c := initClient(codec, s.idgen, &s.services, s.logger)
+	c := initClient(connCtx, codec, s.idgen, &s.services, s.rateLimiter, s.logger)
 	<-codec.closed()
 	c.Close()
 }
@@ -120,7 +131,7 @@ func (s *Server) serveSingleRequest(ctx context.Context, codec ServerCodec, stre
 		return
 	}
 
-	h := newHandler(ctx, codec, s.idgen, &s.services, s.methodAllowList, s.batchConcurrency, s.traceRequests, s.logger, s.rpcSlowLogThreshold)
+	h := newHandler(ctx, codec, s.idgen, &s.services, s.methodAllowList, s.rateLimiter, s.batchConcurrency, s.traceRequests, s.logger, s.rpcSlowLogThreshold)
 	h.allowSubscribe = false
 	defer h.close(io.EOF, nil)
 
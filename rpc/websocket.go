@@ -64,7 +64,11 @@ func (s *Server) WebsocketHandler(allowedOrigins []string, jwtSecret []byte, com
 			return
 		}
 		codec := NewWebsocketCodec(conn)
-		s.ServeCodec(codec, 0)
+		connCtx := context.Background()
+		if s.rateLimiter != nil {
+			connCtx = contextWithRateLimitClient(connCtx, s.rateLimiter.ClientKey(r))
+		}
+		s.serveCodec(connCtx, codec)
 	})
 }
 
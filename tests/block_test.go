@@ -51,7 +51,7 @@ func TestBlockchain(t *testing.T) {
 
 	bt.walk(t, blockTestDir, func(t *testing.T, name string, test *BlockTest) {
 		// import pre accounts & construct test genesis block & state root
-		if err := bt.checkFailure(t, test.Run(t, checkStateRoot)); err != nil {
+		if err := bt.checkFailure(t, test.Run(t, checkStateRoot, nil)); err != nil {
 			t.Error(err)
 		}
 	})
@@ -71,7 +71,7 @@ func TestBlockchainEIP(t *testing.T) {
 	checkStateRoot := true
 
 	bt.walk(t, blockEipTestDir, func(t *testing.T, name string, test *BlockTest) {
-		if err := bt.checkFailure(t, test.Run(t, checkStateRoot)); err != nil {
+		if err := bt.checkFailure(t, test.Run(t, checkStateRoot, nil)); err != nil {
 			t.Error(err)
 		}
 	})
@@ -35,6 +35,7 @@ import (
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon-lib/kv/rawdbv3"
 	"github.com/ledgerwatch/erigon/turbo/services"
 	"github.com/ledgerwatch/erigon/turbo/stages/mock"
 
@@ -46,6 +47,8 @@ import (
 	"github.com/ledgerwatch/erigon/core/rawdb"
 	"github.com/ledgerwatch/erigon/core/state"
 	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/eth/consensuschain"
 	"github.com/ledgerwatch/erigon/eth/ethconsensusconfig"
 	"github.com/ledgerwatch/erigon/rlp"
 )
@@ -117,13 +120,20 @@ type btHeaderMarshaling struct {
 	ExcessBlobGas *math.HexOrDecimal64
 }
 
-func (bt *BlockTest) Run(t *testing.T, checkStateRoot bool) error {
+// Network returns the fork the test runs at.
+func (bt *BlockTest) Network() string {
+	return bt.json.Network
+}
+
+// Run imports the blocks of the test and validates the resulting chain and state. tb may be nil
+// outside of go test. A non-nil tracer re-executes the imported blocks with it.
+func (bt *BlockTest) Run(tb testing.TB, checkStateRoot bool, tracer vm.EVMLogger) error {
 	config, ok := Forks[bt.json.Network]
 	if !ok {
 		return UnsupportedForkError{bt.json.Network}
 	}
 	engine := ethconsensusconfig.CreateConsensusEngineBareBones(context.Background(), config, log.New())
-	m := mock.MockWithGenesisEngine(t, bt.genesis(config), engine, false, checkStateRoot)
+	m := mock.MockWithGenesisEngine(tb, bt.genesis(config), engine, false, checkStateRoot)
 	defer m.Close()
 
 	bt.br = m.BlockReader
@@ -146,6 +156,12 @@ func (bt *BlockTest) Run(t *testing.T, checkStateRoot bool) error {
 	}
 	defer tx.Rollback()
 
+	if tracer != nil {
+		if err := bt.traceBlocks(tx, validBlocks, m, tracer); err != nil {
+			return err
+		}
+	}
+
 	cmlast := rawdb.ReadHeadBlockHash(tx)
 	if libcommon.Hash(bt.json.BestBlock) != cmlast {
 		return fmt.Errorf("last block hash validation mismatch: want: %x, have: %x", bt.json.BestBlock, cmlast)
@@ -364,6 +380,42 @@ func (bt *BlockTest) validateImportedHeaders(tx kv.Tx, validBlocks []btBlock, m
 	return nil
 }
 
+// traceBlocks re-executes the imported blocks on top of the historical state before each of them,
+// the staged sync of the mock executes them without a tracer.
+func (bt *BlockTest) traceBlocks(tx kv.Tx, validBlocks []btBlock, m *mock.MockSentry, tracer vm.EVMLogger) error {
+	logger := log.New()
+	vmConfig := vm.Config{Debug: true, Tracer: tracer}
+	noTracer := func(txIndex int, txHash libcommon.Hash) (vm.EVMLogger, error) { return nil, nil }
+	getHeader := func(hash libcommon.Hash, number uint64) *types.Header {
+		h, _ := m.BlockReader.Header(m.Ctx, tx, hash, number)
+		return h
+	}
+	for _, b := range validBlocks {
+		block, err := b.decode()
+		if err != nil {
+			return err
+		}
+		if canonical, err := m.BlockReader.CanonicalHash(m.Ctx, tx, block.NumberU64()); err != nil {
+			return err
+		} else if canonical != block.Hash() {
+			continue // reorged out
+		}
+		minTxNum, err := rawdbv3.TxNums.Min(tx, block.NumberU64())
+		if err != nil {
+			return err
+		}
+		reader := state.NewHistoryReaderV3()
+		reader.SetTx(tx)
+		reader.SetTxNum(minTxNum)
+		chainReader := consensuschain.NewReader(m.ChainConfig, tx, m.BlockReader, logger)
+		getHashFn := core.GetHashFn(block.Header(), getHeader)
+		if _, err = core.ExecuteBlockEphemerally(m.ChainConfig, &vmConfig, getHashFn, m.Engine, block, reader, state.NewNoopWriter(), chainReader, noTracer, logger); err != nil {
+			return fmt.Errorf("tracing block #%d: %w", block.NumberU64(), err)
+		}
+	}
+	return nil
+}
+
 func (bb *btBlock) decode() (*types.Block, error) {
 	data, err := hexutil.Decode(bb.Rlp)
 	if err != nil {
@@ -0,0 +1,21 @@
+//go:build integration
+
+package tests
+
+import (
+	"testing"
+)
+
+func TestEOF(t *testing.T) {
+	//t.Parallel()
+
+	et := new(testMatcher)
+
+	et.walk(t, eofTestDir, func(t *testing.T, name string, test *EOFTest) {
+		for _, fork := range test.Forks() {
+			if err := et.checkFailure(t, test.Run(fork)); err != nil {
+				t.Errorf("%s: %v", fork, err)
+			}
+		}
+	})
+}
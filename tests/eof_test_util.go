@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+
+	"github.com/ledgerwatch/erigon/core/vm"
+)
+
+// EOFTest checks the validation of EOF containers, see
+// https://ethereum-tests.readthedocs.io/en/latest/test_types/eof_tests.html
+type EOFTest struct {
+	Vectors map[string]eofTestVector `json:"vectors"`
+}
+
+type eofTestVector struct {
+	Code          hexutility.Bytes         `json:"code"`
+	ContainerKind string                   `json:"containerKind"`
+	Results       map[string]eofTestResult `json:"results"`
+}
+
+type eofTestResult struct {
+	Result    bool   `json:"result"`
+	Exception string `json:"exception,omitempty"`
+}
+
+// Forks returns the forks with expected results
+func (t *EOFTest) Forks() []string {
+	seen := make(map[string]struct{})
+	for _, vector := range t.Vectors {
+		for fork := range vector.Results {
+			seen[fork] = struct{}{}
+		}
+	}
+	forks := make([]string, 0, len(seen))
+	for fork := range seen {
+		forks = append(forks, fork)
+	}
+	sort.Strings(forks)
+	return forks
+}
+
+// Run validates the code of every vector and compares the outcome with the result expected at the fork
+func (t *EOFTest) Run(fork string) error {
+	config, ok := Forks[fork]
+	if !ok {
+		return UnsupportedForkError{fork}
+	}
+	if config.OsakaTime == nil {
+		return fmt.Errorf("fork %s does not support EOF", fork)
+	}
+	names := make([]string, 0, len(t.Vectors))
+	for name := range t.Vectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		vector := t.Vectors[name]
+		expected, ok := vector.Results[fork]
+		if !ok {
+			continue
+		}
+		kind := vm.RuntimeContainer
+		if vector.ContainerKind == "INITCODE" {
+			kind = vm.InitcodeContainer
+		}
+		err := vm.ValidateEOF(vector.Code, kind)
+		if expected.Result && err != nil {
+			return fmt.Errorf("vector %s: unexpected validation error: %w", name, err)
+		}
+		if !expected.Result && err == nil {
+			return fmt.Errorf("vector %s: expected validation error %s", name, expected.Exception)
+		}
+	}
+	return nil
+}
@@ -21,7 +21,7 @@ func TestExecutionSpec(t *testing.T) {
 
 	bt.walk(t, dir, func(t *testing.T, name string, test *BlockTest) {
 		// import pre accounts & construct test genesis block & state root
-		if err := bt.checkFailure(t, test.Run(t, checkStateRoot)); err != nil {
+		if err := bt.checkFailure(t, test.Run(t, checkStateRoot, nil)); err != nil {
 			t.Error(err)
 		}
 	})
@@ -415,7 +415,8 @@ type RPCTransaction struct {
 	R                *hexutil.Big       `json:"r"`
 	S                *hexutil.Big       `json:"s"`
 
-	BlobVersionedHashes []libcommon.Hash `json:"blobVersionedHashes,omitempty"`
+	BlobVersionedHashes []libcommon.Hash      `json:"blobVersionedHashes,omitempty"`
+	AuthorizationList   []types.Authorization `json:"authorizationList,omitempty"`
 }
 
 // newRPCTransaction returns a transaction that will serialize to the RPC
@@ -481,6 +482,18 @@ func newRPCTransaction(tx types.Transaction, blockHash libcommon.Hash, blockNumb
 		result.GasPrice = computeGasPrice(tx, blockHash, baseFee)
 		result.MaxFeePerBlobGas = (*hexutil.Big)(t.MaxFeePerBlobGas.ToBig())
 		result.BlobVersionedHashes = t.GetBlobHashes()
+	case *types.SetCodeTransaction:
+		chainId.Set(t.ChainID)
+		result.ChainID = (*hexutil.Big)(chainId.ToBig())
+		result.Tip = (*hexutil.Big)(t.Tip.ToBig())
+		result.FeeCap = (*hexutil.Big)(t.FeeCap.ToBig())
+		result.YParity = (*hexutil.Big)(t.V.ToBig())
+		result.V = (*hexutil.Big)(t.V.ToBig())
+		result.R = (*hexutil.Big)(t.R.ToBig())
+		result.S = (*hexutil.Big)(t.S.ToBig())
+		result.Accesses = &t.AccessList
+		result.GasPrice = computeGasPrice(tx, blockHash, baseFee)
+		result.AuthorizationList = t.Authorizations
 	}
 	signer := types.LatestSignerForChainID(chainId.ToBig())
 	var err error
@@ -43,11 +43,13 @@ import (
 	"github.com/ledgerwatch/erigon/core/rawdb"
 	"github.com/ledgerwatch/erigon/core/rawdb/blockio"
 	coresnaptype "github.com/ledgerwatch/erigon/core/snaptype"
+	"github.com/ledgerwatch/erigon/crypto"
 	"github.com/ledgerwatch/erigon/diagnostics"
 	"github.com/ledgerwatch/erigon/eth/ethconfig"
 	"github.com/ledgerwatch/erigon/eth/ethconfig/estimate"
 	"github.com/ledgerwatch/erigon/eth/integrity"
 	"github.com/ledgerwatch/erigon/eth/stagedsync/stages"
+	"github.com/ledgerwatch/erigon/p2p"
 	"github.com/ledgerwatch/erigon/params"
 	erigoncli "github.com/ledgerwatch/erigon/turbo/cli"
 	"github.com/ledgerwatch/erigon/turbo/debug"
@@ -253,6 +255,8 @@ var snapshotCommand = cli.Command{
 			Action: doIntegrity,
 			Flags: joinFlags([]cli.Flag{
 				&utils.DataDirFlag,
+				&cli.BoolFlag{Name: "offline", Usage: "Only check that snapshot files match the manifest of the datadir, without opening the db"},
+				&cli.BoolFlag{Name: "write-manifest", Usage: "Write manifest of the snapshot files after successful checks, for later --offline checks"},
 			}),
 		},
 		//{
@@ -379,6 +383,23 @@ func doIntegrity(cliCtx *cli.Context) error {
 
 	ctx := cliCtx.Context
 	dirs := datadir.New(cliCtx.String(utils.DataDirFlag.Name))
+	manifestPath := filepath.Join(dirs.DataDir, integrity.SnapManifestFileName)
+	if cliCtx.Bool("offline") {
+		manifest, err := integrity.ReadSnapManifest(manifestPath)
+		if err != nil {
+			return fmt.Errorf("%w, use --write-manifest to create it", err)
+		}
+		nodeKey, err := crypto.LoadECDSA(p2p.NodeKeyConfig{}.DefaultPath(dirs.DataDir))
+		if err != nil {
+			return err
+		}
+		if err := integrity.VerifySnapManifest(ctx, dirs.Snap, manifest, &nodeKey.PublicKey, estimate.AlmostAllCPUs(), logger); err != nil {
+			return err
+		}
+		logger.Info("[integrity] snapshot files match manifest", "files", len(manifest.Files))
+		return nil
+	}
+
 	chainDB := dbCfg(kv.ChainDB, dirs.Chaindata).MustOpen()
 	defer chainDB.Close()
 
@@ -410,6 +431,28 @@ func doIntegrity(cliCtx *cli.Context) error {
 		return err
 	}
 
+	if cliCtx.Bool("write-manifest") {
+		aggTx := agg.BeginFilesRo()
+		defer aggTx.Close()
+		files := append(blockSnaps.OpenedFiles(), borSnaps.OpenedFiles()...)
+		files = append(files, aggTx.OpenedFiles()...)
+		manifest, err := integrity.NewSnapManifest(ctx, dirs.Snap, files, estimate.AlmostAllCPUs(), logger)
+		if err != nil {
+			return err
+		}
+		nodeKey, err := p2p.NodeKeyConfig{}.LoadOrGenerateAndSave(p2p.NodeKeyConfig{}.DefaultPath(dirs.DataDir))
+		if err != nil {
+			return err
+		}
+		if err := manifest.Sign(nodeKey); err != nil {
+			return err
+		}
+		if err := integrity.WriteSnapManifest(manifestPath, manifest); err != nil {
+			return err
+		}
+		logger.Info("[integrity] manifest written", "path", manifestPath, "files", len(manifest.Files))
+	}
+
 	return nil
 }
 
@@ -27,6 +27,10 @@ var DefaultFlags = []cli.Flag{
 	&utils.TxPoolLifetimeFlag,
 	&utils.TxPoolTraceSendersFlag,
 	&utils.TxPoolCommitEveryFlag,
+	&utils.TxPoolOrderingFlag,
+	&utils.TxPoolJournalFlag,
+	&utils.TxPoolJournalRetentionFlag,
+	&utils.TxPoolJournalLimitFlag,
 	&PruneFlag,
 	&PruneBlocksFlag,
 	&PruneHistoryFlag,
@@ -75,6 +79,7 @@ var DefaultFlags = []cli.Flag{
 	&utils.RpcStreamingDisableFlag,
 	&utils.DBReadConcurrencyFlag,
 	&utils.RpcAccessListFlag,
+	&utils.RpcRateLimitFlag,
 	&utils.RpcTraceCompatFlag,
 	&utils.RpcGasCapFlag,
 	&utils.RpcBatchLimit,
@@ -84,6 +89,8 @@ var DefaultFlags = []cli.Flag{
 	&utils.RPCGlobalTxFeeCapFlag,
 	&utils.TxpoolApiAddrFlag,
 	&utils.TraceMaxtracesFlag,
+	&utils.TraceWorkersFlag,
+	&utils.TraceFilterMaxBlocksFlag,
 	&HTTPReadTimeoutFlag,
 	&HTTPWriteTimeoutFlag,
 	&HTTPIdleTimeoutFlag,
@@ -96,6 +103,8 @@ var DefaultFlags = []cli.Flag{
 
 	&utils.SnapKeepBlocksFlag,
 	&utils.SnapStopFlag,
+	&utils.SnapPartialBlocksFlag,
+	&utils.SnapPartialTypesFlag,
 	&utils.DbPageSizeFlag,
 	&utils.DbSizeLimitFlag,
 	&utils.TorrentPortFlag,
@@ -168,6 +177,7 @@ var DefaultFlags = []cli.Flag{
 	&utils.SentinelPortFlag,
 
 	&utils.OtsSearchMaxCapFlag,
+	&utils.ExtraIndicesFlag,
 
 	&utils.SilkwormExecutionFlag,
 	&utils.SilkwormRpcDaemonFlag,
@@ -178,7 +178,7 @@ var (
 
 	UploadLocationFlag = cli.StringFlag{
 		Name:  "upload.location",
-		Usage: "Location to upload snapshot segments to",
+		Usage: "Location to upload snapshot segments to: rclone remote or local dir, or s3://bucket/prefix?endpoint=host&region=name (doesn't need rclone)",
 		Value: "",
 	}
 
@@ -521,9 +521,12 @@ func setEmbeddedRpcDaemon(ctx *cli.Context, cfg *nodecfg.Config, logger log.Logg
 		RpcStreamingDisable:               ctx.Bool(utils.RpcStreamingDisableFlag.Name),
 		DBReadConcurrency:                 ctx.Int(utils.DBReadConcurrencyFlag.Name),
 		RpcAllowListFilePath:              ctx.String(utils.RpcAccessListFlag.Name),
+		RpcRateLimitFilePath:              ctx.String(utils.RpcRateLimitFlag.Name),
 		Gascap:                            ctx.Uint64(utils.RpcGasCapFlag.Name),
 		MaxTraces:                         ctx.Uint64(utils.TraceMaxtracesFlag.Name),
 		TraceCompatibility:                ctx.Bool(utils.RpcTraceCompatFlag.Name),
+		TraceWorkers:                      ctx.Int(utils.TraceWorkersFlag.Name),
+		TraceFilterMaxBlocks:              ctx.Uint64(utils.TraceFilterMaxBlocksFlag.Name),
 		BatchLimit:                        ctx.Int(utils.RpcBatchLimit.Name),
 		ReturnDataLimit:                   ctx.Int(utils.RpcReturnDataLimit.Name),
 		AllowUnprotectedTxs:               ctx.Bool(utils.AllowUnprotectedTxs.Name),
@@ -19,6 +19,7 @@ import (
 	"github.com/ledgerwatch/erigon-lib/gointerfaces"
 	execution "github.com/ledgerwatch/erigon-lib/gointerfaces/executionproto"
 	txpool "github.com/ledgerwatch/erigon-lib/gointerfaces/txpoolproto"
+	"github.com/ledgerwatch/erigon-lib/gointerfaces/typesproto"
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/kvcache"
 	libstate "github.com/ledgerwatch/erigon-lib/state"
@@ -31,6 +32,7 @@ import (
 	"github.com/ledgerwatch/erigon/consensus"
 	"github.com/ledgerwatch/erigon/consensus/merge"
 	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/params"
 	"github.com/ledgerwatch/erigon/rpc"
 	"github.com/ledgerwatch/erigon/turbo/engineapi/engine_block_downloader"
 	"github.com/ledgerwatch/erigon/turbo/engineapi/engine_helpers"
@@ -54,6 +56,7 @@ type EngineServer struct {
 	test             bool
 	caplin           bool // we need to send errors for caplin.
 	executionService execution.ExecutionClient
+	txpool           txpool.TxpoolClient // needed for getBlobs
 
 	chainRW eth1_chain_reader.ChainReaderWriterEth1
 	lock    sync.Mutex
@@ -91,6 +94,8 @@ func (e *EngineServer) Start(
 	txPool txpool.TxpoolClient,
 	mining txpool.MiningClient,
 ) {
+	e.txpool = txPool
+
 	base := jsonrpc.NewBaseApi(filters, stateCache, blockReader, agg, httpConfig.WithDatadir, httpConfig.EvmCallTimeout, engineReader, httpConfig.Dirs)
 
 	ethImpl := jsonrpc.NewEthAPI(base, db, eth, txPool, mining, httpConfig.Gascap, httpConfig.ReturnDataLimit, httpConfig.AllowUnprotectedTxs, httpConfig.MaxGetProofRewindBlockCount, httpConfig.WebsocketSubscribeLogsChannelSize, e.logger)
@@ -183,8 +188,11 @@ func (s *EngineServer) newPayload(ctx context.Context, req *engine_types.Executi
 	}
 
 	var requests types.Requests
-	if version >= clparams.ElectraVersion && req.DepositRequests != nil {
-		requests = req.DepositRequests.ToRequests()
+	if version >= clparams.ElectraVersion && req.DepositRequests != nil && req.WithdrawalRequests != nil && req.ConsolidationRequests != nil {
+		requests = make(types.Requests, 0, len(req.DepositRequests)+len(req.WithdrawalRequests)+len(req.ConsolidationRequests))
+		requests = append(requests, req.DepositRequests.ToRequests()...)
+		requests = append(requests, req.WithdrawalRequests.ToRequests()...)
+		requests = append(requests, req.ConsolidationRequests.ToRequests()...)
 	}
 	if err := s.checkRequestsPresence(header.Time, requests); err != nil {
 		return nil, err
@@ -457,7 +465,9 @@ func (s *EngineServer) getPayload(ctx context.Context, payloadId uint64, version
 
 	ts := data.ExecutionPayload.Timestamp
 	if (!s.config.IsCancun(ts) && version >= clparams.DenebVersion) ||
-		(s.config.IsCancun(ts) && version < clparams.DenebVersion) {
+		(s.config.IsCancun(ts) && version < clparams.DenebVersion) ||
+		(!s.config.IsPrague(ts) && version >= clparams.ElectraVersion) ||
+		(s.config.IsPrague(ts) && version < clparams.ElectraVersion) {
 		return nil, &rpc.UnsupportedForkError{Message: "Unsupported fork"}
 	}
 
@@ -741,24 +751,89 @@ func (e *EngineServer) GetPayloadBodiesByRangeV1(ctx context.Context, start, cou
 	return e.getPayloadBodiesByRange(ctx, uint64(start), uint64(count), clparams.CapellaVersion)
 }
 
-var ourCapabilities = []string{
-	"engine_forkchoiceUpdatedV1",
-	"engine_forkchoiceUpdatedV2",
-	"engine_forkchoiceUpdatedV3",
-	"engine_newPayloadV1",
-	"engine_newPayloadV2",
-	"engine_newPayloadV3",
-	"engine_newPayloadV4",
-	"engine_getPayloadV1",
-	"engine_getPayloadV2",
-	"engine_getPayloadV3",
-	"engine_getPayloadV4",
-	"engine_exchangeTransitionConfigurationV1",
-	"engine_getPayloadBodiesByHashV1",
-	"engine_getPayloadBodiesByRangeV1",
+// Returns blobs and proofs of the blob transactions known to the txpool, in the order of the requested versioned hashes,
+// null for the blobs which are not found
+// See https://github.com/ethereum/execution-apis/blob/main/src/engine/cancun.md#engine_getblobsv1
+func (e *EngineServer) GetBlobsV1(ctx context.Context, blobHashes []libcommon.Hash) ([]*engine_types.BlobAndProofV1, error) {
+	if len(blobHashes) > 128 {
+		return nil, &engine_helpers.TooLargeRequestErr
+	}
+	if e.txpool == nil {
+		return nil, fmt.Errorf("txpool is not available")
+	}
+
+	req := &txpool.GetBlobsRequest{BlobHashes: make([]*typesproto.H256, len(blobHashes))}
+	for i, hash := range blobHashes {
+		req.BlobHashes[i] = gointerfaces.ConvertHashToH256(hash)
+	}
+	reply, err := e.txpool.GetBlobs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.BlobsAndProofs) != len(blobHashes) {
+		return nil, fmt.Errorf("txpool returned %d blobs for %d hashes", len(reply.BlobsAndProofs), len(blobHashes))
+	}
+	res := make([]*engine_types.BlobAndProofV1, len(blobHashes))
+	for i, bp := range reply.BlobsAndProofs {
+		if len(bp.Blob) == 0 {
+			continue
+		}
+		res[i] = &engine_types.BlobAndProofV1{Blob: bp.Blob, Proof: bp.Proof}
+	}
+	return res, nil
+}
+
+// Returns the client version of the execution layer, the version of the consensus layer is logged
+// See https://github.com/ethereum/execution-apis/blob/main/src/engine/identification.md#engine_getclientversionv1
+func (e *EngineServer) GetClientVersionV1(ctx context.Context, callerVersion *engine_types.ClientVersionV1) ([]engine_types.ClientVersionV1, error) {
+	if callerVersion != nil {
+		e.logger.Debug("[GetClientVersionV1] Received request from " + callerVersion.String())
+	}
+	return []engine_types.ClientVersionV1{{
+		Code:    params.ClientCode,
+		Name:    params.ClientName,
+		Version: params.VersionWithMeta,
+		Commit:  params.ShortCommit(params.GitCommit),
+	}}, nil
+}
+
+// capabilities - methods of the engine API which are enabled for the forks of the configured chain
+func (e *EngineServer) capabilities() []string {
+	caps := []string{
+		"engine_forkchoiceUpdatedV1",
+		"engine_newPayloadV1",
+		"engine_getPayloadV1",
+		"engine_exchangeTransitionConfigurationV1",
+		"engine_getClientVersionV1",
+	}
+	if e.config.ShanghaiTime != nil {
+		caps = append(caps,
+			"engine_forkchoiceUpdatedV2",
+			"engine_newPayloadV2",
+			"engine_getPayloadV2",
+			"engine_getPayloadBodiesByHashV1",
+			"engine_getPayloadBodiesByRangeV1",
+		)
+	}
+	if e.config.CancunTime != nil {
+		caps = append(caps,
+			"engine_forkchoiceUpdatedV3",
+			"engine_newPayloadV3",
+			"engine_getPayloadV3",
+			"engine_getBlobsV1",
+		)
+	}
+	if e.config.PragueTime != nil {
+		caps = append(caps,
+			"engine_newPayloadV4",
+			"engine_getPayloadV4",
+		)
+	}
+	return caps
 }
 
 func (e *EngineServer) ExchangeCapabilities(fromCl []string) []string {
+	ourCapabilities := e.capabilities()
 	missingOurs := compareCapabilities(fromCl, ourCapabilities)
 	missingCl := compareCapabilities(ourCapabilities, fromCl)
 
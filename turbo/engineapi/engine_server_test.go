@@ -0,0 +1,88 @@
+package engineapi
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ledgerwatch/log/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon-lib/chain"
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/gointerfaces"
+	txpool "github.com/ledgerwatch/erigon-lib/gointerfaces/txpoolproto"
+	"github.com/ledgerwatch/erigon/params"
+	"github.com/ledgerwatch/erigon/turbo/engineapi/engine_types"
+	"google.golang.org/grpc"
+)
+
+func TestExchangeCapabilities(t *testing.T) {
+	config := chain.Config{}
+	e := NewEngineServer(log.New(), &config, nil, nil, nil, false, true, false)
+	caps := e.ExchangeCapabilities([]string{"engine_newPayloadV1"})
+	require.Contains(t, caps, "engine_newPayloadV1")
+	require.Contains(t, caps, "engine_getClientVersionV1")
+	require.NotContains(t, caps, "engine_newPayloadV2")
+
+	config.ShanghaiTime, config.CancunTime = big.NewInt(0), big.NewInt(10)
+	caps = e.ExchangeCapabilities(nil)
+	require.Contains(t, caps, "engine_newPayloadV2")
+	require.Contains(t, caps, "engine_getPayloadBodiesByRangeV1")
+	require.Contains(t, caps, "engine_getBlobsV1")
+	require.NotContains(t, caps, "engine_newPayloadV4")
+
+	config.PragueTime = big.NewInt(20)
+	require.Contains(t, e.ExchangeCapabilities(nil), "engine_newPayloadV4")
+}
+
+func TestGetClientVersionV1(t *testing.T) {
+	e := NewEngineServer(log.New(), &chain.Config{}, nil, nil, nil, false, true, false)
+	versions, err := e.GetClientVersionV1(context.Background(), nil)
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	require.Equal(t, "EG", versions[0].Code)
+	require.Equal(t, params.VersionWithMeta, versions[0].Version)
+	require.Len(t, versions[0].Commit, 10)
+}
+
+// blobsTxpool serves GetBlobs from a map, like the txpool does for blobs of pooled txs
+type blobsTxpool struct {
+	txpool.TxpoolClient
+	blobs map[libcommon.Hash]*txpool.BlobAndProof
+}
+
+func (p *blobsTxpool) GetBlobs(_ context.Context, in *txpool.GetBlobsRequest, _ ...grpc.CallOption) (*txpool.GetBlobsReply, error) {
+	reply := &txpool.GetBlobsReply{BlobsAndProofs: make([]*txpool.BlobAndProof, len(in.BlobHashes))}
+	for i, h := range in.BlobHashes {
+		if bp, ok := p.blobs[gointerfaces.ConvertH256ToHash(h)]; ok {
+			reply.BlobsAndProofs[i] = bp
+		} else {
+			reply.BlobsAndProofs[i] = &txpool.BlobAndProof{}
+		}
+	}
+	return reply, nil
+}
+
+func TestGetBlobsV1(t *testing.T) {
+	ctx := context.Background()
+	e := NewEngineServer(log.New(), &chain.Config{}, nil, nil, nil, false, true, false)
+	_, err := e.GetBlobsV1(ctx, []libcommon.Hash{{1}})
+	require.Error(t, err)
+
+	present, missing := libcommon.Hash{1}, libcommon.Hash{2}
+	e.txpool = &blobsTxpool{blobs: map[libcommon.Hash]*txpool.BlobAndProof{
+		present: {Blob: []byte{1, 2, 3}, Proof: []byte{4, 5, 6}},
+	}}
+
+	res, err := e.GetBlobsV1(ctx, []libcommon.Hash{missing, present, missing})
+	require.NoError(t, err)
+	require.Equal(t, []*engine_types.BlobAndProofV1{nil, {Blob: []byte{1, 2, 3}, Proof: []byte{4, 5, 6}}, nil}, res)
+
+	res, err = e.GetBlobsV1(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	_, err = e.GetBlobsV1(ctx, make([]libcommon.Hash, 129))
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 
@@ -17,25 +18,26 @@ import (
 
 // ExecutionPayload represents an execution payload (aka block)
 type ExecutionPayload struct {
-	ParentHash         common.Hash              `json:"parentHash"    gencodec:"required"`
-	FeeRecipient       common.Address           `json:"feeRecipient"  gencodec:"required"`
-	StateRoot          common.Hash              `json:"stateRoot"     gencodec:"required"`
-	ReceiptsRoot       common.Hash              `json:"receiptsRoot"  gencodec:"required"`
-	LogsBloom          hexutility.Bytes         `json:"logsBloom"     gencodec:"required"`
-	PrevRandao         common.Hash              `json:"prevRandao"    gencodec:"required"`
-	BlockNumber        hexutil.Uint64           `json:"blockNumber"   gencodec:"required"`
-	GasLimit           hexutil.Uint64           `json:"gasLimit"      gencodec:"required"`
-	GasUsed            hexutil.Uint64           `json:"gasUsed"       gencodec:"required"`
-	Timestamp          hexutil.Uint64           `json:"timestamp"     gencodec:"required"`
-	ExtraData          hexutility.Bytes         `json:"extraData"     gencodec:"required"`
-	BaseFeePerGas      *hexutil.Big             `json:"baseFeePerGas" gencodec:"required"`
-	BlockHash          common.Hash              `json:"blockHash"     gencodec:"required"`
-	Transactions       []hexutility.Bytes       `json:"transactions"  gencodec:"required"`
-	Withdrawals        []*types.Withdrawal      `json:"withdrawals"`
-	BlobGasUsed        *hexutil.Uint64          `json:"blobGasUsed"`
-	ExcessBlobGas      *hexutil.Uint64          `json:"excessBlobGas"`
-	DepositRequests    types.Deposits           `json:"depositRequests"` // do not forget to add it into erigon-lib/gointerfaces/types if needed
-	WithdrawalRequests types.WithdrawalRequests `json:"withdrawalRequests"`
+	ParentHash            common.Hash                 `json:"parentHash"    gencodec:"required"`
+	FeeRecipient          common.Address              `json:"feeRecipient"  gencodec:"required"`
+	StateRoot             common.Hash                 `json:"stateRoot"     gencodec:"required"`
+	ReceiptsRoot          common.Hash                 `json:"receiptsRoot"  gencodec:"required"`
+	LogsBloom             hexutility.Bytes            `json:"logsBloom"     gencodec:"required"`
+	PrevRandao            common.Hash                 `json:"prevRandao"    gencodec:"required"`
+	BlockNumber           hexutil.Uint64              `json:"blockNumber"   gencodec:"required"`
+	GasLimit              hexutil.Uint64              `json:"gasLimit"      gencodec:"required"`
+	GasUsed               hexutil.Uint64              `json:"gasUsed"       gencodec:"required"`
+	Timestamp             hexutil.Uint64              `json:"timestamp"     gencodec:"required"`
+	ExtraData             hexutility.Bytes            `json:"extraData"     gencodec:"required"`
+	BaseFeePerGas         *hexutil.Big                `json:"baseFeePerGas" gencodec:"required"`
+	BlockHash             common.Hash                 `json:"blockHash"     gencodec:"required"`
+	Transactions          []hexutility.Bytes          `json:"transactions"  gencodec:"required"`
+	Withdrawals           []*types.Withdrawal         `json:"withdrawals"`
+	BlobGasUsed           *hexutil.Uint64             `json:"blobGasUsed"`
+	ExcessBlobGas         *hexutil.Uint64             `json:"excessBlobGas"`
+	DepositRequests       types.Deposits              `json:"depositRequests"` // do not forget to add it into erigon-lib/gointerfaces/types if needed
+	WithdrawalRequests    types.WithdrawalRequests    `json:"withdrawalRequests"`
+	ConsolidationRequests types.ConsolidationRequests `json:"consolidationRequests"`
 }
 
 // PayloadAttributes represent the attributes required to start assembling a payload
@@ -68,6 +70,23 @@ type BlobsBundleV1 struct {
 	Blobs       []hexutility.Bytes `json:"blobs"       gencodec:"required"`
 }
 
+type BlobAndProofV1 struct {
+	Blob  hexutility.Bytes `json:"blob"  gencodec:"required"`
+	Proof hexutility.Bytes `json:"proof" gencodec:"required"`
+}
+
+// ClientVersionV1 - identification of the execution or consensus client
+type ClientVersionV1 struct {
+	Code    string `json:"code"    gencodec:"required"`
+	Name    string `json:"name"    gencodec:"required"`
+	Version string `json:"version" gencodec:"required"`
+	Commit  string `json:"commit"  gencodec:"required"`
+}
+
+func (c ClientVersionV1) String() string {
+	return fmt.Sprintf("ClientCode: %s, %s-%s-%s", c.Code, c.Name, c.Version, c.Commit)
+}
+
 type ExecutionPayloadBodyV1 struct {
 	Transactions []hexutility.Bytes  `json:"transactions" gencodec:"required"`
 	Withdrawals  []*types.Withdrawal `json:"withdrawals"  gencodec:"required"`
@@ -189,6 +208,11 @@ func ConvertPayloadFromRpc(payload *types2.ExecutionPayload) *ExecutionPayload {
 		excessBlobGas := *payload.ExcessBlobGas
 		res.ExcessBlobGas = (*hexutil.Uint64)(&excessBlobGas)
 	}
+	if payload.Version >= 4 {
+		res.DepositRequests = ConvertDepositRequestsFromRpc(payload.DepositRequests)
+		res.WithdrawalRequests = ConvertWithdrawalRequestsFromRpc(payload.WithdrawalRequests)
+		res.ConsolidationRequests = ConvertConsolidationRequestsFromRpc(payload.ConsolidationRequests)
+	}
 	return res
 }
 
@@ -245,6 +269,47 @@ func ConvertWithdrawalsFromRpc(in []*types2.Withdrawal) []*types.Withdrawal {
 	return out
 }
 
+func ConvertDepositRequestsFromRpc(in []*types2.DepositRequest) types.Deposits {
+	out := make(types.Deposits, 0, len(in))
+	for _, d := range in {
+		deposit := &types.Deposit{
+			WithdrawalCredentials: gointerfaces.ConvertH256ToHash(d.WithdrawalCredentials),
+			Amount:                d.Amount,
+			Index:                 d.Index,
+		}
+		copy(deposit.Pubkey[:], d.Pubkey)
+		copy(deposit.Signature[:], d.Signature)
+		out = append(out, deposit)
+	}
+	return out
+}
+
+func ConvertWithdrawalRequestsFromRpc(in []*types2.WithdrawalRequest) types.WithdrawalRequests {
+	out := make(types.WithdrawalRequests, 0, len(in))
+	for _, w := range in {
+		wr := &types.WithdrawalRequest{
+			SourceAddress: gointerfaces.ConvertH160toAddress(w.SourceAddress),
+			Amount:        w.Amount,
+		}
+		copy(wr.ValidatorPubkey[:], w.ValidatorPubkey)
+		out = append(out, wr)
+	}
+	return out
+}
+
+func ConvertConsolidationRequestsFromRpc(in []*types2.ConsolidationRequest) types.ConsolidationRequests {
+	out := make(types.ConsolidationRequests, 0, len(in))
+	for _, c := range in {
+		cr := &types.ConsolidationRequest{
+			SourceAddress: gointerfaces.ConvertH160toAddress(c.SourceAddress),
+		}
+		copy(cr.SourcePubKey[:], c.SourcePubkey)
+		copy(cr.TargetPubKey[:], c.TargetPubkey)
+		out = append(out, cr)
+	}
+	return out
+}
+
 func ConvertPayloadId(payloadId uint64) *hexutility.Bytes {
 	encodedPayloadId := make([]byte, 8)
 	binary.BigEndian.PutUint64(encodedPayloadId, payloadId)
@@ -167,6 +167,11 @@ func (e *EthereumExecutionModule) GetAssembledBlock(ctx context.Context, req *ex
 		payload.ExcessBlobGas = header.ExcessBlobGas
 	}
 
+	if header.RequestsRoot != nil {
+		payload.Version = 4
+		payload.DepositRequests, payload.WithdrawalRequests, payload.ConsolidationRequests = eth1_utils.ConvertRequestsToRpc(block.Requests())
+	}
+
 	blockValue := blockValue(blockWithReceipts, baseFee)
 
 	blobsBundle := &types2.BlobsBundleV1{}
@@ -194,6 +194,37 @@ func ConvertWithdrawalsToRpc(in []*types.Withdrawal) []*types2.Withdrawal {
 	return out
 }
 
+// ConvertRequestsToRpc splits the EIP-7685 requests into the typed lists of the execution payload
+func ConvertRequestsToRpc(in types.Requests) ([]*types2.DepositRequest, []*types2.WithdrawalRequest, []*types2.ConsolidationRequest) {
+	deposits := make([]*types2.DepositRequest, 0)
+	for _, d := range in.Deposits() {
+		deposits = append(deposits, &types2.DepositRequest{
+			Pubkey:                d.Pubkey[:],
+			WithdrawalCredentials: gointerfaces.ConvertHashToH256(d.WithdrawalCredentials),
+			Amount:                d.Amount,
+			Signature:             d.Signature[:],
+			Index:                 d.Index,
+		})
+	}
+	withdrawals := make([]*types2.WithdrawalRequest, 0)
+	for _, w := range in.Withdrawals() {
+		withdrawals = append(withdrawals, &types2.WithdrawalRequest{
+			SourceAddress:   gointerfaces.ConvertAddressToH160(w.SourceAddress),
+			ValidatorPubkey: w.ValidatorPubkey[:],
+			Amount:          w.Amount,
+		})
+	}
+	consolidations := make([]*types2.ConsolidationRequest, 0)
+	for _, c := range in.Consolidations() {
+		consolidations = append(consolidations, &types2.ConsolidationRequest{
+			SourceAddress: gointerfaces.ConvertAddressToH160(c.SourceAddress),
+			SourcePubkey:  c.SourcePubKey[:],
+			TargetPubkey:  c.TargetPubKey[:],
+		})
+	}
+	return deposits, withdrawals, consolidations
+}
+
 func ConvertRawBlockBodyToRpc(in *types.RawBody, blockNumber uint64, blockHash libcommon.Hash) *execution.BlockBody {
 	if in == nil {
 		return nil
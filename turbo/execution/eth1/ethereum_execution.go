@@ -226,6 +226,11 @@ func (e *EthereumExecutionModule) ValidateChain(ctx context.Context, req *execut
 	if isInvalidChain {
 		e.logger.Warn("ethereumExecutionModule.ValidateChain: chain is invalid", "hash", libcommon.Hash(blockHash))
 		validationStatus = execution.ExecutionStatus_BadBlock
+		// keep the rejected payload for debug_getBadBlocks
+		badBlock := types.NewBlockFromStorage(blockHash, header, body.Transactions, body.Uncles, body.Withdrawals, body.Requests)
+		if err := rawdb.WriteBadBlock(tx, badBlock); err != nil {
+			e.logger.Warn("ethereumExecutionModule.ValidateChain: failed to store bad block", "hash", libcommon.Hash(blockHash), "err", err)
+		}
 	}
 	validationReceipt := &execution.ValidationReceipt{
 		ValidationStatus: validationStatus,
@@ -2,6 +2,8 @@ package jsonrpc
 
 import (
 	"context"
+	"encoding/json"
+	"math"
 	"sync"
 	"testing"
 
@@ -260,3 +262,74 @@ func TestFilterAddressIntersection(t *testing.T) {
 		require.Empty(t, blockNumbersFromTraces(t, stream.Buffer()))
 	})
 }
+
+func TestFilterCursorPagination(t *testing.T) {
+	m := mock.Mock(t)
+	chain, err := core.GenerateChain(m.ChainConfig, m.Genesis, m.Engine, m.DB, 10, func(i int, gen *core.BlockGen) {
+		gen.SetCoinbase(common.Address{1})
+		if i == 5 {
+			// 3 reward traces in one block, so a page can end in the middle of them
+			for j := 1; j <= 2; j++ {
+				uncle := gen.PrevBlock(j).Header()
+				uncle.Extra = []byte("foo")
+				gen.AddUncle(uncle)
+			}
+		}
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.InsertChain(chain))
+	api := NewTraceAPI(newBaseApiForTest(m), m.DB, &httpcfg.HttpCfg{TraceFilterMaxBlocks: 3})
+
+	var fromBlock, toBlock uint64 = 1, 10
+	stream := jsoniter.ConfigDefault.BorrowStream(nil)
+	defer jsoniter.ConfigDefault.ReturnStream(stream)
+	// the block limit only applies to pages, a call without a cursor still replays the whole range
+	require.NoError(t, api.Filter(context.Background(), TraceFilterRequest{
+		FromBlock: (*hexutil.Uint64)(&fromBlock),
+		ToBlock:   (*hexutil.Uint64)(&toBlock),
+	}, new(bool), stream))
+	expected := blockNumbersFromTraces(t, stream.Buffer())
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 6, 6, 7, 8, 9, 10}, expected)
+
+	count := uint64(2)
+	cursor := ""
+	var paged []int
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 20)
+		stream.Reset(nil)
+		require.NoError(t, api.Filter(context.Background(), TraceFilterRequest{
+			FromBlock: (*hexutil.Uint64)(&fromBlock),
+			ToBlock:   (*hexutil.Uint64)(&toBlock),
+			Count:     &count,
+			Cursor:    &cursor,
+		}, new(bool), stream))
+		var page struct {
+			Traces     []json.RawMessage `json:"traces"`
+			NextCursor *string           `json:"nextCursor"`
+		}
+		require.NoError(t, json.Unmarshal(stream.Buffer(), &page))
+		require.LessOrEqual(t, uint64(len(page.Traces)), count)
+		for _, tr := range page.Traces {
+			paged = append(paged, blockNumbersFromTraces(t, append(append([]byte{'['}, tr...), ']'))...)
+		}
+		if page.NextCursor == nil {
+			break
+		}
+		cursor = *page.NextCursor
+	}
+	require.Equal(t, expected, paged)
+
+	after := uint64(1)
+	require.Error(t, api.Filter(context.Background(), TraceFilterRequest{After: &after, Cursor: &cursor}, new(bool), stream))
+	malformed := "not a cursor"
+	require.Error(t, api.Filter(context.Background(), TraceFilterRequest{Cursor: &malformed}, new(bool), stream))
+	// the blocks have no transactions, so the block reward traces at index 0 are the only valid position
+	for _, txIndex := range []uint64{1, math.MaxUint64} {
+		forged := (&traceFilterCursor{BlockNum: 2, TxIndex: txIndex}).String()
+		require.Error(t, api.Filter(context.Background(), TraceFilterRequest{
+			FromBlock: (*hexutil.Uint64)(&fromBlock),
+			ToBlock:   (*hexutil.Uint64)(&toBlock),
+			Cursor:    &forged,
+		}, new(bool), stream))
+	}
+}
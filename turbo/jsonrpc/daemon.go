@@ -26,7 +26,7 @@ func APIList(db kv.RoDB, eth rpchelper.ApiBackend, txPool txpool.TxpoolClient, m
 	erigonImpl := NewErigonAPI(base, db, eth)
 	txpoolImpl := NewTxPoolAPI(base, db, txPool)
 	netImpl := NewNetAPIImpl(eth)
-	debugImpl := NewPrivateDebugAPI(base, db, cfg.Gascap)
+	debugImpl := NewPrivateDebugAPI(base, db, cfg.Gascap, cfg.TraceWorkers)
 	traceImpl := NewTraceAPI(base, db, cfg)
 	web3Impl := NewWeb3APIImpl(eth)
 	dbImpl := NewDBAPIImpl() /* deprecated */
@@ -50,7 +50,7 @@ func APIList(db kv.RoDB, eth rpchelper.ApiBackend, txPool txpool.TxpoolClient, m
 	}
 
 	otsImpl := NewOtterscanAPI(base, db, cfg.OtsMaxPageSize)
-	gqlImpl := NewGraphQLAPI(base, db)
+	gqlImpl := NewGraphQLAPI(base, db, ethImpl)
 	overlayImpl := NewOverlayAPI(base, db, cfg.Gascap, cfg.OverlayGetLogsTimeout, cfg.OverlayReplayBlockTimeout, otsImpl)
 
 	if cfg.GraphQLEnabled {
@@ -71,6 +71,12 @@ func APIList(db kv.RoDB, eth rpchelper.ApiBackend, txPool txpool.TxpoolClient, m
 				Service:   EthAPI(ethImpl),
 				Version:   "1.0",
 			})
+			list = append(list, rpc.API{
+				Namespace: "eth",
+				Public:    true,
+				Service:   NewEthSyncingSubscriptionAPI(ethImpl),
+				Version:   "1.0",
+			})
 		case "debug":
 			list = append(list, rpc.API{
 				Namespace: "debug",
@@ -150,6 +156,13 @@ func APIList(db kv.RoDB, eth rpchelper.ApiBackend, txPool txpool.TxpoolClient, m
 				Service:   OtterscanAPI(otsImpl),
 				Version:   "1.0",
 			})
+		case "ots2":
+			list = append(list, rpc.API{
+				Namespace: "ots2",
+				Public:    true,
+				Service:   Otterscan2API(otsImpl),
+				Version:   "1.0",
+			})
 		case "clique":
 			list = append(list, clique.NewCliqueAPI(db, engine, blockReader))
 		case "overlay":
@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -33,6 +34,7 @@ type PrivateDebugAPI interface {
 	TraceTransaction(ctx context.Context, hash common.Hash, config *tracers.TraceConfig, stream *jsoniter.Stream) error
 	TraceBlockByHash(ctx context.Context, hash common.Hash, config *tracers.TraceConfig, stream *jsoniter.Stream) error
 	TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *tracers.TraceConfig, stream *jsoniter.Stream) error
+	TraceChain(ctx context.Context, start, end rpc.BlockNumber, config *tracers.TraceConfig) (*rpc.Subscription, error)
 	AccountRange(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, start []byte, maxResults int, nocode, nostorage bool) (state.IteratorDump, error)
 	GetModifiedAccountsByNumber(ctx context.Context, startNum rpc.BlockNumber, endNum *rpc.BlockNumber) ([]common.Address, error)
 	GetModifiedAccountsByHash(ctx context.Context, startHash common.Hash, endHash *common.Hash) ([]common.Address, error)
@@ -40,21 +42,26 @@ type PrivateDebugAPI interface {
 	AccountAt(ctx context.Context, blockHash common.Hash, txIndex uint64, account common.Address) (*AccountResult, error)
 	GetRawHeader(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (hexutility.Bytes, error)
 	GetRawBlock(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (hexutility.Bytes, error)
+	GetRawReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]hexutility.Bytes, error)
+	GetRawTransaction(ctx context.Context, hash common.Hash) (hexutility.Bytes, error)
+	GetBadBlocks(ctx context.Context) ([]*BadBlockArgs, error)
 }
 
 // PrivateDebugAPIImpl is implementation of the PrivateDebugAPI interface based on remote Db access
 type PrivateDebugAPIImpl struct {
 	*BaseAPI
-	db     kv.RoDB
-	GasCap uint64
+	db           kv.RoDB
+	GasCap       uint64
+	traceWorkers int // number of workers tracing transactions of a block concurrently, sequential tracing if <= 1
 }
 
 // NewPrivateDebugAPI returns PrivateDebugAPIImpl instance
-func NewPrivateDebugAPI(base *BaseAPI, db kv.RoDB, gascap uint64) *PrivateDebugAPIImpl {
+func NewPrivateDebugAPI(base *BaseAPI, db kv.RoDB, gascap uint64, traceWorkers int) *PrivateDebugAPIImpl {
 	return &PrivateDebugAPIImpl{
-		BaseAPI: base,
-		db:      db,
-		GasCap:  gascap,
+		BaseAPI:      base,
+		db:           db,
+		GasCap:       gascap,
+		traceWorkers: traceWorkers,
 	}
 }
 
@@ -351,3 +358,102 @@ func (api *PrivateDebugAPIImpl) GetRawBlock(ctx context.Context, blockNrOrHash r
 	}
 	return rlp.EncodeToBytes(block)
 }
+
+// GetRawReceipts implements debug_getRawReceipts. Returns the consensus encoding of all receipts of the block.
+func (api *PrivateDebugAPIImpl) GetRawReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]hexutility.Bytes, error) {
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	n, h, _, err := rpchelper.GetBlockNumber(blockNrOrHash, tx, api.filters)
+	if err != nil {
+		return nil, err
+	}
+	block, err := api.blockWithSenders(ctx, tx, h, n)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block not found")
+	}
+	receipts, err := api.getReceipts(ctx, tx, block, block.Body().SendersFromTxs())
+	if err != nil {
+		return nil, fmt.Errorf("getReceipts error: %w", err)
+	}
+	result := make([]hexutility.Bytes, len(receipts))
+	for i := range receipts {
+		var buf bytes.Buffer
+		receipts.EncodeIndex(i, &buf)
+		result[i] = buf.Bytes()
+	}
+	return result, nil
+}
+
+// GetRawTransaction implements debug_getRawTransaction. Returns the bytes of the transaction for the given hash.
+func (api *PrivateDebugAPIImpl) GetRawTransaction(ctx context.Context, txnHash common.Hash) (hexutility.Bytes, error) {
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	blockNum, ok, err := api.txnLookup(ctx, tx, txnHash)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	block, err := api.blockByNumberWithSenders(ctx, tx, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, nil
+	}
+	for _, txn := range block.Transactions() {
+		if txn.Hash() == txnHash {
+			var buf bytes.Buffer
+			err = txn.MarshalBinary(&buf)
+			return buf.Bytes(), err
+		}
+	}
+	return nil, nil
+}
+
+// BadBlockArgs represents the entries in the list returned when bad blocks are queried.
+type BadBlockArgs struct {
+	Hash  common.Hash            `json:"hash"`
+	Block map[string]interface{} `json:"block"`
+	RLP   hexutility.Bytes       `json:"rlp"`
+}
+
+// GetBadBlocks implements debug_getBadBlocks. Returns the most recent blocks rejected by block validation, newest first.
+func (api *PrivateDebugAPIImpl) GetBadBlocks(ctx context.Context) ([]*BadBlockArgs, error) {
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	blocks, err := rawdb.ReadAllBadBlocks(tx)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*BadBlockArgs, 0, len(blocks))
+	for _, block := range blocks {
+		blockRlp, err := rlp.EncodeToBytes(block)
+		if err != nil {
+			return nil, err
+		}
+		blockJson, err := ethapi.RPCMarshalBlock(block, true, true, nil)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &BadBlockArgs{
+			Hash:  block.Hash(),
+			Block: blockJson,
+			RLP:   blockRlp,
+		})
+	}
+	return results, nil
+}
@@ -3,20 +3,26 @@ package jsonrpc
 import (
 	"bytes"
 	"encoding/json"
+	"math/big"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	jsoniter "github.com/json-iterator/go"
 	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 	"github.com/ledgerwatch/erigon-lib/kv"
 	"github.com/ledgerwatch/erigon-lib/kv/iter"
 	"github.com/ledgerwatch/erigon-lib/kv/kvcache"
 	"github.com/ledgerwatch/erigon-lib/kv/order"
 	"github.com/ledgerwatch/erigon-lib/kv/rawdbv3"
 	"github.com/ledgerwatch/erigon/cmd/rpcdaemon/rpcdaemontest"
+	"github.com/ledgerwatch/erigon/core/rawdb"
 	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/eth/tracers"
+	"github.com/ledgerwatch/erigon/rlp"
 	"github.com/ledgerwatch/erigon/rpc"
 	"github.com/ledgerwatch/erigon/rpc/rpccfg"
 	"github.com/ledgerwatch/erigon/turbo/adapter/ethapi"
@@ -54,7 +60,7 @@ func TestTraceBlockByNumber(t *testing.T) {
 	stateCache := kvcache.New(kvcache.DefaultCoherentConfig)
 	baseApi := NewBaseApi(nil, stateCache, m.BlockReader, agg, false, rpccfg.DefaultEvmCallTimeout, m.Engine, m.Dirs)
 	ethApi := NewEthAPI(baseApi, m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, 128, log.New())
-	api := NewPrivateDebugAPI(baseApi, m.DB, 0)
+	api := NewPrivateDebugAPI(baseApi, m.DB, 0, 0)
 	for _, tt := range debugTraceTransactionTests {
 		var buf bytes.Buffer
 		stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
@@ -99,7 +105,7 @@ func TestTraceBlockByNumber(t *testing.T) {
 func TestTraceBlockByHash(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
 	ethApi := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, 128, log.New())
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 0)
 	for _, tt := range debugTraceTransactionTests {
 		var buf bytes.Buffer
 		stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
@@ -130,7 +136,7 @@ func TestTraceBlockByHash(t *testing.T) {
 
 func TestTraceTransaction(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 0)
 	for _, tt := range debugTraceTransactionTests {
 		var buf bytes.Buffer
 		stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
@@ -159,7 +165,7 @@ func TestTraceTransaction(t *testing.T) {
 
 func TestTraceTransactionNoRefund(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 0)
 	for _, tt := range debugTraceTransactionNoRefundTests {
 		var buf bytes.Buffer
 		stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
@@ -189,7 +195,7 @@ func TestTraceTransactionNoRefund(t *testing.T) {
 
 func TestStorageRangeAt(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 0)
 	t.Run("invalid addr", func(t *testing.T) {
 		var block4 *types.Block
 		var err error
@@ -283,7 +289,7 @@ func TestStorageRangeAt(t *testing.T) {
 
 func TestAccountRange(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 0)
 
 	t.Run("valid account", func(t *testing.T) {
 		addr := common.HexToAddress("0x537e697c7ab75a26f9ecf0ce810e3154dfcaaf55")
@@ -342,7 +348,7 @@ func TestAccountRange(t *testing.T) {
 
 func TestGetModifiedAccountsByNumber(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 0)
 
 	t.Run("correct input", func(t *testing.T) {
 		n, n2 := rpc.BlockNumber(1), rpc.BlockNumber(2)
@@ -444,7 +450,7 @@ func TestMapTxNum2BlockNum(t *testing.T) {
 
 func TestAccountAt(t *testing.T) {
 	m, _, _ := rpcdaemontest.CreateTestSentry(t)
-	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 0)
 
 	var blockHash0, blockHash1, blockHash3, blockHash10, blockHash12 common.Hash
 	_ = m.DB.View(m.Ctx, func(tx kv.Tx) error {
@@ -504,3 +510,129 @@ func TestAccountAt(t *testing.T) {
 		require.Equal(0, int(results.Nonce))
 	})
 }
+
+func TestTraceBlockParallel(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	ethApi := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, 128, log.New())
+	sequential := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 0)
+	parallel := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 4)
+
+	latest, err := ethApi.BlockNumber(m.Ctx)
+	require.NoError(t, err)
+	callTracer := "callTracer"
+	for _, config := range []*tracers.TraceConfig{{}, {Tracer: &callTracer}} {
+		for blockNum := rpc.BlockNumber(1); blockNum <= rpc.BlockNumber(latest); blockNum++ {
+			var expected, actual bytes.Buffer
+			stream := jsoniter.NewStream(jsoniter.ConfigDefault, &expected, 4096)
+			require.NoError(t, sequential.TraceBlockByNumber(m.Ctx, blockNum, config, stream))
+			require.NoError(t, stream.Flush())
+			stream = jsoniter.NewStream(jsoniter.ConfigDefault, &actual, 4096)
+			require.NoError(t, parallel.TraceBlockByNumber(m.Ctx, blockNum, config, stream))
+			require.NoError(t, stream.Flush())
+			require.JSONEq(t, expected.String(), actual.String(), "block %d", blockNum)
+		}
+	}
+}
+
+func TestTraceChain(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 0)
+
+	server := rpc.NewServer(50, false /* traceRequests */, false /* debugSingleRequest */, true /* disableStreaming */, log.New(), 100*time.Millisecond)
+	defer server.Stop()
+	require.NoError(t, server.RegisterName("debug", api))
+	client := rpc.DialInProc(server, log.New())
+	defer client.Close()
+
+	callTracer := "callTracer"
+	config := &tracers.TraceConfig{Tracer: &callTracer}
+	notifications := make(chan blockTraceResult)
+	sub, err := client.Subscribe(m.Ctx, "debug", notifications, "traceChain", rpc.BlockNumber(2), rpc.BlockNumber(5), config)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	for blockNum := uint64(2); blockNum <= 5; blockNum++ {
+		var res blockTraceResult
+		select {
+		case res = <-notifications:
+		case err := <-sub.Err():
+			t.Fatal(err)
+		}
+		require.Equal(t, hexutil.Uint64(blockNum), res.Block)
+		require.Empty(t, res.Error)
+
+		var buf bytes.Buffer
+		stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
+		require.NoError(t, api.TraceBlockByNumber(m.Ctx, rpc.BlockNumber(blockNum), config, stream))
+		require.NoError(t, stream.Flush())
+		require.JSONEq(t, buf.String(), string(res.Traces))
+	}
+
+	_, err = client.Subscribe(m.Ctx, "debug", notifications, "traceChain", rpc.BlockNumber(5), rpc.BlockNumber(2), config)
+	require.Error(t, err)
+}
+
+type rawReceipts []hexutility.Bytes
+
+func (rs rawReceipts) Len() int                           { return len(rs) }
+func (rs rawReceipts) EncodeIndex(i int, w *bytes.Buffer) { w.Write(rs[i]) }
+
+func TestGetRawReceiptsAndTransaction(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	ethApi := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, 128, log.New())
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 0)
+
+	for _, tt := range debugTraceTransactionTests {
+		txnHash := common.HexToHash(tt.txHash)
+		expected, err := ethApi.GetRawTransactionByHash(m.Ctx, txnHash)
+		require.NoError(t, err)
+		actual, err := api.GetRawTransaction(m.Ctx, txnHash)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+
+		txn, err := ethApi.GetTransactionByHash(m.Ctx, txnHash)
+		require.NoError(t, err)
+		receipts, err := api.GetRawReceipts(m.Ctx, rpc.BlockNumberOrHashWithHash(*txn.BlockHash, true))
+		require.NoError(t, err)
+		tx, err := m.DB.BeginRo(m.Ctx)
+		require.NoError(t, err)
+		block, err := m.BlockReader.BlockByHash(m.Ctx, tx, *txn.BlockHash)
+		tx.Rollback()
+		require.NoError(t, err)
+		require.Len(t, receipts, block.Transactions().Len())
+		require.Equal(t, block.ReceiptHash(), types.DeriveSha(rawReceipts(receipts)))
+	}
+
+	missing, err := api.GetRawTransaction(m.Ctx, common.Hash{1})
+	require.NoError(t, err)
+	require.Nil(t, missing)
+}
+
+func TestGetBadBlocks(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	api := NewPrivateDebugAPI(newBaseApiForTest(m), m.DB, 0, 0)
+
+	badBlocks, err := api.GetBadBlocks(m.Ctx)
+	require.NoError(t, err)
+	require.Empty(t, badBlocks)
+
+	block := types.NewBlockWithHeader(&types.Header{
+		Number:      big.NewInt(100),
+		Extra:       []byte("bad block"),
+		UncleHash:   types.EmptyUncleHash,
+		TxHash:      types.EmptyRootHash,
+		ReceiptHash: types.EmptyRootHash,
+	})
+	require.NoError(t, m.DB.Update(m.Ctx, func(tx kv.RwTx) error {
+		return rawdb.WriteBadBlock(tx, block)
+	}))
+
+	badBlocks, err = api.GetBadBlocks(m.Ctx)
+	require.NoError(t, err)
+	require.Len(t, badBlocks, 1)
+	require.Equal(t, block.Hash(), badBlocks[0].Hash)
+	require.Equal(t, block.Hash(), badBlocks[0].Block["hash"])
+	expectedRlp, err := rlp.EncodeToBytes(block)
+	require.NoError(t, err)
+	require.Equal(t, hexutility.Bytes(expectedRlp), badBlocks[0].RLP)
+}
@@ -87,16 +87,19 @@ type EthAPI interface {
 	ChainId(ctx context.Context) (hexutil.Uint64, error) /* called eth_protocolVersion elsewhere */
 	ProtocolVersion(_ context.Context) (hexutil.Uint, error)
 	GasPrice(_ context.Context) (*hexutil.Big, error)
+	BlobBaseFee(ctx context.Context) (*hexutil.Big, error)
 
 	// Sending related (see ./eth_call.go)
 	Call(ctx context.Context, args ethapi2.CallArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *ethapi2.StateOverrides) (hexutility.Bytes, error)
 	EstimateGas(ctx context.Context, argsOrNil *ethapi2.CallArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error)
 	SendRawTransaction(ctx context.Context, encodedTx hexutility.Bytes) (common.Hash, error)
+	SendPrivateRawTransaction(ctx context.Context, encodedTx hexutility.Bytes, maxBlockNumber *hexutil.Uint64) (common.Hash, error)
 	SendTransaction(_ context.Context, txObject interface{}) (common.Hash, error)
 	Sign(ctx context.Context, _ common.Address, _ hexutility.Bytes) (hexutility.Bytes, error)
 	SignTransaction(_ context.Context, txObject interface{}) (common.Hash, error)
 	GetProof(ctx context.Context, address common.Address, storageKeys []common.Hash, blockNr rpc.BlockNumberOrHash) (*accounts.AccProofResult, error)
 	CreateAccessList(ctx context.Context, args ethapi2.CallArgs, blockNrOrHash *rpc.BlockNumberOrHash, optimizeGas *bool) (*accessListResult, error)
+	SimulateV1(ctx context.Context, opts SimulationOpts, blockNrOrHash *rpc.BlockNumberOrHash) ([]map[string]interface{}, error)
 
 	// Mining related (see ./eth_mining.go)
 	Coinbase(ctx context.Context) (common.Address, error)
@@ -363,28 +366,29 @@ func NewEthAPI(base *BaseAPI, db kv.RoDB, eth rpchelper.ApiBackend, txPool txpoo
 
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
 type RPCTransaction struct {
-	BlockHash           *common.Hash       `json:"blockHash"`
-	BlockNumber         *hexutil.Big       `json:"blockNumber"`
-	From                common.Address     `json:"from"`
-	Gas                 hexutil.Uint64     `json:"gas"`
-	GasPrice            *hexutil.Big       `json:"gasPrice,omitempty"`
-	Tip                 *hexutil.Big       `json:"maxPriorityFeePerGas,omitempty"`
-	FeeCap              *hexutil.Big       `json:"maxFeePerGas,omitempty"`
-	Hash                common.Hash        `json:"hash"`
-	Input               hexutility.Bytes   `json:"input"`
-	Nonce               hexutil.Uint64     `json:"nonce"`
-	To                  *common.Address    `json:"to"`
-	TransactionIndex    *hexutil.Uint64    `json:"transactionIndex"`
-	Value               *hexutil.Big       `json:"value"`
-	Type                hexutil.Uint64     `json:"type"`
-	Accesses            *types2.AccessList `json:"accessList,omitempty"`
-	ChainID             *hexutil.Big       `json:"chainId,omitempty"`
-	MaxFeePerBlobGas    *hexutil.Big       `json:"maxFeePerBlobGas,omitempty"`
-	BlobVersionedHashes []common.Hash      `json:"blobVersionedHashes,omitempty"`
-	V                   *hexutil.Big       `json:"v"`
-	YParity             *hexutil.Big       `json:"yParity,omitempty"`
-	R                   *hexutil.Big       `json:"r"`
-	S                   *hexutil.Big       `json:"s"`
+	BlockHash           *common.Hash          `json:"blockHash"`
+	BlockNumber         *hexutil.Big          `json:"blockNumber"`
+	From                common.Address        `json:"from"`
+	Gas                 hexutil.Uint64        `json:"gas"`
+	GasPrice            *hexutil.Big          `json:"gasPrice,omitempty"`
+	Tip                 *hexutil.Big          `json:"maxPriorityFeePerGas,omitempty"`
+	FeeCap              *hexutil.Big          `json:"maxFeePerGas,omitempty"`
+	Hash                common.Hash           `json:"hash"`
+	Input               hexutility.Bytes      `json:"input"`
+	Nonce               hexutil.Uint64        `json:"nonce"`
+	To                  *common.Address       `json:"to"`
+	TransactionIndex    *hexutil.Uint64       `json:"transactionIndex"`
+	Value               *hexutil.Big          `json:"value"`
+	Type                hexutil.Uint64        `json:"type"`
+	Accesses            *types2.AccessList    `json:"accessList,omitempty"`
+	ChainID             *hexutil.Big          `json:"chainId,omitempty"`
+	MaxFeePerBlobGas    *hexutil.Big          `json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes []common.Hash         `json:"blobVersionedHashes,omitempty"`
+	AuthorizationList   []types.Authorization `json:"authorizationList,omitempty"`
+	V                   *hexutil.Big          `json:"v"`
+	YParity             *hexutil.Big          `json:"yParity,omitempty"`
+	R                   *hexutil.Big          `json:"r"`
+	S                   *hexutil.Big          `json:"s"`
 }
 
 // NewRPCTransaction returns a transaction that will serialize to the RPC
@@ -451,6 +455,18 @@ func NewRPCTransaction(tx types.Transaction, blockHash common.Hash, blockNumber
 		result.GasPrice = computeGasPrice(tx, blockHash, baseFee)
 		result.MaxFeePerBlobGas = (*hexutil.Big)(t.MaxFeePerBlobGas.ToBig())
 		result.BlobVersionedHashes = t.BlobVersionedHashes
+	case *types.SetCodeTransaction:
+		chainId.Set(t.ChainID)
+		result.ChainID = (*hexutil.Big)(chainId.ToBig())
+		result.Tip = (*hexutil.Big)(t.Tip.ToBig())
+		result.FeeCap = (*hexutil.Big)(t.FeeCap.ToBig())
+		result.YParity = (*hexutil.Big)(t.V.ToBig())
+		result.V = (*hexutil.Big)(t.V.ToBig())
+		result.R = (*hexutil.Big)(t.R.ToBig())
+		result.S = (*hexutil.Big)(t.S.ToBig())
+		result.Accesses = &t.AccessList
+		result.GasPrice = computeGasPrice(tx, blockHash, baseFee)
+		result.AuthorizationList = t.Authorizations
 	}
 	signer := types.LatestSignerForChainID(chainId.ToBig())
 	result.From, _ = tx.Sender(*signer)
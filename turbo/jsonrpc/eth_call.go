@@ -43,6 +43,28 @@ func (api *APIImpl) Call(ctx context.Context, args ethapi2.CallArgs, blockNrOrHa
 	}
 	defer tx.Rollback()
 
+	result, err := api.doCall(ctx, tx, args, blockNrOrHash, overrides)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	if len(result.ReturnData) > api.ReturnDataLimit {
+		return nil, fmt.Errorf("call returned result on length %d exceeding --rpc.returndata.limit %d", len(result.ReturnData), api.ReturnDataLimit)
+	}
+
+	// If the result contains a revert reason, try to unpack and return it.
+	if len(result.Revert()) > 0 {
+		return nil, ethapi2.NewRevertError(result)
+	}
+
+	return result.Return(), result.Err
+}
+
+// doCall executes the eth_call message on top of the given block, the result is nil if the block is not found
+func (api *APIImpl) doCall(ctx context.Context, tx kv.Tx, args ethapi2.CallArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *ethapi2.StateOverrides) (*core.ExecutionResult, error) {
 	chainConfig, err := api.chainConfig(ctx, tx)
 	if err != nil {
 		return nil, err
@@ -70,21 +92,7 @@ func (api *APIImpl) Call(ctx context.Context, args ethapi2.CallArgs, blockNrOrHa
 		return nil, err
 	}
 	header := block.HeaderNoCopy()
-	result, err := transactions.DoCall(ctx, engine, args, tx, blockNrOrHash, header, overrides, api.GasCap, chainConfig, stateReader, api._blockReader, api.evmCallTimeout)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(result.ReturnData) > api.ReturnDataLimit {
-		return nil, fmt.Errorf("call returned result on length %d exceeding --rpc.returndata.limit %d", len(result.ReturnData), api.ReturnDataLimit)
-	}
-
-	// If the result contains a revert reason, try to unpack and return it.
-	if len(result.Revert()) > 0 {
-		return nil, ethapi2.NewRevertError(result)
-	}
-
-	return result.Return(), result.Err
+	return transactions.DoCall(ctx, engine, args, tx, blockNrOrHash, header, overrides, api.GasCap, chainConfig, stateReader, api._blockReader, api.evmCallTimeout)
 }
 
 // headerByNumberOrHash - intent to read recent headers only, tries from the lru cache before reading from the db
@@ -2,11 +2,17 @@ package jsonrpc
 
 import (
 	"context"
+	"reflect"
 	"strings"
 
 	"github.com/ledgerwatch/log/v3"
 
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/kv"
+
 	"github.com/ledgerwatch/erigon/common/debug"
+	"github.com/ledgerwatch/erigon/core/rawdb"
 	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/eth/filters"
 	"github.com/ledgerwatch/erigon/rpc"
@@ -287,3 +293,119 @@ func (api *APIImpl) Logs(ctx context.Context, crit filters.FilterCriteria) (*rpc
 
 	return rpcSub, nil
 }
+
+// SyncingResult is the notification of the syncing subscription. Status has the eth_syncing format.
+type SyncingResult struct {
+	Syncing bool        `json:"syncing"`
+	Status  interface{} `json:"status,omitempty"`
+}
+
+// EthSyncingSubscriptionAPI serves eth_subscribe("syncing"). It is a separate receiver because
+// APIImpl.Syncing already serves eth_syncing.
+type EthSyncingSubscriptionAPI struct {
+	api *APIImpl
+}
+
+func NewEthSyncingSubscriptionAPI(api *APIImpl) *EthSyncingSubscriptionAPI {
+	return &EthSyncingSubscriptionAPI{api: api}
+}
+
+// Syncing send a notification each time the node starts or stops syncing, and on stage progress while it is syncing.
+func (s *EthSyncingSubscriptionAPI) Syncing(ctx context.Context) (*rpc.Subscription, error) {
+	api := s.api
+	return api.subscribeChainState(ctx, func(tx kv.Tx) (interface{}, error) {
+		status, err := api.syncingStatus(tx)
+		if err != nil {
+			return nil, err
+		}
+		if syncing, ok := status.(bool); ok && !syncing {
+			return SyncingResult{Syncing: false}, nil
+		}
+		return SyncingResult{Syncing: true, Status: status}, nil
+	})
+}
+
+// ForkchoiceHead is a block referenced by the last engine API forkchoice update
+type ForkchoiceHead struct {
+	Hash   common.Hash    `json:"hash"`
+	Number hexutil.Uint64 `json:"number"`
+}
+
+// ForkchoiceHeads is the notification of the forkchoiceHeads subscription, unknown blocks are null
+type ForkchoiceHeads struct {
+	Safe      *ForkchoiceHead `json:"safe"`
+	Finalized *ForkchoiceHead `json:"finalized"`
+}
+
+// ForkchoiceHeads send a notification each time the safe or finalized block set by the consensus layer changes.
+func (api *APIImpl) ForkchoiceHeads(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribeChainState(ctx, func(tx kv.Tx) (interface{}, error) {
+		readHead := func(hash common.Hash) *ForkchoiceHead {
+			if hash == (common.Hash{}) {
+				return nil
+			}
+			number := rawdb.ReadHeaderNumber(tx, hash)
+			if number == nil {
+				return nil
+			}
+			return &ForkchoiceHead{Hash: hash, Number: hexutil.Uint64(*number)}
+		}
+		return ForkchoiceHeads{
+			Safe:      readHead(rawdb.ReadForkchoiceSafe(tx)),
+			Finalized: readHead(rawdb.ReadForkchoiceFinalized(tx)),
+		}, nil
+	})
+}
+
+// subscribeChainState sends the value returned by read each time it differs from the previously sent one.
+// The current value is sent right after subscribing, then it is re-read on every chain state event, which
+// is emitted when a stage makes progress and when a forkchoice update is committed.
+func (api *APIImpl) subscribeChainState(ctx context.Context, read func(tx kv.Tx) (interface{}, error)) (*rpc.Subscription, error) {
+	if api.filters == nil {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		defer debug.LogPanic()
+		events, id := api.filters.SubscribeChainState(8)
+		defer api.filters.UnsubscribeChainState(id)
+
+		var last interface{}
+		for {
+			current, err := func() (interface{}, error) {
+				tx, err := api.db.BeginRo(context.Background())
+				if err != nil {
+					return nil, err
+				}
+				defer tx.Rollback()
+				return read(tx)
+			}()
+			if err != nil {
+				log.Warn("[rpc] error while reading chain state for subscription", "err", err)
+			} else if !reflect.DeepEqual(current, last) {
+				if err = notifier.Notify(rpcSub.ID, current); err != nil {
+					log.Warn("[rpc] error while notifying subscription", "err", err)
+				}
+				last = current
+			}
+
+			select {
+			case _, ok := <-events:
+				if !ok {
+					log.Warn("[rpc] chain state channel was closed")
+					return
+				}
+			case <-rpcSub.Err():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
@@ -8,14 +8,19 @@ import (
 
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/length"
+	"github.com/ledgerwatch/erigon-lib/kv"
 
+	"github.com/ledgerwatch/erigon/rpc"
 	"github.com/ledgerwatch/erigon/rpc/rpccfg"
 
+	remote "github.com/ledgerwatch/erigon-lib/gointerfaces/remoteproto"
 	txpool "github.com/ledgerwatch/erigon-lib/gointerfaces/txpoolproto"
 	"github.com/ledgerwatch/erigon-lib/kv/kvcache"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/ledgerwatch/erigon/cmd/rpcdaemon/rpcdaemontest"
+	"github.com/ledgerwatch/erigon/core/rawdb"
 	"github.com/ledgerwatch/erigon/eth/filters"
 	"github.com/ledgerwatch/erigon/turbo/rpchelper"
 	"github.com/ledgerwatch/erigon/turbo/stages/mock"
@@ -100,3 +105,66 @@ func TestLogsSubscribeAndUnsubscribe_WithoutConcurrentMapIssue(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestChainStateSubscriptions(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	agg := m.HistoryV3Components()
+	stateCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	ff := rpchelper.New(m.Ctx, nil, nil, nil, func() {}, m.Log)
+	api := NewEthAPI(NewBaseApi(ff, stateCache, m.BlockReader, agg, false, rpccfg.DefaultEvmCallTimeout, m.Engine, m.Dirs), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, 128, log.New())
+
+	server := rpc.NewServer(50, false /* traceRequests */, false /* debugSingleRequest */, true /* disableStreaming */, log.New(), 100*time.Millisecond)
+	defer server.Stop()
+	require.NoError(t, server.RegisterName("eth", api))
+	require.NoError(t, server.RegisterName("eth", NewEthSyncingSubscriptionAPI(api)))
+	client := rpc.DialInProc(server, log.New())
+	defer client.Close()
+
+	syncingCh := make(chan SyncingResult)
+	syncingSub, err := client.Subscribe(m.Ctx, "eth", syncingCh, "syncing")
+	require.NoError(t, err)
+	defer syncingSub.Unsubscribe()
+	status, err := api.Syncing(m.Ctx)
+	require.NoError(t, err)
+	select {
+	case res := <-syncingCh:
+		require.Equal(t, status != false, res.Syncing)
+	case err := <-syncingSub.Err():
+		t.Fatal(err)
+	}
+
+	headsCh := make(chan ForkchoiceHeads)
+	headsSub, err := client.Subscribe(m.Ctx, "eth", headsCh, "forkchoiceHeads")
+	require.NoError(t, err)
+	defer headsSub.Unsubscribe()
+	nextHeads := func() ForkchoiceHeads {
+		select {
+		case res := <-headsCh:
+			return res
+		case err := <-headsSub.Err():
+			t.Fatal(err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("no forkchoiceHeads notification")
+		}
+		return ForkchoiceHeads{}
+	}
+	require.Equal(t, ForkchoiceHeads{}, nextHeads())
+
+	var finalized, safe libcommon.Hash
+	require.NoError(t, m.DB.Update(m.Ctx, func(tx kv.RwTx) (err error) {
+		if finalized, err = rawdb.ReadCanonicalHash(tx, 3); err != nil {
+			return err
+		}
+		if safe, err = rawdb.ReadCanonicalHash(tx, 5); err != nil {
+			return err
+		}
+		rawdb.WriteForkchoiceFinalized(tx, finalized)
+		rawdb.WriteForkchoiceSafe(tx, safe)
+		return nil
+	}))
+	ff.OnNewEvent(&remote.SubscribeReply{Type: remote.Event_CHAIN_STATE})
+	require.Equal(t, ForkchoiceHeads{
+		Safe:      &ForkchoiceHead{Hash: safe, Number: 5},
+		Finalized: &ForkchoiceHead{Hash: finalized, Number: 3},
+	}, nextHeads())
+}
@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/ledgerwatch/erigon-lib/kv/iter"
 	"github.com/ledgerwatch/erigon-lib/kv/order"
 	"github.com/ledgerwatch/erigon-lib/kv/rawdbv3"
+	libstate "github.com/ledgerwatch/erigon-lib/state"
 	"github.com/ledgerwatch/erigon/eth/ethutils"
 	bortypes "github.com/ledgerwatch/erigon/polygon/bor/types"
 
@@ -28,13 +30,16 @@ import (
 	"github.com/ledgerwatch/erigon/core/state"
 	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/crypto"
 	"github.com/ledgerwatch/erigon/eth/filters"
+	"github.com/ledgerwatch/erigon/rlp"
 	"github.com/ledgerwatch/erigon/rpc"
 	"github.com/ledgerwatch/erigon/turbo/rpchelper"
 	"github.com/ledgerwatch/erigon/turbo/transactions"
 )
 
-// getReceipts - checking in-mem cache, or else fallback to db, or else fallback to re-exec of block to re-gen receipts
+// getReceipts - checking in-mem cache, or else fallback to db, or else to the receipt domain, or else fallback to
+// re-exec of block to re-gen receipts
 func (api *BaseAPI) getReceipts(ctx context.Context, tx kv.Tx, block *types.Block, senders []common.Address) (types.Receipts, error) {
 	if receipts, ok := api.receiptsCache.Get(block.Hash()); ok {
 		return receipts, nil
@@ -45,6 +50,27 @@ func (api *BaseAPI) getReceipts(ctx context.Context, tx kv.Tx, block *types.Bloc
 		return receipts, nil
 	}
 
+	if ttx, ok := tx.(kv.TemporalTx); ok {
+		receipts, err := api.getReceiptsFromDomain(ttx, block, senders)
+		if err != nil {
+			return nil, err
+		}
+		if receipts != nil {
+			api.receiptsCache.Add(block.Hash(), receipts)
+			return receipts, nil
+		}
+	}
+
+	receipts, err := api.execReceipts(ctx, tx, block)
+	if err != nil {
+		return nil, err
+	}
+	api.receiptsCache.Add(block.Hash(), receipts)
+	return receipts, nil
+}
+
+// execReceipts - re-generates the receipts of the block by its re-execution
+func (api *BaseAPI) execReceipts(ctx context.Context, tx kv.Tx, block *types.Block) (types.Receipts, error) {
 	engine := api.engine()
 	chainConfig, err := api.chainConfig(ctx, tx)
 	if err != nil {
@@ -81,11 +107,112 @@ func (api *BaseAPI) getReceipts(ctx context.Context, tx kv.Tx, block *types.Bloc
 		receipt.BlockHash = block.Hash()
 		receipts[i] = receipt
 	}
+	return receipts, nil
+}
 
-	api.receiptsCache.Add(block.Hash(), receipts)
+// getReceiptsFromDomain - receipts of the block built from the receipt domain, without re-execution.
+// Returns nil if the receipt domain has no data for any of the transactions, then the block must be re-executed.
+func (api *BaseAPI) getReceiptsFromDomain(tx kv.TemporalTx, block *types.Block, senders []common.Address) (types.Receipts, error) {
+	minTxNum, err := rawdbv3.TxNums.Min(tx, block.NumberU64())
+	if err != nil {
+		return nil, err
+	}
+	// the system transaction at the beginning of the block resets the counters
+	prev, ok, err := receiptValueAfter(tx, minTxNum)
+	if err != nil || !ok {
+		return nil, err
+	}
+	receipts := make(types.Receipts, len(block.Transactions()))
+	for i := range receipts {
+		value, ok, err := receiptValueAfter(tx, minTxNum+1+uint64(i))
+		if err != nil || !ok || value.Logs == nil {
+			return nil, err
+		}
+		if receipts[i], err = receiptFromDomain(block, i, senders, prev, value); err != nil {
+			return nil, err
+		}
+		prev = value
+	}
 	return receipts, nil
 }
 
+// getReceiptFromDomain - receipt of a single transaction of the block built from the receipt domain.
+// Returns nil if the receipt domain has no data for the transaction, then the block must be re-executed.
+func (api *BaseAPI) getReceiptFromDomain(tx kv.TemporalTx, block *types.Block, txnIndex int) (*types.Receipt, error) {
+	minTxNum, err := rawdbv3.TxNums.Min(tx, block.NumberU64())
+	if err != nil {
+		return nil, err
+	}
+	txNum := minTxNum + 1 + uint64(txnIndex) // +1 for the system transaction at the beginning of the block
+	prev, ok, err := receiptValueAfter(tx, txNum-1)
+	if err != nil || !ok {
+		return nil, err
+	}
+	value, ok, err := receiptValueAfter(tx, txNum)
+	if err != nil || !ok || value.Logs == nil {
+		return nil, err
+	}
+	return receiptFromDomain(block, txnIndex, nil, prev, value)
+}
+
+// receiptValueAfter - rawdbv3.ReceiptValueAfter, history which is not available on this node (receipt domain files
+// start after the block) is no data, then the block must be re-executed
+func receiptValueAfter(tx kv.TemporalTx, txNum uint64) (rawdbv3.ReceiptValue, bool, error) {
+	value, ok, err := rawdbv3.ReceiptValueAfter(tx, txNum)
+	if errors.Is(err, libstate.ErrHistoryOutOfRange) {
+		return rawdbv3.ReceiptValue{}, false, nil
+	}
+	return value, ok, err
+}
+
+// receiptFromDomain - builds the receipt of the transaction from its value in the receipt domain and the value of
+// the previous transaction of the block
+func receiptFromDomain(block *types.Block, txnIndex int, senders []common.Address, prev, value rawdbv3.ReceiptValue) (*types.Receipt, error) {
+	txn := block.Transactions()[txnIndex]
+	var logs types.Logs
+	if err := rlp.DecodeBytes(value.Logs, &logs); err != nil {
+		return nil, fmt.Errorf("can't decode logs of txn %x: %w", txn.Hash(), err)
+	}
+	if uint64(len(logs)) != value.LogIndex-prev.LogIndex {
+		return nil, fmt.Errorf("receipt domain of txn %x has %d logs, expected %d", txn.Hash(), len(logs), value.LogIndex-prev.LogIndex)
+	}
+	if len(logs) == 0 {
+		logs = nil // as the execution leaves it
+	}
+	receipt := &types.Receipt{
+		Type:              txn.Type(),
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: value.CumulativeGasUsed,
+		TxHash:            txn.Hash(),
+		GasUsed:           value.CumulativeGasUsed - prev.CumulativeGasUsed,
+		BlockHash:         block.Hash(),
+		BlockNumber:       block.Number(),
+		TransactionIndex:  uint(txnIndex),
+		Logs:              logs,
+	}
+	if value.Failed {
+		receipt.Status = types.ReceiptStatusFailed
+	}
+	if txn.GetTo() == nil {
+		sender, ok := txn.GetSender()
+		if txnIndex < len(senders) {
+			sender, ok = senders[txnIndex], true
+		}
+		if ok {
+			receipt.ContractAddress = crypto.CreateAddress(sender, txn.GetNonce())
+		}
+	}
+	for i, l := range receipt.Logs {
+		l.Index = uint(prev.LogIndex) + uint(i)
+		l.TxHash = txn.Hash()
+		l.TxIndex = uint(txnIndex)
+		l.BlockNumber = block.NumberU64()
+		l.BlockHash = block.Hash()
+	}
+	receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+	return receipt, nil
+}
+
 // GetLogs implements eth_getLogs. Returns an array of logs matching a given filter object.
 func (api *APIImpl) GetLogs(ctx context.Context, crit filters.FilterCriteria) (types.Logs, error) {
 	var begin, end uint64
@@ -307,7 +434,7 @@ func applyFiltersV3(tx kv.TemporalTx, begin, end uint64, crit filters.FilterCrit
 		if out == nil {
 			out = addrBitmap
 		} else {
-			out = iter.Intersect[uint64](out, addrBitmap, -1)
+			out = iter.Intersect[uint64](out, addrBitmap, order.Asc, -1)
 		}
 	}
 	if out == nil {
@@ -425,7 +552,7 @@ func getTopicsBitmapV3(tx kv.TemporalTx, topics [][]common.Hash, from, to uint64
 			res = topicsUnion
 			continue
 		}
-		res = iter.Intersect[uint64](res, topicsUnion, -1)
+		res = iter.Intersect[uint64](res, topicsUnion, order.Asc, -1)
 	}
 	return res, nil
 }
@@ -498,6 +625,16 @@ func (api *APIImpl) GetTransactionReceipt(ctx context.Context, txnHash common.Ha
 			borTx = bortypes.NewBorTransaction()
 		}
 	}
+	if ttx, ok := tx.(kv.TemporalTx); ok && txn != nil {
+		receipt, err := api.getReceiptFromDomain(ttx, block, int(txnIndex))
+		if err != nil {
+			return nil, fmt.Errorf("getReceiptFromDomain error: %w", err)
+		}
+		if receipt != nil {
+			return ethutils.MarshalReceipt(receipt, txn, cc, block.HeaderNoCopy(), txnHash, true), nil
+		}
+	}
+
 	receipts, err := api.getReceipts(ctx, tx, block, block.Body().SendersFromTxs())
 	if err != nil {
 		return nil, fmt.Errorf("getReceipts error: %w", err)
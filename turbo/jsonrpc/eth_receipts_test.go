@@ -0,0 +1,122 @@
+package jsonrpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ledgerwatch/log/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon-lib/kv/rawdbv3"
+	libstate "github.com/ledgerwatch/erigon-lib/state"
+	"github.com/ledgerwatch/erigon/cmd/rpcdaemon/rpcdaemontest"
+	"github.com/ledgerwatch/erigon/eth/ethutils"
+	"github.com/ledgerwatch/erigon/rpc"
+)
+
+// receipts built from the receipt domain must be the same as the ones of the block re-execution
+func TestGetReceiptsFromDomain(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	api := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, 128, log.New())
+	ctx := context.Background()
+
+	tx, err := m.DB.BeginRo(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback()
+	chainConfig, err := api.chainConfig(ctx, tx)
+	require.NoError(t, err)
+
+	latest, err := api.BlockNumber(ctx)
+	require.NoError(t, err)
+	var checked int
+	for blockNum := uint64(1); blockNum <= uint64(latest); blockNum++ {
+		block, err := api.blockByNumberWithSenders(ctx, tx, blockNum)
+		require.NoError(t, err)
+		receipts, err := api.getReceiptsFromDomain(tx.(kv.TemporalTx), block, block.Body().SendersFromTxs())
+		require.NoError(t, err)
+		require.NotNil(t, receipts, "block %d", blockNum)
+		expected, err := api.execReceipts(ctx, tx, block)
+		require.NoError(t, err)
+		require.Len(t, receipts, len(expected))
+		for i, receipt := range receipts {
+			txn := block.Transactions()[i]
+			require.Equal(t,
+				ethutils.MarshalReceipt(expected[i], txn, chainConfig, block.HeaderNoCopy(), txn.Hash(), true),
+				ethutils.MarshalReceipt(receipt, txn, chainConfig, block.HeaderNoCopy(), txn.Hash(), true),
+				"block %d, txn %d", blockNum, i)
+			checked++
+		}
+
+		// a single receipt from the domain
+		blockReceipts, err := api.GetBlockReceipts(ctx, rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(blockNum)))
+		require.NoError(t, err)
+		for _, blockReceipt := range blockReceipts {
+			receipt, err := api.GetTransactionReceipt(ctx, blockReceipt["transactionHash"].(common.Hash))
+			require.NoError(t, err)
+			require.Equal(t, blockReceipt, receipt, "block %d", blockNum)
+		}
+	}
+	require.NotZero(t, checked)
+}
+
+// receiptHistoryFrom - receipt domain of a node whose receipt files start at txNum `from`, not at genesis
+type receiptHistoryFrom struct {
+	kv.TemporalTx
+	from uint64
+}
+
+func (tx receiptHistoryFrom) DomainGetAsOf(name kv.Domain, k, k2 []byte, ts uint64) ([]byte, bool, error) {
+	if name == kv.ReceiptDomain && ts < tx.from {
+		return nil, false, fmt.Errorf("%w: %s txNum=%d", libstate.ErrHistoryOutOfRange, name, ts)
+	}
+	return tx.TemporalTx.DomainGetAsOf(name, k, k2, ts)
+}
+
+// blocks older than the receipt domain files are re-executed
+func TestGetReceiptsBeforeReceiptFiles(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	api := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, 128, log.New())
+	ctx := context.Background()
+
+	roTx, err := m.DB.BeginRo(ctx)
+	require.NoError(t, err)
+	defer roTx.Rollback()
+	latest, err := api.BlockNumber(ctx)
+	require.NoError(t, err)
+	filesFrom := uint64(latest) / 2
+	from, err := rawdbv3.TxNums.Min(roTx, filesFrom)
+	require.NoError(t, err)
+	tx := receiptHistoryFrom{TemporalTx: roTx.(kv.TemporalTx), from: from}
+
+	var checked int
+	for blockNum := uint64(1); blockNum <= uint64(latest); blockNum++ {
+		block, err := api.blockByNumberWithSenders(ctx, tx, blockNum)
+		require.NoError(t, err)
+		fromDomain, err := api.getReceiptsFromDomain(tx, block, block.Body().SendersFromTxs())
+		require.NoError(t, err)
+		require.Equal(t, blockNum < filesFrom, fromDomain == nil, "block %d", blockNum)
+		if block.Transactions().Len() > 0 {
+			receipt, err := api.getReceiptFromDomain(tx, block, 0)
+			require.NoError(t, err)
+			require.Equal(t, blockNum < filesFrom, receipt == nil, "block %d", blockNum)
+		}
+
+		receipts, err := api.getReceipts(ctx, tx, block, block.Body().SendersFromTxs())
+		require.NoError(t, err)
+		expected, err := api.execReceipts(ctx, tx, block)
+		require.NoError(t, err)
+		require.Len(t, receipts, len(expected))
+		for i := range receipts {
+			require.Equal(t, expected[i].TxHash, receipts[i].TxHash, "block %d, txn %d", blockNum, i)
+			require.Equal(t, expected[i].CumulativeGasUsed, receipts[i].CumulativeGasUsed, "block %d, txn %d", blockNum, i)
+			require.Equal(t, len(expected[i].Logs), len(receipts[i].Logs), "block %d, txn %d", blockNum, i)
+			if blockNum < filesFrom {
+				checked++
+			}
+		}
+	}
+	require.NotZero(t, checked)
+}
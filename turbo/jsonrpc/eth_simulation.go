@@ -0,0 +1,602 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/holiman/uint256"
+	"github.com/ledgerwatch/log/v3"
+
+	"github.com/ledgerwatch/erigon-lib/chain"
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
+	"github.com/ledgerwatch/erigon-lib/kv"
+
+	"github.com/ledgerwatch/erigon/consensus/misc"
+	"github.com/ledgerwatch/erigon/core"
+	"github.com/ledgerwatch/erigon/core/state"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/core/vm"
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/rpc"
+	"github.com/ledgerwatch/erigon/turbo/adapter/ethapi"
+	"github.com/ledgerwatch/erigon/turbo/rpchelper"
+)
+
+const (
+	// maxSimulateBlocks is the maximum number of blocks (including the ones
+	// filled in between explicitly numbered blocks) a single eth_simulateV1 call may produce.
+	maxSimulateBlocks = 256
+	// simulateTimestampIncrement is the default difference between the timestamps of two simulated blocks.
+	simulateTimestampIncrement = 12
+)
+
+// Error codes defined by the execution-apis eth_simulateV1 specification.
+const (
+	simErrCodeNonceTooLow             = -38010
+	simErrCodeNonceTooHigh            = -38011
+	simErrCodeFeeCapTooLow            = -38012
+	simErrCodeIntrinsicGas            = -38013
+	simErrCodeInsufficientFunds       = -38014
+	simErrCodeBlockGasLimitReached    = -38015
+	simErrCodeBlockNumberInvalid      = -38020
+	simErrCodeBlockTimestampInvalid   = -38021
+	simErrCodeSenderIsNotEOA          = -38024
+	simErrCodeMaxInitCodeSizeExceeded = -38025
+	simErrCodeClientLimitExceeded     = -38026
+	simErrCodeInvalidParams           = -32602
+	simErrCodeInternalError           = -32603
+	simErrCodeReverted                = -32000
+	simErrCodeVMError                 = -32015
+)
+
+// transferLogAddress is the pseudo-address (ERC-7528) used as the emitter of the synthetic ETH transfer logs.
+var transferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+// transferTopic is the keccak256 of the ERC-20 `Transfer(address,address,uint256)` event signature.
+var transferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+// SimulationOpts are the inputs of eth_simulateV1.
+type SimulationOpts struct {
+	BlockStateCalls        []SimulatedBlock `json:"blockStateCalls"`
+	TraceTransfers         bool             `json:"traceTransfers"`
+	Validation             bool             `json:"validation"`
+	ReturnFullTransactions bool             `json:"returnFullTransactions"`
+}
+
+// SimulatedBlock is a batch of calls to be simulated sequentially within one block.
+type SimulatedBlock struct {
+	BlockOverrides *SimulatedBlockOverrides `json:"blockOverrides"`
+	StateOverrides *ethapi.StateOverrides   `json:"stateOverrides"`
+	Calls          []ethapi.CallArgs        `json:"calls"`
+}
+
+// SimulatedBlockOverrides is the set of header fields which can be overridden for a simulated block.
+type SimulatedBlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	PrevRandao    *common.Hash    `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// SimulatedCallResult is the outcome of a single call of a simulated block.
+type SimulatedCallResult struct {
+	ReturnData hexutility.Bytes `json:"returnData"`
+	Logs       []*types.Log     `json:"logs"`
+	GasUsed    hexutil.Uint64   `json:"gasUsed"`
+	Status     hexutil.Uint64   `json:"status"`
+	Error      *simulateError   `json:"error,omitempty"`
+}
+
+// simulateError is an API error carrying one of the eth_simulateV1 error codes.
+type simulateError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+func (e *simulateError) Error() string          { return e.Message }
+func (e *simulateError) ErrorCode() int         { return e.Code }
+func (e *simulateError) ErrorData() interface{} { return e.Data }
+
+// txValidationError maps consensus errors of a simulated call to the eth_simulateV1 error codes.
+func txValidationError(err error) *simulateError {
+	code := simErrCodeInternalError
+	switch {
+	case errors.Is(err, core.ErrNonceTooLow):
+		code = simErrCodeNonceTooLow
+	case errors.Is(err, core.ErrNonceTooHigh):
+		code = simErrCodeNonceTooHigh
+	case errors.Is(err, core.ErrFeeCapTooLow):
+		code = simErrCodeFeeCapTooLow
+	case errors.Is(err, core.ErrIntrinsicGas):
+		code = simErrCodeIntrinsicGas
+	case errors.Is(err, core.ErrInsufficientFunds):
+		code = simErrCodeInsufficientFunds
+	case errors.Is(err, core.ErrGasLimitReached):
+		code = simErrCodeBlockGasLimitReached
+	case errors.Is(err, core.ErrSenderNoEOA):
+		code = simErrCodeSenderIsNotEOA
+	case errors.Is(err, core.ErrMaxInitCodeSizeExceeded):
+		code = simErrCodeMaxInitCodeSizeExceeded
+	}
+	return &simulateError{Code: code, Message: err.Error()}
+}
+
+// SimulateV1 implements eth_simulateV1. It executes a sequence of blocks, each holding
+// several calls, on top of the given block, applying per-block header and state overrides.
+func (api *APIImpl) SimulateV1(ctx context.Context, opts SimulationOpts, blockNrOrHash *rpc.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, &simulateError{Code: simErrCodeInternalError, Message: "empty input"}
+	}
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	chainConfig, err := api.chainConfig(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockNum, hash, _, err := rpchelper.GetCanonicalBlockNumber(*blockNrOrHash, tx, api.filters)
+	if err != nil {
+		return nil, err
+	}
+	base, err := api._blockReader.Header(ctx, tx, hash, blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		return nil, fmt.Errorf("block %d(%x) not found", blockNum, hash)
+	}
+
+	blocks, err := sanitizeSimulatedBlocks(base, opts.BlockStateCalls)
+	if err != nil {
+		return nil, err
+	}
+
+	stateReader, err := rpchelper.CreateStateReader(ctx, tx, *blockNrOrHash, 0, api.filters, api.stateCache, chainConfig.ChainName)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func(start time.Time) { log.Trace("Executing EVM simulateV1 finished", "runtime", time.Since(start)) }(time.Now())
+
+	var cancel context.CancelFunc
+	if api.evmCallTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, api.evmCallTimeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	sim := &simulator{
+		api:         api,
+		tx:          tx,
+		chainConfig: chainConfig,
+		ibs:         state.New(stateReader),
+		base:        base,
+		opts:        &opts,
+		hashes:      make(map[uint64]common.Hash, len(blocks)),
+	}
+	evm := vm.NewEVM(core.NewEVMBlockContext(base, sim.getHash(ctx), api.engine(), nil /* author */), core.NewEVMTxContext(types.Message{}), sim.ibs, chainConfig, vm.Config{})
+	go func() {
+		<-ctx.Done()
+		evm.Cancel()
+	}()
+
+	results := make([]map[string]interface{}, 0, len(blocks))
+	parent := base
+	for _, block := range blocks {
+		result, header, err := sim.processBlock(ctx, evm, &block, parent)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+		parent = header
+	}
+	return results, nil
+}
+
+// sanitizeSimulatedBlocks assigns numbers and timestamps to the blocks which don't override them,
+// checks that both are strictly increasing and fills the gaps between explicitly numbered blocks with empty blocks.
+func sanitizeSimulatedBlocks(base *types.Header, blocks []SimulatedBlock) ([]SimulatedBlock, error) {
+	res := make([]SimulatedBlock, 0, len(blocks))
+	prevNumber := base.Number.Uint64()
+	prevTimestamp := base.Time
+	for _, block := range blocks {
+		if block.BlockOverrides == nil {
+			block.BlockOverrides = &SimulatedBlockOverrides{}
+		}
+		if block.BlockOverrides.Number == nil {
+			block.BlockOverrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(prevNumber + 1))
+		}
+		if !block.BlockOverrides.Number.ToInt().IsUint64() {
+			return nil, &simulateError{Code: simErrCodeBlockNumberInvalid, Message: "block number too large"}
+		}
+		number := block.BlockOverrides.Number.ToInt().Uint64()
+		if number <= prevNumber {
+			return nil, &simulateError{Code: simErrCodeBlockNumberInvalid, Message: fmt.Sprintf("block numbers must be in order: %d <= %d", number, prevNumber)}
+		}
+		if number-base.Number.Uint64() > maxSimulateBlocks {
+			return nil, &simulateError{Code: simErrCodeClientLimitExceeded, Message: "too many blocks"}
+		}
+		// Fill the gap with empty blocks
+		for n := prevNumber + 1; n < number; n++ {
+			prevTimestamp += simulateTimestampIncrement
+			t := hexutil.Uint64(prevTimestamp)
+			res = append(res, SimulatedBlock{BlockOverrides: &SimulatedBlockOverrides{
+				Number: (*hexutil.Big)(new(big.Int).SetUint64(n)),
+				Time:   &t,
+			}})
+		}
+		prevNumber = number
+
+		if block.BlockOverrides.Time == nil {
+			t := hexutil.Uint64(prevTimestamp + simulateTimestampIncrement)
+			block.BlockOverrides.Time = &t
+		}
+		timestamp := uint64(*block.BlockOverrides.Time)
+		if timestamp <= prevTimestamp {
+			return nil, &simulateError{Code: simErrCodeBlockTimestampInvalid, Message: fmt.Sprintf("block timestamps must be in order: %d <= %d", timestamp, prevTimestamp)}
+		}
+		prevTimestamp = timestamp
+		res = append(res, block)
+	}
+	return res, nil
+}
+
+// simulator holds the state shared by all blocks of one eth_simulateV1 call.
+type simulator struct {
+	api         *APIImpl
+	tx          kv.Tx
+	chainConfig *chain.Config
+	ibs         *state.IntraBlockState
+	base        *types.Header
+	opts        *SimulationOpts
+	hashes      map[uint64]common.Hash // hashes of the already simulated blocks
+}
+
+// getHash resolves BLOCKHASH for both the canonical chain and the already simulated blocks.
+func (s *simulator) getHash(ctx context.Context) func(uint64) common.Hash {
+	return func(n uint64) common.Hash {
+		if hash, ok := s.hashes[n]; ok {
+			return hash
+		}
+		if n > s.base.Number.Uint64() {
+			return common.Hash{}
+		}
+		hash, err := s.api._blockReader.CanonicalHash(ctx, s.tx, n)
+		if err != nil {
+			log.Debug("Can't get block hash by number", "number", n, "only-canonical", true)
+		}
+		return hash
+	}
+}
+
+// makeHeader builds the header of a simulated block from its parent and the block overrides.
+//
+// The state root of a simulated block is left empty, as computing it would require
+// committing the simulated state. Blob transactions are rejected, so the blob gas
+// fields only carry the values derived from the parent.
+func (s *simulator) makeHeader(overrides *SimulatedBlockOverrides, parent *types.Header) *types.Header {
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		UncleHash:  types.EmptyUncleHash,
+		Coinbase:   parent.Coinbase,
+		Difficulty: new(big.Int).Set(s.base.Difficulty),
+		Number:     new(big.Int).Set(overrides.Number.ToInt()),
+		GasLimit:   parent.GasLimit,
+		Time:       uint64(*overrides.Time),
+		MixDigest:  s.base.MixDigest,
+	}
+	if overrides.FeeRecipient != nil {
+		header.Coinbase = *overrides.FeeRecipient
+	}
+	if overrides.GasLimit != nil {
+		header.GasLimit = uint64(*overrides.GasLimit)
+	}
+	if overrides.PrevRandao != nil {
+		header.MixDigest = *overrides.PrevRandao
+	}
+	if s.chainConfig.IsLondon(header.Number.Uint64()) {
+		switch {
+		case overrides.BaseFeePerGas != nil:
+			header.BaseFee = new(big.Int).Set(overrides.BaseFeePerGas.ToInt())
+		case s.opts.Validation:
+			header.BaseFee = misc.CalcBaseFee(s.chainConfig, parent)
+		default:
+			header.BaseFee = new(big.Int)
+		}
+	}
+	if s.chainConfig.IsCancun(header.Time) {
+		excessBlobGas := misc.CalcExcessBlobGas(s.chainConfig, parent)
+		header.ExcessBlobGas = &excessBlobGas
+		header.BlobGasUsed = new(uint64)
+		header.ParentBeaconBlockRoot = &common.Hash{}
+	}
+	return header
+}
+
+// processBlock executes the calls of a single simulated block and returns its RPC representation together with its header.
+func (s *simulator) processBlock(ctx context.Context, evm *vm.EVM, block *SimulatedBlock, parent *types.Header) (map[string]interface{}, *types.Header, error) {
+	header := s.makeHeader(block.BlockOverrides, parent)
+	if block.StateOverrides != nil {
+		if err := block.StateOverrides.Override(s.ibs); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var tracer *transferTracer
+	vmConfig := vm.Config{NoBaseFee: !s.opts.Validation}
+	if s.opts.TraceTransfers {
+		tracer = &transferTracer{ibs: s.ibs}
+		vmConfig.Debug = true
+		vmConfig.Tracer = tracer
+	}
+	blockCtx := core.NewEVMBlockContext(header, s.getHash(ctx), s.api.engine(), &header.Coinbase)
+	rules := s.chainConfig.Rules(blockCtx.BlockNumber, blockCtx.Time)
+	evm.ResetBetweenBlocks(blockCtx, core.NewEVMTxContext(types.Message{}), s.ibs, vmConfig, rules)
+
+	var (
+		gp          = new(core.GasPool).AddGas(header.GasLimit).AddBlobGas(s.chainConfig.GetMaxBlobGasPerBlock())
+		txs         = make(types.Transactions, 0, len(block.Calls))
+		receipts    = make(types.Receipts, 0, len(block.Calls))
+		callResults = make([]SimulatedCallResult, 0, len(block.Calls))
+		gasUsed     uint64
+		blobGasUsed uint64
+	)
+	for i := range block.Calls {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, fmt.Errorf("execution aborted (timeout = %v)", s.api.evmCallTimeout)
+		}
+		txn, msg, err := s.toTransaction(&block.Calls[i], header, gasUsed)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.ibs.SetTxContext(txn.Hash(), common.Hash{}, i)
+		if tracer != nil {
+			tracer.reset(txn.Hash())
+		}
+		evm.Reset(core.NewEVMTxContext(msg), s.ibs)
+		result, err := core.ApplyMessage(evm, msg, gp, true /* refunds */, false /* gasBailout */)
+		if err != nil {
+			return nil, nil, txValidationError(err)
+		}
+		if evm.Cancelled() {
+			return nil, nil, fmt.Errorf("execution aborted (timeout = %v)", s.api.evmCallTimeout)
+		}
+		if err = s.ibs.FinalizeTx(rules, state.NewNoopWriter()); err != nil {
+			return nil, nil, err
+		}
+		gasUsed += result.UsedGas
+		blobGasUsed += txn.GetBlobGas()
+
+		logs := s.ibs.GetLogs(txn.Hash())
+		if tracer != nil {
+			logs = tracer.mergeLogs(logs)
+		}
+		receipt := &types.Receipt{
+			Type:              txn.Type(),
+			CumulativeGasUsed: gasUsed,
+			TxHash:            txn.Hash(),
+			GasUsed:           result.UsedGas,
+			Logs:              logs,
+			BlockNumber:       header.Number,
+			TransactionIndex:  uint(i),
+		}
+		if msg.To() == nil {
+			receipt.ContractAddress = crypto.CreateAddress(msg.From(), msg.Nonce())
+		}
+
+		callResult := SimulatedCallResult{
+			ReturnData: result.Return(),
+			GasUsed:    hexutil.Uint64(result.UsedGas),
+			Logs:       logs,
+		}
+		if result.Failed() {
+			receipt.Status = types.ReceiptStatusFailed
+			if errors.Is(result.Err, vm.ErrExecutionReverted) {
+				revertErr := ethapi.NewRevertError(result)
+				callResult.Error = &simulateError{Code: simErrCodeReverted, Message: revertErr.Error(), Data: revertErr.ErrorData().(string)}
+			} else {
+				callResult.Error = &simulateError{Code: simErrCodeVMError, Message: result.Err.Error()}
+			}
+		} else {
+			receipt.Status = types.ReceiptStatusSuccessful
+			callResult.Status = hexutil.Uint64(types.ReceiptStatusSuccessful)
+		}
+		receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+
+		txs = append(txs, txn)
+		receipts = append(receipts, receipt)
+		callResults = append(callResults, callResult)
+	}
+
+	header.GasUsed = gasUsed
+	if header.BlobGasUsed != nil {
+		*header.BlobGasUsed = blobGasUsed
+	}
+	var withdrawals []*types.Withdrawal
+	if s.chainConfig.IsShanghai(header.Time) {
+		withdrawals = []*types.Withdrawal{}
+	}
+	// The state root is left empty: computing it would require building the commitment for the simulated state.
+	b := types.NewBlock(header, txs, nil /* uncles */, receipts, withdrawals, nil /* requests */)
+	blockHash := b.Hash()
+	s.hashes[b.NumberU64()] = blockHash
+
+	var logIndex uint
+	for _, receipt := range receipts {
+		receipt.BlockHash = blockHash
+		for _, l := range receipt.Logs {
+			l.BlockHash = blockHash
+			l.BlockNumber = b.NumberU64()
+			l.Index = logIndex
+			logIndex++
+		}
+	}
+
+	result, err := ethapi.RPCMarshalBlock(b, true, s.opts.ReturnFullTransactions, map[string]interface{}{"calls": callResults})
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, b.Header(), nil
+}
+
+// toTransaction fills in the defaults of a simulated call and converts it to the
+// (unsigned) transaction included in the simulated block and the message to execute.
+func (s *simulator) toTransaction(args *ethapi.CallArgs, header *types.Header, gasUsed uint64) (types.Transaction, types.Message, error) {
+	if args.MaxFeePerBlobGas != nil {
+		return nil, types.Message{}, &simulateError{Code: simErrCodeInvalidParams, Message: "blob transactions are not supported by eth_simulateV1"}
+	}
+	from := common.Address{}
+	if args.From != nil {
+		from = *args.From
+	}
+	if args.Nonce == nil {
+		nonce := hexutil.Uint64(s.ibs.GetNonce(from))
+		args.Nonce = &nonce
+	}
+	remaining := header.GasLimit - gasUsed
+	if args.Gas == nil {
+		gas := hexutil.Uint64(remaining)
+		if s.api.GasCap != 0 && s.api.GasCap < remaining {
+			gas = hexutil.Uint64(s.api.GasCap)
+		}
+		args.Gas = &gas
+	}
+	if uint64(*args.Gas) > remaining {
+		return nil, types.Message{}, &simulateError{Code: simErrCodeBlockGasLimitReached, Message: fmt.Sprintf("block gas limit reached: %d > %d", uint64(*args.Gas), remaining)}
+	}
+
+	baseFee := new(uint256.Int)
+	if s.opts.Validation && header.BaseFee != nil {
+		baseFee.SetFromBig(header.BaseFee)
+	}
+	m, err := args.ToMessage(s.api.GasCap, baseFee)
+	if err != nil {
+		return nil, types.Message{}, err
+	}
+	msg := types.NewMessage(from, m.To(), uint64(*args.Nonce), m.Value(), m.Gas(), m.GasPrice(), m.FeeCap(), m.Tip(), m.Data(), m.AccessList(), s.opts.Validation /* checkNonce */, false /* isFree */, nil /* maxFeePerBlobGas */)
+
+	chainID, _ := uint256.FromBig(s.chainConfig.ChainID)
+	txn := &types.DynamicFeeTransaction{
+		CommonTx: types.CommonTx{
+			Nonce: msg.Nonce(),
+			Gas:   msg.Gas(),
+			To:    msg.To(),
+			Value: msg.Value(),
+			Data:  msg.Data(),
+		},
+		ChainID:    chainID,
+		Tip:        msg.Tip(),
+		FeeCap:     msg.FeeCap(),
+		AccessList: msg.AccessList(),
+	}
+	txn.SetSender(from)
+	return txn, msg, nil
+}
+
+// transferTracer records the ETH value transfers of a transaction as ERC-20 like
+// Transfer logs emitted by transferLogAddress, as requested by eth_simulateV1 traceTransfers.
+type transferTracer struct {
+	ibs       *state.IntraBlockState
+	txHash    common.Hash
+	transfers []transferLog
+	frames    []int // number of transfers recorded when each call frame was entered
+}
+
+// transferLog is a synthetic log together with the number of regular logs emitted before it.
+type transferLog struct {
+	log *types.Log
+	pos int
+}
+
+func (t *transferTracer) reset(txHash common.Hash) {
+	t.txHash = txHash
+	t.transfers = t.transfers[:0]
+	t.frames = t.frames[:0]
+}
+
+func (t *transferTracer) captureTransfer(from, to common.Address, value *uint256.Int) {
+	t.frames = append(t.frames, len(t.transfers))
+	if value == nil || value.IsZero() {
+		return
+	}
+	t.transfers = append(t.transfers, transferLog{
+		log: &types.Log{
+			Address: transferLogAddress,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    common.BigToHash(value.ToBig()).Bytes(),
+			TxHash:  t.txHash,
+		},
+		pos: len(t.ibs.GetLogs(t.txHash)),
+	})
+}
+
+func (t *transferTracer) captureExit(err error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	start := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if err != nil {
+		t.transfers = t.transfers[:start]
+	}
+}
+
+// mergeLogs interleaves the recorded transfers with the regular logs of the transaction.
+func (t *transferTracer) mergeLogs(logs []*types.Log) []*types.Log {
+	if len(t.transfers) == 0 {
+		return logs
+	}
+	merged := make([]*types.Log, 0, len(logs)+len(t.transfers))
+	var j int
+	for i := 0; i <= len(logs); i++ {
+		for ; j < len(t.transfers) && t.transfers[j].pos == i; j++ {
+			l := t.transfers[j].log
+			l.TxIndex = uint(t.ibs.TxIndex())
+			merged = append(merged, l)
+		}
+		if i < len(logs) {
+			merged = append(merged, logs[i])
+		}
+	}
+	return merged
+}
+
+func (t *transferTracer) CaptureTxStart(gasLimit uint64) {}
+func (t *transferTracer) CaptureTxEnd(restGas uint64)    {}
+func (t *transferTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	t.captureTransfer(from, to, value)
+}
+func (t *transferTracer) CaptureEnd(output []byte, usedGas uint64, err error) {
+	t.captureExit(err)
+}
+func (t *transferTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, precompile bool, create bool, input []byte, gas uint64, value *uint256.Int, code []byte) {
+	if typ == vm.DELEGATECALL {
+		value = nil
+	}
+	t.captureTransfer(from, to, value)
+}
+func (t *transferTracer) CaptureExit(output []byte, usedGas uint64, err error) {
+	t.captureExit(err)
+}
+func (t *transferTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+}
+func (t *transferTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+}
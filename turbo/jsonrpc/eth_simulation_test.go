@@ -0,0 +1,105 @@
+package jsonrpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ledgerwatch/erigon-lib/common/datadir"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/kv/kvcache"
+	"github.com/ledgerwatch/log/v3"
+
+	"github.com/ledgerwatch/erigon/accounts/abi/bind/backends"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/params"
+	"github.com/ledgerwatch/erigon/rpc/rpccfg"
+	"github.com/ledgerwatch/erigon/turbo/adapter/ethapi"
+)
+
+func TestSimulateV1(t *testing.T) {
+	var (
+		key, _   = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		key1, _  = crypto.HexToECDSA("49a7b37aa6f6645917e7b807e9d1c00d4fa71f18343b0d4122a4d2df64dd6fee")
+		address  = crypto.PubkeyToAddress(key.PublicKey)
+		address1 = crypto.PubkeyToAddress(key1.PublicKey)
+		gspec    = &types.Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				address: {Balance: big.NewInt(9000000000000000000)},
+			},
+			GasLimit: 10000000,
+		}
+		ctx = context.Background()
+	)
+	contractBackend := backends.NewTestSimulatedBackendWithConfig(t, gspec.Alloc, gspec.Config, gspec.GasLimit)
+	defer contractBackend.Close()
+	contractBackend.Commit()
+
+	stateCache := kvcache.New(kvcache.DefaultCoherentConfig)
+	api := NewEthAPI(NewBaseApi(nil, stateCache, contractBackend.BlockReader(), contractBackend.Agg(), false, rpccfg.DefaultEvmCallTimeout, contractBackend.Engine(),
+		datadir.New(t.TempDir())), contractBackend.DB(), nil, nil, nil, 5000000, 100_000, false, 100_000, 128, log.New())
+
+	value := (*hexutil.Big)(big.NewInt(1000))
+	transfer := ethapi.CallArgs{From: &address, To: &address1, Value: value}
+	number := (*hexutil.Big)(big.NewInt(4))
+
+	res, err := api.SimulateV1(ctx, SimulationOpts{
+		TraceTransfers: true,
+		BlockStateCalls: []SimulatedBlock{
+			{Calls: []ethapi.CallArgs{transfer, transfer}},
+			{BlockOverrides: &SimulatedBlockOverrides{Number: number}, Calls: []ethapi.CallArgs{transfer}},
+		},
+	}, nil)
+	require.NoError(t, err)
+	// block 2 is the first simulated block, block 3 fills the gap up to the explicitly numbered block 4
+	require.Len(t, res, 3)
+	require.Equal(t, (*hexutil.Big)(big.NewInt(2)), res[0]["number"])
+	require.Equal(t, (*hexutil.Big)(big.NewInt(3)), res[1]["number"])
+	require.Equal(t, (*hexutil.Big)(big.NewInt(4)), res[2]["number"])
+	require.Equal(t, res[0]["hash"], res[1]["parentHash"])
+
+	calls := res[0]["calls"].([]SimulatedCallResult)
+	require.Len(t, calls, 2)
+	for i, call := range calls {
+		require.Nil(t, call.Error)
+		require.Equal(t, hexutil.Uint64(types.ReceiptStatusSuccessful), call.Status)
+		require.Equal(t, hexutil.Uint64(params.TxGas), call.GasUsed)
+		require.Len(t, call.Logs, 1)
+		require.Equal(t, transferLogAddress, call.Logs[0].Address)
+		require.Equal(t, uint(i), call.Logs[0].Index)
+	}
+	require.Empty(t, res[1]["calls"])
+	require.Len(t, res[2]["calls"], 1)
+
+	_, err = api.SimulateV1(ctx, SimulationOpts{
+		BlockStateCalls: []SimulatedBlock{
+			{BlockOverrides: &SimulatedBlockOverrides{Number: number}},
+			{BlockOverrides: &SimulatedBlockOverrides{Number: number}},
+		},
+	}, nil)
+	var simErr *simulateError
+	require.ErrorAs(t, err, &simErr)
+	require.Equal(t, simErrCodeBlockNumberInvalid, simErr.Code)
+
+	tooHigh := hexutil.Uint64(100)
+	_, err = api.SimulateV1(ctx, SimulationOpts{
+		Validation: true,
+		BlockStateCalls: []SimulatedBlock{
+			{Calls: []ethapi.CallArgs{{From: &address, To: &address1, Nonce: &tooHigh}}},
+		},
+	}, nil)
+	require.ErrorAs(t, err, &simErr)
+	require.Equal(t, simErrCodeNonceTooHigh, simErr.Code)
+
+	_, err = api.SimulateV1(ctx, SimulationOpts{
+		BlockStateCalls: []SimulatedBlock{
+			{Calls: []ethapi.CallArgs{{From: &address, To: &address1, MaxFeePerBlobGas: value}}},
+		},
+	}, nil)
+	require.ErrorAs(t, err, &simErr)
+	require.Equal(t, simErrCodeInvalidParams, simErr.Code)
+}
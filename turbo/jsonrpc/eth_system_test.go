@@ -7,12 +7,16 @@ import (
 	"testing"
 
 	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 
+	"github.com/ledgerwatch/erigon/consensus/misc"
 	"github.com/ledgerwatch/erigon/core"
 	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/crypto"
 	"github.com/ledgerwatch/erigon/params"
+	"github.com/ledgerwatch/erigon/rpc"
 	"github.com/ledgerwatch/erigon/turbo/stages/mock"
 	"github.com/ledgerwatch/log/v3"
 )
@@ -87,3 +91,69 @@ func createGasPriceTestKV(t *testing.T, chainSize int) *mock.MockSentry {
 
 	return m
 }
+
+func TestBlobBaseFee(t *testing.T) {
+	// there is no blob gas market before Cancun
+	m := createGasPriceTestKV(t, 3)
+	defer m.DB.Close()
+	eth := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, 128, log.New())
+	ctx := context.Background()
+
+	blobBaseFee, err := eth.BlobBaseFee(ctx)
+	require.NoError(t, err)
+	require.Zero(t, blobBaseFee.ToInt().Sign())
+
+	history, err := eth.FeeHistory(ctx, 2, rpc.LatestBlockNumber, nil)
+	require.NoError(t, err)
+	require.Len(t, history.BlobBaseFee, 3)
+	require.Len(t, history.BlobGasUsedRatio, 2)
+	for _, fee := range history.BlobBaseFee {
+		require.Zero(t, fee.ToInt().Sign())
+	}
+}
+
+func TestBlobBaseFeeCancun(t *testing.T) {
+	var (
+		key, _        = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr          = crypto.PubkeyToAddress(key.PublicKey)
+		config        = *params.TestChainConfig
+		excessBlobGas = uint64(10_000_000)
+	)
+	config.LondonBlock = big.NewInt(0)
+	config.ShanghaiTime = big.NewInt(0)
+	config.CancunTime = big.NewInt(0)
+	blobGasUsed := config.GetMaxBlobGasPerBlock()
+	// the chain generator doesn't produce post-Shanghai blocks, so the Cancun header is the genesis one
+	gspec := &types.Genesis{
+		Config:        &config,
+		Alloc:         types.GenesisAlloc{addr: {Balance: big.NewInt(math.MaxInt64)}},
+		ExcessBlobGas: &excessBlobGas,
+		BlobGasUsed:   &blobGasUsed,
+	}
+	m := mock.MockWithGenesis(t, gspec, key, false)
+	defer m.DB.Close()
+	eth := NewEthAPI(newBaseApiForTest(m), m.DB, nil, nil, nil, 5000000, 100_000, false, 100_000, 128, log.New())
+	ctx := context.Background()
+
+	price := func(excessBlobGas uint64) *big.Int {
+		fee, err := misc.GetBlobGasPrice(&config, excessBlobGas)
+		require.NoError(t, err)
+		return fee.ToBig()
+	}
+	// a full block raises the excess blob gas of the next one by the target
+	nextExcessBlobGas := excessBlobGas + config.GetTargetBlobGasPerBlock()
+	// the excess is large enough for the fees to be above the minimum and to differ between the blocks
+	require.True(t, price(excessBlobGas).Cmp(big.NewInt(1)) > 0)
+	require.True(t, price(nextExcessBlobGas).Cmp(price(excessBlobGas)) > 0)
+
+	blobBaseFee, err := eth.BlobBaseFee(ctx)
+	require.NoError(t, err)
+	require.Equal(t, price(nextExcessBlobGas), blobBaseFee.ToInt())
+
+	history, err := eth.FeeHistory(ctx, 1, rpc.LatestBlockNumber, nil)
+	require.NoError(t, err)
+	require.Equal(t, []float64{1}, history.BlobGasUsedRatio)
+	require.Len(t, history.BlobBaseFee, 2)
+	require.Equal(t, price(excessBlobGas), history.BlobBaseFee[0].ToInt())
+	require.Equal(t, price(nextExcessBlobGas), history.BlobBaseFee[1].ToInt())
+}
@@ -6,12 +6,15 @@ import (
 	"math/big"
 
 	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 
 	"github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon/core"
 	"github.com/ledgerwatch/erigon/core/rawdb"
 	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/eth/ethutils"
+	"github.com/ledgerwatch/erigon/eth/filters"
 	"github.com/ledgerwatch/erigon/rpc"
 	"github.com/ledgerwatch/erigon/turbo/adapter/ethapi"
 	"github.com/ledgerwatch/erigon/turbo/rpchelper"
@@ -20,17 +23,38 @@ import (
 type GraphQLAPI interface {
 	GetBlockDetails(ctx context.Context, number rpc.BlockNumber) (map[string]interface{}, error)
 	GetChainID(ctx context.Context) (*big.Int, error)
+	GetTransactionBlockNumber(ctx context.Context, hash common.Hash) (*uint64, error)
+	GetPendingBlock(ctx context.Context) (*types.Block, error)
+
+	// Account state
+	GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error)
+	GetTransactionCount(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Uint64, error)
+	GetCode(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (hexutility.Bytes, error)
+	GetStorageAt(ctx context.Context, address common.Address, index string, blockNrOrHash rpc.BlockNumberOrHash) (string, error)
+
+	GetLogs(ctx context.Context, crit filters.FilterCriteria) (types.Logs, error)
+	Call(ctx context.Context, args ethapi.CallArgs, blockNrOrHash rpc.BlockNumberOrHash) (*core.ExecutionResult, error)
+	EstimateGas(ctx context.Context, args *ethapi.CallArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error)
+
+	GasPrice(ctx context.Context) (*hexutil.Big, error)
+	MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error)
+	Syncing(ctx context.Context) (interface{}, error)
+	SendRawTransaction(ctx context.Context, encodedTx hexutility.Bytes) (common.Hash, error)
 }
 
+// GraphQLAPIImpl serves the GraphQL resolvers. Everything that has an eth_ equivalent is delegated to the eth API,
+// so both endpoints share limits (gas cap, return data limit) and results.
 type GraphQLAPIImpl struct {
 	*BaseAPI
-	db kv.RoDB
+	db  kv.RoDB
+	eth *APIImpl
 }
 
-func NewGraphQLAPI(base *BaseAPI, db kv.RoDB) *GraphQLAPIImpl {
+func NewGraphQLAPI(base *BaseAPI, db kv.RoDB, eth *APIImpl) *GraphQLAPIImpl {
 	return &GraphQLAPIImpl{
 		BaseAPI: base,
 		db:      db,
+		eth:     eth,
 	}
 }
 
@@ -140,3 +164,92 @@ func (api *GraphQLAPIImpl) delegateGetBlockByNumber(tx kv.Tx, b *types.Block, nu
 
 	return response, err
 }
+
+// GetTransactionBlockNumber returns the number of the canonical block including the transaction, nil if it is not mined
+func (api *GraphQLAPIImpl) GetTransactionBlockNumber(ctx context.Context, hash common.Hash) (*uint64, error) {
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	blockNum, ok, err := api.txnLookup(ctx, tx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &blockNum, nil
+}
+
+// GetPendingBlock returns the block being built by the local miner, nil if there is none
+func (api *GraphQLAPIImpl) GetPendingBlock(_ context.Context) (*types.Block, error) {
+	if api.filters == nil {
+		return nil, nil
+	}
+	return api.pendingBlock(), nil
+}
+
+func (api *GraphQLAPIImpl) GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error) {
+	return api.eth.GetBalance(ctx, address, blockNrOrHash)
+}
+
+func (api *GraphQLAPIImpl) GetTransactionCount(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Uint64, error) {
+	return api.eth.GetTransactionCount(ctx, address, blockNrOrHash)
+}
+
+func (api *GraphQLAPIImpl) GetCode(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (hexutility.Bytes, error) {
+	return api.eth.GetCode(ctx, address, blockNrOrHash)
+}
+
+func (api *GraphQLAPIImpl) GetStorageAt(ctx context.Context, address common.Address, index string, blockNrOrHash rpc.BlockNumberOrHash) (string, error) {
+	return api.eth.GetStorageAt(ctx, address, index, blockNrOrHash)
+}
+
+func (api *GraphQLAPIImpl) GetLogs(ctx context.Context, crit filters.FilterCriteria) (types.Logs, error) {
+	return api.eth.GetLogs(ctx, crit)
+}
+
+// Call executes the call like eth_call does, but returns the whole execution result:
+// GraphQL reports failed calls with their status and gas used instead of an error.
+func (api *GraphQLAPIImpl) Call(ctx context.Context, args ethapi.CallArgs, blockNrOrHash rpc.BlockNumberOrHash) (*core.ExecutionResult, error) {
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if args.Gas == nil || uint64(*args.Gas) == 0 {
+		gasCap := hexutil.Uint64(api.eth.GasCap)
+		args.Gas = &gasCap
+	}
+	result, err := api.eth.doCall(ctx, tx, args, blockNrOrHash, nil)
+	if err != nil {
+		return nil, err
+	}
+	if result != nil && len(result.ReturnData) > api.eth.ReturnDataLimit {
+		return nil, fmt.Errorf("call returned result on length %d exceeding --rpc.returndata.limit %d", len(result.ReturnData), api.eth.ReturnDataLimit)
+	}
+	return result, nil
+}
+
+func (api *GraphQLAPIImpl) EstimateGas(ctx context.Context, args *ethapi.CallArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
+	return api.eth.EstimateGas(ctx, args, blockNrOrHash)
+}
+
+func (api *GraphQLAPIImpl) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	return api.eth.GasPrice(ctx)
+}
+
+func (api *GraphQLAPIImpl) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	return api.eth.MaxPriorityFeePerGas(ctx)
+}
+
+func (api *GraphQLAPIImpl) Syncing(ctx context.Context) (interface{}, error) {
+	return api.eth.Syncing(ctx)
+}
+
+func (api *GraphQLAPIImpl) SendRawTransaction(ctx context.Context, encodedTx hexutility.Bytes) (common.Hash, error) {
+	return api.eth.SendRawTransaction(ctx, encodedTx)
+}
@@ -0,0 +1,291 @@
+package jsonrpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/kv"
+)
+
+// Otterscan2API - token-aware search endpoints of the "ots2" namespace. Searches are built on LogAddrIdx
+// and LogTopicIdx and follow the paginated model of ots_searchTransactionsBefore/After: `blockNum` is excluded,
+// 0 means "from the head" for Before and "from genesis" for After, and a page may be a bit bigger than
+// pageSize because the last found block is always drained.
+type Otterscan2API interface {
+	SearchTokenTransfersBefore(ctx context.Context, addr common.Address, blockNum uint64, pageSize uint16) (*TokenTransfersResult, error)
+	SearchTokenTransfersAfter(ctx context.Context, addr common.Address, blockNum uint64, pageSize uint16) (*TokenTransfersResult, error)
+	SearchTokenHoldingsBefore(ctx context.Context, holder common.Address, blockNum uint64, pageSize uint16) (*TokenHoldingsResult, error)
+	SearchTokenHoldingsAfter(ctx context.Context, holder common.Address, blockNum uint64, pageSize uint16) (*TokenHoldingsResult, error)
+	SearchTokenHoldersBefore(ctx context.Context, token common.Address, blockNum uint64, pageSize uint16) (*TokenHoldersResult, error)
+	SearchTokenHoldersAfter(ctx context.Context, token common.Address, blockNum uint64, pageSize uint16) (*TokenHoldersResult, error)
+}
+
+const (
+	TokenStandardERC20   = "ERC20"
+	TokenStandardERC721  = "ERC721"
+	TokenStandardERC1155 = "ERC1155"
+)
+
+// TokenTransfer - one token movement; ERC-1155 batch transfers are split into one TokenTransfer per token id
+type TokenTransfer struct {
+	BlockNumber hexutil.Uint64  `json:"blockNumber"`
+	Timestamp   hexutil.Uint64  `json:"timestamp"`
+	TxHash      common.Hash     `json:"transactionHash"`
+	TxIndex     hexutil.Uint64  `json:"transactionIndex"`
+	Token       common.Address  `json:"token"`
+	Standard    string          `json:"standard"`
+	Operator    *common.Address `json:"operator,omitempty"` // ERC1155 only
+	From        common.Address  `json:"from"`
+	To          common.Address  `json:"to"`
+	TokenID     *hexutil.Big    `json:"tokenId,omitempty"` // ERC721 and ERC1155
+	Value       *hexutil.Big    `json:"value,omitempty"`   // ERC20 and ERC1155
+}
+
+type TokenTransfersResult struct {
+	Transfers []*TokenTransfer `json:"transfers"`
+	FirstPage bool             `json:"firstPage"`
+	LastPage  bool             `json:"lastPage"`
+}
+
+// TokenHolding - token received by the holder. Balances are not tracked: the holder may have transferred
+// the tokens away since, use balanceOf of the token to get the balance.
+type TokenHolding struct {
+	Token       common.Address `json:"token"`
+	Standard    string         `json:"standard"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"` // block of the latest transfer to the holder for Before, of the earliest one for After
+}
+
+type TokenHoldingsResult struct {
+	Holdings  []*TokenHolding `json:"holdings"`
+	FirstPage bool            `json:"firstPage"`
+	LastPage  bool            `json:"lastPage"`
+}
+
+// TokenHolder - receiver of the token, see TokenHolding about balances
+type TokenHolder struct {
+	Holder      common.Address `json:"holder"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"` // block of the latest transfer to the holder for Before, of the earliest one for After
+}
+
+type TokenHoldersResult struct {
+	Holders   []*TokenHolder `json:"holders"`
+	FirstPage bool           `json:"firstPage"`
+	LastPage  bool           `json:"lastPage"`
+}
+
+// tokenTransferCollector - takes the transfers found by the search, returns true if the transfer is counted into the page
+type tokenTransferCollector func(ctx context.Context, tx kv.TemporalTx, t *TokenTransfer) (bool, error)
+
+// SearchTokenTransfersBefore - ERC-20/721/1155 transfers from or to the address, sorted descending
+func (api *OtterscanAPIImpl) SearchTokenTransfersBefore(ctx context.Context, addr common.Address, blockNum uint64, pageSize uint16) (*TokenTransfersResult, error) {
+	res := TokenTransfersResult{Transfers: []*TokenTransfer{}}
+	firstPage, lastPage, err := api.searchTokenTransfers(ctx, addr, blockNum, true, pageSize, res.collector(addr))
+	if err != nil {
+		return nil, err
+	}
+	res.FirstPage, res.LastPage = firstPage, lastPage
+	return &res, nil
+}
+
+// SearchTokenTransfersAfter - ERC-20/721/1155 transfers from or to the address, sorted descending
+func (api *OtterscanAPIImpl) SearchTokenTransfersAfter(ctx context.Context, addr common.Address, blockNum uint64, pageSize uint16) (*TokenTransfersResult, error) {
+	res := TokenTransfersResult{Transfers: []*TokenTransfer{}}
+	firstPage, lastPage, err := api.searchTokenTransfers(ctx, addr, blockNum, false, pageSize, res.collector(addr))
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(res.Transfers)
+	res.FirstPage, res.LastPage = firstPage, lastPage
+	return &res, nil
+}
+
+func (res *TokenTransfersResult) collector(addr common.Address) tokenTransferCollector {
+	return func(_ context.Context, _ kv.TemporalTx, t *TokenTransfer) (bool, error) {
+		if t.From != addr && t.To != addr {
+			return false, nil
+		}
+		res.Transfers = append(res.Transfers, t)
+		return true, nil
+	}
+}
+
+// SearchTokenHoldingsBefore - tokens received by the holder, sorted descending by the block of the transfer
+func (api *OtterscanAPIImpl) SearchTokenHoldingsBefore(ctx context.Context, holder common.Address, blockNum uint64, pageSize uint16) (*TokenHoldingsResult, error) {
+	receipts, firstPage, lastPage, err := api.searchFirstReceipts(ctx, nil, []common.Address{holder}, blockNum, true, pageSize, receivedBy(holder))
+	if err != nil {
+		return nil, err
+	}
+	return newTokenHoldingsResult(receipts, firstPage, lastPage), nil
+}
+
+// SearchTokenHoldingsAfter - tokens received by the holder, sorted descending by the block of the transfer
+func (api *OtterscanAPIImpl) SearchTokenHoldingsAfter(ctx context.Context, holder common.Address, blockNum uint64, pageSize uint16) (*TokenHoldingsResult, error) {
+	receipts, firstPage, lastPage, err := api.searchFirstReceipts(ctx, nil, []common.Address{holder}, blockNum, false, pageSize, receivedBy(holder))
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(receipts)
+	return newTokenHoldingsResult(receipts, firstPage, lastPage), nil
+}
+
+func receivedBy(holder common.Address) func(t *TokenTransfer) bool {
+	return func(t *TokenTransfer) bool { return t.To == holder }
+}
+
+func newTokenHoldingsResult(receipts []*TokenTransfer, firstPage, lastPage bool) *TokenHoldingsResult {
+	res := TokenHoldingsResult{Holdings: make([]*TokenHolding, 0, len(receipts)), FirstPage: firstPage, LastPage: lastPage}
+	for _, t := range receipts {
+		res.Holdings = append(res.Holdings, &TokenHolding{Token: t.Token, Standard: t.Standard, BlockNumber: t.BlockNumber})
+	}
+	return &res
+}
+
+// SearchTokenHoldersBefore - receivers of the token, sorted descending by the block of the transfer
+func (api *OtterscanAPIImpl) SearchTokenHoldersBefore(ctx context.Context, token common.Address, blockNum uint64, pageSize uint16) (*TokenHoldersResult, error) {
+	receipts, firstPage, lastPage, err := api.searchFirstReceipts(ctx, []common.Address{token}, nil, blockNum, true, pageSize, receivedOf(token))
+	if err != nil {
+		return nil, err
+	}
+	return newTokenHoldersResult(receipts, firstPage, lastPage), nil
+}
+
+// SearchTokenHoldersAfter - receivers of the token, sorted descending by the block of the transfer
+func (api *OtterscanAPIImpl) SearchTokenHoldersAfter(ctx context.Context, token common.Address, blockNum uint64, pageSize uint16) (*TokenHoldersResult, error) {
+	receipts, firstPage, lastPage, err := api.searchFirstReceipts(ctx, []common.Address{token}, nil, blockNum, false, pageSize, receivedOf(token))
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(receipts)
+	return newTokenHoldersResult(receipts, firstPage, lastPage), nil
+}
+
+func receivedOf(token common.Address) func(t *TokenTransfer) bool {
+	return func(t *TokenTransfer) bool { return t.Token == token && t.To != (common.Address{}) } // skip burns
+}
+
+func newTokenHoldersResult(receipts []*TokenTransfer, firstPage, lastPage bool) *TokenHoldersResult {
+	res := TokenHoldersResult{Holders: make([]*TokenHolder, 0, len(receipts)), FirstPage: firstPage, LastPage: lastPage}
+	for _, t := range receipts {
+		res.Holders = append(res.Holders, &TokenHolder{Holder: t.To, BlockNumber: t.BlockNumber})
+	}
+	return &res
+}
+
+// receipt - [token, holder] pair, holdings and holders are returned once per pair over all pages
+type receipt [2]common.Address
+
+func receiptOf(t *TokenTransfer) receipt { return receipt{t.Token, t.To} }
+
+// searchFirstReceipts - transfers selected by `accept`, each one is the first receipt of its token by its holder in
+// the search order. Candidates of the page are checked against the previous pages in a single scan, and the page is
+// refilled from where it stopped until it has pageSize receipts or the search is exhausted.
+func (api *OtterscanAPIImpl) searchFirstReceipts(ctx context.Context, tokens, participants []common.Address, blockNum uint64, backward bool, pageSize uint16, accept func(t *TokenTransfer) bool) (receipts []*TokenTransfer, firstPage, lastPage bool, err error) {
+	if uint64(pageSize) > api.maxPageSize {
+		return nil, false, false, fmt.Errorf("max allowed page size: %v", api.maxPageSize)
+	}
+
+	dbtx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, false, false, err
+	}
+	defer dbtx.Rollback()
+	tx := dbtx.(kv.TemporalTx)
+
+	receipts = []*TokenTransfer{}
+	seen := map[receipt]struct{}{}
+	cursor := blockNum
+	for {
+		var candidates []*TokenTransfer
+		collect := func(_ context.Context, _ kv.TemporalTx, t *TokenTransfer) (bool, error) {
+			cursor = uint64(t.BlockNumber)
+			if !accept(t) {
+				return false, nil
+			}
+			if _, ok := seen[receiptOf(t)]; ok {
+				return false, nil
+			}
+			seen[receiptOf(t)] = struct{}{}
+			candidates = append(candidates, t)
+			return true, nil
+		}
+		first, last, err := api.searchTokenTransfersV3(ctx, tx, tokens, participants, cursor, backward, pageSize-uint16(len(receipts)), collect)
+		if err != nil {
+			return nil, false, false, err
+		}
+		received, err := api.receivedInPrevPages(ctx, tx, candidates, blockNum, backward)
+		if err != nil {
+			return nil, false, false, err
+		}
+		for _, t := range candidates {
+			if _, ok := received[receiptOf(t)]; !ok {
+				receipts = append(receipts, t)
+			}
+		}
+
+		if backward && (last || len(receipts) >= int(pageSize)) {
+			return receipts, blockNum == 0, last, nil
+		}
+		if !backward && (first || len(receipts) >= int(pageSize)) {
+			return receipts, first, blockNum == 0, nil
+		}
+	}
+}
+
+// receivedInPrevPages - receipts of the transfers which were already received in the blocks of the previous pages,
+// which are all blocks from blockNum to the head for a backward search and from genesis to blockNum for a forward one.
+// Such holdings were already returned by one of those pages. All transfers are checked by a single scan.
+func (api *OtterscanAPIImpl) receivedInPrevPages(ctx context.Context, tx kv.TemporalTx, transfers []*TokenTransfer, blockNum uint64, backward bool) (map[receipt]struct{}, error) {
+	received := map[receipt]struct{}{}
+	if blockNum == 0 || len(transfers) == 0 { // first page
+		return received, nil
+	}
+	pending := map[receipt]struct{}{}
+	tokenSet, holderSet := map[common.Address]struct{}{}, map[common.Address]struct{}{}
+	for _, t := range transfers {
+		pending[receiptOf(t)] = struct{}{}
+		tokenSet[t.Token] = struct{}{}
+		holderSet[t.To] = struct{}{}
+	}
+	tokens, holders := make([]common.Address, 0, len(tokenSet)), make([]common.Address, 0, len(holderSet))
+	for token := range tokenSet {
+		tokens = append(tokens, token)
+	}
+	for holder := range holderSet {
+		holders = append(holders, holder)
+	}
+
+	collect := func(_ context.Context, _ kv.TemporalTx, t *TokenTransfer) (bool, error) {
+		if _, ok := pending[receiptOf(t)]; !ok {
+			return false, nil
+		}
+		delete(pending, receiptOf(t))
+		received[receiptOf(t)] = struct{}{}
+		return true, nil
+	}
+	// searches exclude blockNum, so step over it to include; stop as soon as all receipts are found
+	var err error
+	if backward {
+		_, _, err = api.searchTokenTransfersV3(ctx, tx, tokens, holders, blockNum-1, false, uint16(len(pending)), collect)
+	} else {
+		_, _, err = api.searchTokenTransfersV3(ctx, tx, tokens, holders, blockNum+1, true, uint16(len(pending)), collect)
+	}
+	return received, err
+}
+
+// searchTokenTransfers - opens db transaction and checks the page size, see searchTokenTransfersV3
+func (api *OtterscanAPIImpl) searchTokenTransfers(ctx context.Context, participant common.Address, blockNum uint64, backward bool, pageSize uint16, collect tokenTransferCollector) (firstPage, lastPage bool, err error) {
+	if uint64(pageSize) > api.maxPageSize {
+		return false, false, fmt.Errorf("max allowed page size: %v", api.maxPageSize)
+	}
+
+	dbtx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return false, false, err
+	}
+	defer dbtx.Rollback()
+
+	return api.searchTokenTransfersV3(ctx, dbtx.(kv.TemporalTx), nil, []common.Address{participant}, blockNum, backward, pageSize, collect)
+}
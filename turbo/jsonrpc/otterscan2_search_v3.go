@@ -0,0 +1,268 @@
+package jsonrpc
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon-lib/kv/iter"
+	"github.com/ledgerwatch/erigon-lib/kv/order"
+	"github.com/ledgerwatch/erigon-lib/kv/rawdbv3"
+	"github.com/ledgerwatch/erigon/cmd/state/exec3"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/log/v3"
+)
+
+// transferTopic is shared by ERC-20 and ERC-721, the latter has indexed tokenId as the 4th topic
+var (
+	// TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+	transferSingleTopic = common.HexToHash("0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62")
+	// TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+	transferBatchTopic = common.HexToHash("0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb")
+
+	tokenTransferTopics = []common.Hash{transferTopic, transferSingleTopic, transferBatchTopic}
+)
+
+// createTokenTransfersTxNumIter - txNums of transactions with token transfer events, which are emitted by one of
+// `tokens` and have one of `participants` as indexed participant (from, to or ERC-1155 operator). Empty list means
+// any, at least one of the lists must be given.
+func createTokenTransfersTxNumIter(tx kv.TemporalTx, tokens, participants []common.Address, fromTxNum int, asc order.By) (*rawdbv3.MapTxNum2BlockNumIter, error) {
+	keys := make([][]byte, len(tokenTransferTopics))
+	for i := range tokenTransferTopics {
+		keys[i] = tokenTransferTopics[i][:]
+	}
+	txNums, err := indexUnion(tx, kv.LogTopicIdx, keys, fromTxNum, asc)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) > 0 {
+		keys := make([][]byte, len(tokens))
+		for i := range tokens {
+			keys[i] = tokens[i][:]
+		}
+		it, err := indexUnion(tx, kv.LogAddrIdx, keys, fromTxNum, asc)
+		if err != nil {
+			return nil, err
+		}
+		txNums = iter.Intersect[uint64](it, txNums, asc, kv.Unlim)
+	}
+	if len(participants) > 0 {
+		keys := make([][]byte, len(participants))
+		for i := range participants {
+			keys[i] = common.BytesToHash(participants[i][:]).Bytes() // indexed addresses are left-padded topics
+		}
+		it, err := indexUnion(tx, kv.LogTopicIdx, keys, fromTxNum, asc)
+		if err != nil {
+			return nil, err
+		}
+		txNums = iter.Intersect[uint64](it, txNums, asc, kv.Unlim)
+	}
+	return rawdbv3.TxNums2BlockNums(tx, txNums, asc), nil
+}
+
+// indexUnion - txNums of any of the keys in the index
+func indexUnion(tx kv.TemporalTx, idx kv.InvertedIdx, keys [][]byte, fromTxNum int, asc order.By) (iter.U64, error) {
+	// unbounded limit on purpose, since there could be e.g. block rewards system txs, we limit
+	// results later
+	var txNums iter.U64
+	for _, k := range keys {
+		it, err := tx.IndexRange(idx, k, fromTxNum, -1, asc, kv.Unlim)
+		if err != nil {
+			return nil, err
+		}
+		txNums = iter.Union[uint64](txNums, it, asc, kv.Unlim)
+	}
+	return txNums, nil
+}
+
+// searchTokenTransfersV3 - re-executes the transactions found by createTokenTransfersTxNumIter and passes their
+// token transfers to `collect`, which returns true if the transfer is counted into the page
+func (api *OtterscanAPIImpl) searchTokenTransfersV3(ctx context.Context, tx kv.TemporalTx, tokens, participants []common.Address, blockNum uint64, backward bool, pageSize uint16, collect tokenTransferCollector) (firstPage, lastPage bool, err error) {
+	fromTxNum := -1
+	asc := order.Asc
+	if backward {
+		asc = order.Desc
+		firstPage = blockNum == 0
+		if blockNum > 1 {
+			// Internal search code considers blockNum [including], so adjust the value;
+			// from == 0 == magic number which means last; reproduce bug-compatibility for == 1
+			_txNum, err := rawdbv3.TxNums.Max(tx, blockNum-1)
+			if err != nil {
+				return false, false, err
+			}
+			fromTxNum = int(_txNum)
+		}
+	} else {
+		lastPage = blockNum == 0
+		if blockNum != 0 {
+			// Internal search code considers blockNum [including], so adjust the value
+			_txNum, err := rawdbv3.TxNums.Min(tx, blockNum+1)
+			if err != nil {
+				return false, false, err
+			}
+			fromTxNum = int(_txNum)
+		}
+	}
+
+	chainConfig, err := api.chainConfig(ctx, tx)
+	if err != nil {
+		return false, false, err
+	}
+	txNumsIter, err := createTokenTransfersTxNumIter(tx, tokens, participants, fromTxNum, asc)
+	if err != nil {
+		return false, false, err
+	}
+
+	exec := exec3.NewTraceWorker(tx, chainConfig, api.engine(), api._blockReader, nil)
+	var header *types.Header
+	resultCount := uint16(0)
+	mustReadHeader := true
+	reachedPageSize := false
+	hasMore := false
+	for txNumsIter.HasNext() {
+		txNum, blockNum, txIndex, isFinalTxn, blockNumChanged, err := txNumsIter.Next()
+		if err != nil {
+			return false, false, err
+		}
+
+		// Even if the desired page size is reached, drain the entire matching
+		// txs inside the block, same as ots_searchTransactionsBefore/After
+		if blockNumChanged && reachedPageSize {
+			hasMore = true
+			break
+		}
+
+		mustReadHeader = mustReadHeader || blockNumChanged
+		if isFinalTxn {
+			continue
+		}
+
+		if mustReadHeader {
+			if header, err = api._blockReader.HeaderByNumber(ctx, tx, blockNum); err != nil {
+				return false, false, err
+			}
+			if header == nil {
+				log.Warn("[rpc] header is nil", "blockNum", blockNum)
+				continue
+			}
+			exec.ChangeBlock(header)
+			mustReadHeader = false
+		}
+
+		txn, err := api._txnReader.TxnByIdxInBlock(ctx, tx, blockNum, txIndex)
+		if err != nil {
+			return false, false, err
+		}
+		if txn == nil {
+			log.Warn("[rpc] txn not found", "blockNum", blockNum, "txIndex", txIndex)
+			continue
+		}
+		if _, err := exec.ExecTxn(txNum, txIndex, txn); err != nil {
+			return false, false, err
+		}
+		for _, lg := range exec.GetLogs(txIndex, txn) {
+			for _, transfer := range decodeTokenTransfers(lg) {
+				transfer.BlockNumber = hexutil.Uint64(blockNum)
+				transfer.Timestamp = hexutil.Uint64(header.Time)
+				transfer.TxHash = txn.Hash()
+				transfer.TxIndex = hexutil.Uint64(txIndex)
+				counted, err := collect(ctx, tx, transfer)
+				if err != nil {
+					return false, false, err
+				}
+				if counted {
+					resultCount++
+				}
+			}
+		}
+		if resultCount >= pageSize {
+			reachedPageSize = true
+		}
+	}
+
+	if backward {
+		return firstPage, !hasMore, nil
+	}
+	return !hasMore, lastPage, nil
+}
+
+// decodeTokenTransfers - transfers of ERC-20/721 Transfer and ERC-1155 TransferSingle/TransferBatch events,
+// nil for other events and malformed data
+func decodeTokenTransfers(lg *types.Log) []*TokenTransfer {
+	if len(lg.Topics) == 0 {
+		return nil
+	}
+	word := func(data []byte, i uint64) (*big.Int, bool) {
+		if i >= uint64(len(data))/32 {
+			return nil, false
+		}
+		return new(big.Int).SetBytes(data[i*32 : (i+1)*32]), true
+	}
+	switch lg.Topics[0] {
+	case transferTopic:
+		t := &TokenTransfer{Token: lg.Address}
+		switch len(lg.Topics) {
+		case 3:
+			value, ok := word(lg.Data, 0)
+			if !ok {
+				return nil
+			}
+			t.Standard, t.Value = TokenStandardERC20, (*hexutil.Big)(value)
+		case 4:
+			t.Standard, t.TokenID = TokenStandardERC721, (*hexutil.Big)(lg.Topics[3].Big())
+		default:
+			return nil
+		}
+		t.From, t.To = common.BytesToAddress(lg.Topics[1][:]), common.BytesToAddress(lg.Topics[2][:])
+		return []*TokenTransfer{t}
+	case transferSingleTopic, transferBatchTopic:
+		if len(lg.Topics) != 4 {
+			return nil
+		}
+		operator := common.BytesToAddress(lg.Topics[1][:])
+		from, to := common.BytesToAddress(lg.Topics[2][:]), common.BytesToAddress(lg.Topics[3][:])
+		newTransfer := func(id, value *big.Int) *TokenTransfer {
+			return &TokenTransfer{Token: lg.Address, Standard: TokenStandardERC1155, Operator: &operator, From: from, To: to, TokenID: (*hexutil.Big)(id), Value: (*hexutil.Big)(value)}
+		}
+		if lg.Topics[0] == transferSingleTopic {
+			id, ok1 := word(lg.Data, 0)
+			value, ok2 := word(lg.Data, 1)
+			if !ok1 || !ok2 {
+				return nil
+			}
+			return []*TokenTransfer{newTransfer(id, value)}
+		}
+
+		// abi encoding of (uint256[], uint256[]): offsets of both arrays, then length-prefixed arrays
+		array := func(i uint64) []*big.Int {
+			offset, ok := word(lg.Data, i)
+			if !ok || !offset.IsUint64() || offset.Uint64()%32 != 0 {
+				return nil
+			}
+			start := offset.Uint64() / 32
+			n, ok := word(lg.Data, start)
+			if !ok || !n.IsUint64() || n.Uint64() > uint64(len(lg.Data))/32 {
+				return nil
+			}
+			res := make([]*big.Int, n.Uint64())
+			for j := range res {
+				if res[j], ok = word(lg.Data, start+1+uint64(j)); !ok {
+					return nil
+				}
+			}
+			return res
+		}
+		ids, values := array(0), array(1)
+		if ids == nil || values == nil || len(ids) != len(values) {
+			return nil
+		}
+		transfers := make([]*TokenTransfer, 0, len(ids))
+		for j := range ids {
+			transfers = append(transfers, newTransfer(ids[j], values[j]))
+		}
+		return transfers
+	}
+	return nil
+}
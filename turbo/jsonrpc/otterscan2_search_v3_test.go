@@ -0,0 +1,180 @@
+package jsonrpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	libcommon "github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+
+	"github.com/ledgerwatch/erigon/core"
+	"github.com/ledgerwatch/erigon/core/types"
+	"github.com/ledgerwatch/erigon/crypto"
+	"github.com/ledgerwatch/erigon/params"
+	"github.com/ledgerwatch/erigon/turbo/stages/mock"
+)
+
+func TestDecodeTokenTransfers(t *testing.T) {
+	require := require.New(t)
+	require.Equal(crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")), transferTopic)
+	require.Equal(crypto.Keccak256Hash([]byte("TransferSingle(address,address,address,uint256,uint256)")), transferSingleTopic)
+	require.Equal(crypto.Keccak256Hash([]byte("TransferBatch(address,address,address,uint256[],uint256[])")), transferBatchTopic)
+
+	token, operator, from, to := libcommon.Address{0xaa}, libcommon.Address{1}, libcommon.Address{2}, libcommon.Address{3}
+	topic := func(a libcommon.Address) libcommon.Hash { return libcommon.BytesToHash(a[:]) }
+	words := func(v ...uint64) (data []byte) {
+		for _, w := range v {
+			data = append(data, libcommon.BigToHash(new(big.Int).SetUint64(w)).Bytes()...)
+		}
+		return data
+	}
+	hb := func(v int64) *hexutil.Big { return (*hexutil.Big)(big.NewInt(v)) }
+
+	erc20 := decodeTokenTransfers(&types.Log{Address: token, Topics: []libcommon.Hash{transferTopic, topic(from), topic(to)}, Data: words(5)})
+	require.Equal([]*TokenTransfer{{Token: token, Standard: TokenStandardERC20, From: from, To: to, Value: hb(5)}}, erc20)
+
+	erc721 := decodeTokenTransfers(&types.Log{Address: token, Topics: []libcommon.Hash{transferTopic, topic(from), topic(to), libcommon.BigToHash(big.NewInt(9))}})
+	require.Equal([]*TokenTransfer{{Token: token, Standard: TokenStandardERC721, From: from, To: to, TokenID: hb(9)}}, erc721)
+
+	single := decodeTokenTransfers(&types.Log{Address: token, Topics: []libcommon.Hash{transferSingleTopic, topic(operator), topic(from), topic(to)}, Data: words(9, 5)})
+	require.Equal([]*TokenTransfer{{Token: token, Standard: TokenStandardERC1155, Operator: &operator, From: from, To: to, TokenID: hb(9), Value: hb(5)}}, single)
+
+	// offsets 0x40 and 0xa0, ids [1, 2], values [10, 20]
+	batch := decodeTokenTransfers(&types.Log{Address: token, Topics: []libcommon.Hash{transferBatchTopic, topic(operator), topic(from), topic(to)}, Data: words(0x40, 0xa0, 2, 1, 2, 2, 10, 20)})
+	require.Equal([]*TokenTransfer{
+		{Token: token, Standard: TokenStandardERC1155, Operator: &operator, From: from, To: to, TokenID: hb(1), Value: hb(10)},
+		{Token: token, Standard: TokenStandardERC1155, Operator: &operator, From: from, To: to, TokenID: hb(2), Value: hb(20)},
+	}, batch)
+
+	// malformed and unrelated events
+	require.Nil(decodeTokenTransfers(&types.Log{Address: token, Topics: []libcommon.Hash{transferTopic, topic(from), topic(to)}}))
+	require.Nil(decodeTokenTransfers(&types.Log{Address: token, Topics: []libcommon.Hash{transferTopic}}))
+	require.Nil(decodeTokenTransfers(&types.Log{Address: token, Topics: []libcommon.Hash{transferBatchTopic, topic(operator), topic(from), topic(to)}, Data: words(0x40, 0x1000)}))
+	require.Nil(decodeTokenTransfers(&types.Log{Address: token, Topics: []libcommon.Hash{transferBatchTopic, topic(operator), topic(from), topic(to)}, Data: words(0x40, 0xa0, 2, 1, 2, 1, 10)}))
+	require.Nil(decodeTokenTransfers(&types.Log{Address: token, Topics: []libcommon.Hash{{1}, topic(from), topic(to)}, Data: words(5)}))
+	require.Nil(decodeTokenTransfers(&types.Log{Address: token}))
+}
+
+func TestSearchTokenTransfers(t *testing.T) {
+	require := require.New(t)
+	token := libcommon.HexToAddress("0x1000")
+	acc1, acc2 := libcommon.HexToAddress("0x2001"), libcommon.HexToAddress("0x2002")
+
+	// emits Transfer(caller, calldata[0:32], calldata[32:64])
+	code := []byte{0x60, 0x20, 0x35, 0x60, 0x00, 0x52, 0x60, 0x00, 0x35, 0x33, 0x7f}
+	code = append(code, transferTopic[:]...)
+	code = append(code, 0x60, 0x20, 0x60, 0x00, 0xa3, 0x00)
+
+	m := mock.MockWithGenesis(t, &types.Genesis{
+		Config: params.TestChainConfig,
+		Alloc: types.GenesisAlloc{
+			testAddr: {Balance: big.NewInt(1000000)},
+			token:    {Balance: big.NewInt(0), Code: code},
+		},
+	}, testKey, false)
+	signer := types.LatestSignerForChainID(nil)
+	transfer := func(block *core.BlockGen, to libcommon.Address, amount int64) {
+		data := append(libcommon.BytesToHash(to[:]).Bytes(), libcommon.BigToHash(big.NewInt(amount)).Bytes()...)
+		txn, err := types.SignTx(types.NewTransaction(block.TxNonce(testAddr), token, uint256.NewInt(0), 100_000, nil, data), *signer, testKey)
+		require.NoError(err)
+		block.AddTx(txn)
+	}
+	chain, err := core.GenerateChain(m.ChainConfig, m.Genesis, m.Engine, m.DB, 4, func(i int, block *core.BlockGen) {
+		switch i {
+		case 0:
+			transfer(block, acc1, 5)
+		case 1:
+			transfer(block, acc2, 7)
+		case 2:
+			// not a token transfer
+			txn, err := types.SignTx(types.NewTransaction(block.TxNonce(testAddr), acc1, uint256.NewInt(1), params.TxGas, nil, nil), *signer, testKey)
+			require.NoError(err)
+			block.AddTx(txn)
+		case 3:
+			transfer(block, acc1, 1)
+		}
+	})
+	require.NoError(err)
+	require.NoError(m.InsertChain(chain))
+	api := NewOtterscanAPI(newBaseApiForTest(m), m.DB, 25)
+
+	blocks := func(transfers []*TokenTransfer) (res []uint64) {
+		for _, t := range transfers {
+			res = append(res, uint64(t.BlockNumber))
+		}
+		return res
+	}
+
+	res, err := api.SearchTokenTransfersBefore(m.Ctx, acc1, 0, 25)
+	require.NoError(err)
+	require.Equal([]uint64{4, 1}, blocks(res.Transfers))
+	require.True(res.FirstPage)
+	require.True(res.LastPage)
+	require.Equal(testAddr, res.Transfers[0].From)
+	require.Equal(big.NewInt(1), res.Transfers[0].Value.ToInt())
+	require.Equal(chain.Blocks[3].Transactions()[0].Hash(), res.Transfers[0].TxHash)
+
+	// pagination
+	res, err = api.SearchTokenTransfersBefore(m.Ctx, testAddr, 0, 1)
+	require.NoError(err)
+	require.Equal([]uint64{4}, blocks(res.Transfers))
+	require.False(res.LastPage)
+	res, err = api.SearchTokenTransfersBefore(m.Ctx, testAddr, 4, 1)
+	require.NoError(err)
+	require.Equal([]uint64{2}, blocks(res.Transfers))
+	require.False(res.FirstPage)
+
+	res, err = api.SearchTokenTransfersAfter(m.Ctx, testAddr, 0, 25)
+	require.NoError(err)
+	require.Equal([]uint64{4, 2, 1}, blocks(res.Transfers))
+	res, err = api.SearchTokenTransfersAfter(m.Ctx, testAddr, 1, 1)
+	require.NoError(err)
+	require.Equal([]uint64{2}, blocks(res.Transfers))
+	require.False(res.FirstPage)
+
+	holdings, err := api.SearchTokenHoldingsBefore(m.Ctx, acc1, 0, 25)
+	require.NoError(err)
+	require.Equal([]*TokenHolding{{Token: token, Standard: TokenStandardERC20, BlockNumber: 4}}, holdings.Holdings)
+	holdings, err = api.SearchTokenHoldingsAfter(m.Ctx, testAddr, 0, 25)
+	require.NoError(err)
+	require.Empty(holdings.Holdings)
+
+	holders, err := api.SearchTokenHoldersBefore(m.Ctx, token, 0, 25)
+	require.NoError(err)
+	require.Equal([]*TokenHolder{{Holder: acc1, BlockNumber: 4}, {Holder: acc2, BlockNumber: 2}}, holders.Holders)
+	holders, err = api.SearchTokenHoldersAfter(m.Ctx, token, 0, 25)
+	require.NoError(err)
+	require.Equal([]*TokenHolder{{Holder: acc2, BlockNumber: 2}, {Holder: acc1, BlockNumber: 1}}, holders.Holders)
+
+	// every holder is returned once over all pages
+	holders, err = api.SearchTokenHoldersBefore(m.Ctx, token, 0, 1)
+	require.NoError(err)
+	require.Equal([]*TokenHolder{{Holder: acc1, BlockNumber: 4}}, holders.Holders)
+	holders, err = api.SearchTokenHoldersBefore(m.Ctx, token, 4, 1)
+	require.NoError(err)
+	require.Equal([]*TokenHolder{{Holder: acc2, BlockNumber: 2}}, holders.Holders)
+	require.False(holders.LastPage)
+	holders, err = api.SearchTokenHoldersBefore(m.Ctx, token, 2, 1)
+	require.NoError(err)
+	require.Empty(holders.Holders)
+	require.True(holders.LastPage)
+	holders, err = api.SearchTokenHoldersAfter(m.Ctx, token, 2, 1)
+	require.NoError(err)
+	require.Empty(holders.Holders)
+	require.True(holders.FirstPage)
+	holdings, err = api.SearchTokenHoldingsBefore(m.Ctx, acc1, 4, 25)
+	require.NoError(err)
+	require.Empty(holdings.Holdings)
+	holdings, err = api.SearchTokenHoldingsAfter(m.Ctx, acc1, 1, 25)
+	require.NoError(err)
+	require.Empty(holdings.Holdings)
+	holdings, err = api.SearchTokenHoldingsAfter(m.Ctx, acc1, 0, 1)
+	require.NoError(err)
+	require.Equal([]*TokenHolding{{Token: token, Standard: TokenStandardERC20, BlockNumber: 1}}, holdings.Holdings)
+
+	_, err = api.SearchTokenTransfersBefore(m.Ctx, acc1, 0, 26)
+	require.Error(err)
+}
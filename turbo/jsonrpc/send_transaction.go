@@ -7,16 +7,33 @@ import (
 	"math/big"
 
 	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 	"github.com/ledgerwatch/erigon-lib/common/hexutility"
 	txPoolProto "github.com/ledgerwatch/erigon-lib/gointerfaces/txpoolproto"
+	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
 
 	"github.com/ledgerwatch/erigon/core/types"
 	"github.com/ledgerwatch/erigon/eth/ethconfig"
 	"github.com/ledgerwatch/erigon/params"
+	"github.com/ledgerwatch/erigon/turbo/rpchelper"
 )
 
+// defaultPrivateTxBlocks - for how many blocks a private transaction stays in the pool if the max block is not given
+const defaultPrivateTxBlocks = 25
+
 // SendRawTransaction implements eth_sendRawTransaction. Creates new message call transaction or a contract creation for previously-signed transactions.
 func (api *APIImpl) SendRawTransaction(ctx context.Context, encodedTx hexutility.Bytes) (common.Hash, error) {
+	return api.sendRawTransaction(ctx, encodedTx, false, nil)
+}
+
+// SendPrivateRawTransaction implements eth_sendPrivateRawTransaction. Adds the previously-signed transaction to the
+// local pool without announcing it to the peers, it's only included by the blocks built by this node.
+// The transaction is dropped if it isn't mined until maxBlockNumber, by default 25 blocks after the latest one.
+func (api *APIImpl) SendPrivateRawTransaction(ctx context.Context, encodedTx hexutility.Bytes, maxBlockNumber *hexutil.Uint64) (common.Hash, error) {
+	return api.sendRawTransaction(ctx, encodedTx, true, maxBlockNumber)
+}
+
+func (api *APIImpl) sendRawTransaction(ctx context.Context, encodedTx hexutility.Bytes, private bool, maxBlockNumber *hexutil.Uint64) (common.Hash, error) {
 	txn, err := types.DecodeWrappedTransaction(encodedTx)
 	if err != nil {
 		return common.Hash{}, err
@@ -53,8 +70,24 @@ func (api *APIImpl) SendRawTransaction(ctx context.Context, encodedTx hexutility
 		}
 	}
 
+	req := &txPoolProto.AddRequest{RlpTxs: [][]byte{encodedTx}}
+	if private {
+		latest, err := rpchelper.GetLatestBlockNumber(tx)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		req.PrivateMaxBlock = latest + defaultPrivateTxBlocks
+		if maxBlockNumber != nil {
+			req.PrivateMaxBlock = uint64(*maxBlockNumber)
+		}
+		// the pool treats 0 as a public transaction, so an already reached deadline must not get that far
+		if req.PrivateMaxBlock <= latest {
+			return common.Hash{}, fmt.Errorf("%s: %s", txPoolProto.ImportResult_STALE, txpoolcfg.PrivateTxExpired)
+		}
+	}
+
 	hash := txn.Hash()
-	res, err := api.txPool.Add(ctx, &txPoolProto.AddRequest{RlpTxs: [][]byte{encodedTx}})
+	res, err := api.txPool.Add(ctx, req)
 	if err != nil {
 		return common.Hash{}, err
 	}
@@ -12,8 +12,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ledgerwatch/erigon-lib/common"
+	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/gointerfaces"
 	sentry "github.com/ledgerwatch/erigon-lib/gointerfaces/sentryproto"
 	txpool "github.com/ledgerwatch/erigon-lib/gointerfaces/txpoolproto"
+	typesproto "github.com/ledgerwatch/erigon-lib/gointerfaces/typesproto"
 	"github.com/ledgerwatch/erigon-lib/kv/kvcache"
 	"github.com/ledgerwatch/erigon-lib/txpool/txpoolcfg"
 	"github.com/ledgerwatch/erigon-lib/wrap"
@@ -169,6 +172,36 @@ func TestSendRawTransactionUnprotected(t *testing.T) {
 	}
 }
 
+func TestSendPrivateRawTransactionExpired(t *testing.T) {
+	mockSentry, require := mock.MockWithTxPool(t), require.New(t)
+	logger := log.New()
+
+	oneBlockStep(mockSentry, require, t)
+
+	txn, err := types.SignTx(types.NewTransaction(0, common.Address{1}, uint256.NewInt(1234), params.TxGas, uint256.NewInt(10*params.GWei), nil), *types.LatestSignerForChainID(mockSentry.ChainConfig.ChainID), mockSentry.Key)
+	require.NoError(err)
+
+	ctx, conn := rpcdaemontest.CreateTestGrpcConn(t, mockSentry)
+	txPool := txpool.NewTxpoolClient(conn)
+	api := jsonrpc.NewEthAPI(newBaseApiForTest(mockSentry), mockSentry.DB, nil, txPool, nil, 5000000, 100_000, false, 100_000, 128, logger)
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(txn.MarshalBinary(buf))
+
+	// 0 must not turn the transaction into a public one, the latest block is already too late as well
+	expectedErr := txpool_proto.ImportResult_name[int32(txpool_proto.ImportResult_STALE)] + ": " + txpoolcfg.PrivateTxExpired.String()
+	for _, maxBlockNumber := range []hexutil.Uint64{0, 1} {
+		_, err = api.SendPrivateRawTransaction(ctx, buf.Bytes(), &maxBlockNumber)
+		require.EqualError(err, expectedErr)
+	}
+	reply, err := txPool.Transactions(ctx, &txpool_proto.TransactionsRequest{Hashes: []*typesproto.H256{gointerfaces.ConvertHashToH256(txn.Hash())}})
+	require.NoError(err)
+	require.Empty(reply.RlpTxs[0])
+
+	_, err = api.SendPrivateRawTransaction(ctx, buf.Bytes(), nil)
+	require.NoError(err)
+}
+
 func transaction(nonce uint64, gaslimit uint64, key *ecdsa.PrivateKey) types.Transaction {
 	return pricedTransaction(nonce, gaslimit, u256.Num1, key)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/ledgerwatch/log/v3"
 
+	"github.com/ledgerwatch/erigon-lib/chain"
 	libcommon "github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 	"github.com/ledgerwatch/erigon-lib/common/hexutility"
@@ -854,7 +855,12 @@ func (api *TraceAPIImpl) ReplayBlockTransactions(ctx context.Context, blockNrOrH
 
 	signer := types.MakeSigner(chainConfig, blockNumber, block.Time())
 	// Returns an array of trace arrays, one trace array for each transaction
-	traces, _, err := api.callManyTransactions(ctx, tx, block, traceTypes, -1 /* all tx indices */, *gasBailOut, signer, chainConfig)
+	var traces []*TraceCallResult
+	if api.traceWorkers > 1 && chainConfig.Bor == nil {
+		traces, err = api.replayBlockTransactionsParallel(ctx, tx, block, traceTypes, *gasBailOut, signer, chainConfig)
+	} else {
+		traces, _, err = api.callManyTransactions(ctx, tx, block, traceTypes, -1 /* all tx indices */, *gasBailOut, signer, chainConfig)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -881,6 +887,137 @@ func (api *TraceAPIImpl) ReplayBlockTransactions(ctx context.Context, blockNrOrH
 	return result, nil
 }
 
+// replayBlockTransactionsParallel is the concurrent counterpart of callManyTransactions for the whole block:
+// transactions are replayed on a pool of api.traceWorkers workers, each one on top of its own pre-state read
+// from history, and the results are collected in block order.
+func (api *TraceAPIImpl) replayBlockTransactionsParallel(ctx context.Context, tx kv.Tx, block *types.Block, traceTypes []string, gasBailOut bool, signer *types.Signer, cfg *chain.Config) ([]*TraceCallResult, error) {
+	txs := block.Transactions()
+	results := make([]*TraceCallResult, len(txs))
+
+	trace := func(ctx context.Context, dbtx kv.Tx, txIndex int) (*TraceCallResult, error) {
+		return api.replayTransactionAt(ctx, dbtx, block, txIndex, traceTypes, gasBailOut, signer, cfg)
+	}
+	emit := func(txIndex int, result *TraceCallResult) error {
+		results[txIndex] = result
+		return nil
+	}
+	if err := traceTxsParallel(ctx, api.kv, tx, api.traceWorkers, len(txs), trace, emit); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// traceTypeFlags are the trace types requested by the caller.
+type traceTypeFlags struct {
+	trace, stateDiff, vmTrace bool
+}
+
+func parseTraceTypes(traceTypes []string) (traceTypeFlags, error) {
+	var res traceTypeFlags
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case TraceTypeTrace:
+			res.trace = true
+		case TraceTypeStateDiff:
+			res.stateDiff = true
+		case TraceTypeVmTrace:
+			res.vmTrace = true
+		default:
+			return res, fmt.Errorf("unrecognized trace type: %s", traceType)
+		}
+	}
+	return res, nil
+}
+
+// newTxTraceResult prepares the result of a single transaction trace along with the vm config whose tracer fills it in.
+// The call traces are only collected if withTrace is set, the returned state diff is nil unless it was requested.
+func (api *TraceAPIImpl) newTxTraceResult(txHash *libcommon.Hash, txIndex int, withTrace bool, flags traceTypeFlags) (*TraceCallResult, vm.Config, *StateDiff) {
+	traceResult := &TraceCallResult{Trace: []*ParityTrace{}, TransactionHash: txHash}
+	vmConfig := vm.Config{}
+	if withTrace || flags.vmTrace {
+		var ot OeTracer
+		ot.compat = api.compatibility
+		ot.r = traceResult
+		ot.idx = []string{fmt.Sprintf("%d-", txIndex)}
+		if withTrace {
+			ot.traceAddr = []int{}
+		}
+		if flags.vmTrace {
+			traceResult.VmTrace = &VmTrace{Ops: []*VmTraceOp{}}
+		}
+		vmConfig.Debug = true
+		vmConfig.Tracer = &ot
+	}
+	var sd *StateDiff
+	if flags.stateDiff {
+		sdMap := make(map[libcommon.Address]*StateDiffAccount)
+		traceResult.StateDiff = sdMap
+		sd = &StateDiff{sdMap: sdMap}
+	}
+	return traceResult, vmConfig, sd
+}
+
+// replayTransactionAt replays the transaction with the given index on top of the state as of its txNum, so it
+// doesn't need the preceding transactions of the block to be re-executed.
+func (api *TraceAPIImpl) replayTransactionAt(ctx context.Context, dbtx kv.Tx, block *types.Block, txIndex int, traceTypes []string, gasBailout bool, signer *types.Signer, cfg *chain.Config) (*TraceCallResult, error) {
+	flags, err := parseTraceTypes(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	engine := api.engine()
+	header := block.HeaderNoCopy()
+	rules := cfg.Rules(block.NumberU64(), block.Time())
+
+	stateReader, err := rpchelper.CreateHistoryStateReader(dbtx, block.NumberU64(), txIndex, cfg.ChainName)
+	if err != nil {
+		return nil, err
+	}
+	ibs := state.New(stateReader)
+
+	txn := block.Transactions()[txIndex]
+	txHash := txn.Hash()
+	msg, err := txn.AsMessage(*signer, header.BaseFee, rules)
+	if err != nil {
+		return nil, fmt.Errorf("convert tx into msg: %w", err)
+	}
+	// gnosis might have a fee free account here
+	if msg.FeeCap().IsZero() && engine != nil {
+		syscall := func(contract libcommon.Address, data []byte) ([]byte, error) {
+			return core.SysCallContract(contract, data, cfg, ibs, header, engine, true /* constCall */)
+		}
+		msg.SetIsFree(engine.IsServiceTransaction(msg.From(), syscall))
+	}
+
+	traceResult, vmConfig, sd := api.newTxTraceResult(&txHash, txIndex, flags.trace, flags)
+	var finalizeTxStateWriter state.StateWriter = state.NewNoopWriter()
+	if sd != nil {
+		finalizeTxStateWriter = sd
+	}
+
+	ibs.SetTxContext(txHash, block.Hash(), txIndex)
+	blockCtx := transactions.NewEVMBlockContext(engine, header, true /* requireCanonical */, dbtx, api._blockReader)
+	evm := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), ibs, cfg, vmConfig)
+	gp := new(core.GasPool).AddGas(msg.Gas()).AddBlobGas(msg.BlobGas())
+	execResult, err := core.ApplyMessage(evm, msg, gp, true /* refunds */, gasBailout /* gasBailout */)
+	if err != nil {
+		return nil, fmt.Errorf("first run for txIndex %d error: %w", txIndex, err)
+	}
+	traceResult.Output = libcommon.CopyBytes(execResult.ReturnData)
+
+	if err = ibs.FinalizeTx(rules, finalizeTxStateWriter); err != nil {
+		return nil, err
+	}
+	if sd != nil {
+		// the history reader is positioned before the transaction, so it still serves the initial state
+		sd.CompareStates(state.New(stateReader), ibs)
+	}
+	if !flags.trace {
+		traceResult.Trace = []*ParityTrace{}
+	}
+	return traceResult, nil
+}
+
 // Call implements trace_call.
 func (api *TraceAPIImpl) Call(ctx context.Context, args TraceCallParam, traceTypes []string, blockNrOrHash *rpc.BlockNumberOrHash) (*TraceCallResult, error) {
 	tx, err := api.kv.BeginRo(ctx)
@@ -1165,37 +1302,12 @@ func (api *TraceAPIImpl) doCallMany(ctx context.Context, dbtx kv.Tx, msgs []type
 			return nil, nil, err
 		}
 
-		var traceTypeTrace, traceTypeStateDiff, traceTypeVmTrace bool
 		args := callParams[txIndex]
-		for _, traceType := range args.traceTypes {
-			switch traceType {
-			case TraceTypeTrace:
-				traceTypeTrace = true
-			case TraceTypeStateDiff:
-				traceTypeStateDiff = true
-			case TraceTypeVmTrace:
-				traceTypeVmTrace = true
-			default:
-				return nil, nil, fmt.Errorf("unrecognized trace type: %s", traceType)
-			}
-		}
-
-		traceResult := &TraceCallResult{Trace: []*ParityTrace{}, TransactionHash: args.txHash}
-		vmConfig := vm.Config{}
-		if (traceTypeTrace && (txIndexNeeded == -1 || txIndex == txIndexNeeded)) || traceTypeVmTrace {
-			var ot OeTracer
-			ot.compat = api.compatibility
-			ot.r = traceResult
-			ot.idx = []string{fmt.Sprintf("%d-", txIndex)}
-			if traceTypeTrace && (txIndexNeeded == -1 || txIndex == txIndexNeeded) {
-				ot.traceAddr = []int{}
-			}
-			if traceTypeVmTrace {
-				traceResult.VmTrace = &VmTrace{Ops: []*VmTraceOp{}}
-			}
-			vmConfig.Debug = true
-			vmConfig.Tracer = &ot
+		flags, err := parseTraceTypes(args.traceTypes)
+		if err != nil {
+			return nil, nil, err
 		}
+		traceResult, vmConfig, sd := api.newTxTraceResult(args.txHash, txIndex, flags.trace && (txIndexNeeded == -1 || txIndex == txIndexNeeded), flags)
 
 		blockCtx := transactions.NewEVMBlockContext(engine, header, parentNrOrHash.RequireCanonical, dbtx, api._blockReader)
 		if useParent {
@@ -1205,14 +1317,9 @@ func (api *TraceAPIImpl) doCallMany(ctx context.Context, dbtx kv.Tx, msgs []type
 
 		// Clone the state cache before applying the changes for diff after transaction execution, clone is discarded
 		var cloneReader state.StateReader
-		var sd *StateDiff
-		if traceTypeStateDiff {
+		if sd != nil {
 			cloneCache := stateCache.Clone()
 			cloneReader = state.NewCachedReader(stateReader, cloneCache)
-
-			sdMap := make(map[libcommon.Address]*StateDiffAccount)
-			traceResult.StateDiff = sdMap
-			sd = &StateDiff{sdMap: sdMap}
 		}
 
 		var finalizeTxStateWriter state.StateWriter
@@ -1260,7 +1367,7 @@ func (api *TraceAPIImpl) doCallMany(ctx context.Context, dbtx kv.Tx, msgs []type
 
 		chainRules := chainConfig.Rules(blockCtx.BlockNumber, blockCtx.Time)
 		traceResult.Output = libcommon.CopyBytes(execResult.ReturnData)
-		if traceTypeStateDiff {
+		if flags.stateDiff {
 			initialIbs := state.New(cloneReader)
 			if !txFinalized {
 				if err = ibs.FinalizeTx(chainRules, sd); err != nil {
@@ -1281,7 +1388,7 @@ func (api *TraceAPIImpl) doCallMany(ctx context.Context, dbtx kv.Tx, msgs []type
 				return nil, nil, err
 			}
 		}
-		if !traceTypeTrace {
+		if !flags.trace {
 			traceResult.Trace = []*ParityTrace{}
 		}
 		results = append(results, traceResult)
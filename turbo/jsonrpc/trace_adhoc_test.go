@@ -101,3 +101,23 @@ func TestReplayBlockTransactions(t *testing.T) {
 	v := addrDiff.Balance.(map[string]*hexutil.Big)["+"].ToInt().Uint64()
 	require.Equal(t, uint64(1_000_000_000_000_000), v)
 }
+
+func TestReplayBlockTransactionsParallel(t *testing.T) {
+	m, _, _ := rpcdaemontest.CreateTestSentry(t)
+	sequential := NewTraceAPI(newBaseApiForTest(m), m.DB, &httpcfg.HttpCfg{})
+	parallel := NewTraceAPI(newBaseApiForTest(m), m.DB, &httpcfg.HttpCfg{TraceWorkers: 4})
+
+	traceTypes := []string{TraceTypeTrace, TraceTypeStateDiff, TraceTypeVmTrace}
+	for blockNum := rpc.BlockNumber(1); blockNum <= 10; blockNum++ {
+		expected, err := sequential.ReplayBlockTransactions(m.Ctx, rpc.BlockNumberOrHash{BlockNumber: &blockNum}, traceTypes, new(bool))
+		require.NoError(t, err)
+		actual, err := parallel.ReplayBlockTransactions(m.Ctx, rpc.BlockNumberOrHash{BlockNumber: &blockNum}, traceTypes, new(bool))
+		require.NoError(t, err)
+
+		expectedJson, err := json.Marshal(expected)
+		require.NoError(t, err)
+		actualJson, err := json.Marshal(actual)
+		require.NoError(t, err)
+		require.JSONEq(t, string(expectedJson), string(actualJson), "block %d", blockNum)
+	}
+}
@@ -34,19 +34,23 @@ type TraceAPI interface {
 // TraceAPIImpl is implementation of the TraceAPI interface based on remote Db access
 type TraceAPIImpl struct {
 	*BaseAPI
-	kv            kv.RoDB
-	maxTraces     uint64
-	gasCap        uint64
-	compatibility bool // Bug for bug compatiblity with OpenEthereum
+	kv              kv.RoDB
+	maxTraces       uint64
+	gasCap          uint64
+	compatibility   bool   // Bug for bug compatiblity with OpenEthereum
+	traceWorkers    int    // number of workers replaying transactions of a block concurrently, sequential replay if <= 1
+	filterMaxBlocks uint64 // max blocks replayed for one page of paginated trace_filter, 0 - unlimited
 }
 
 // NewTraceAPI returns NewTraceAPI instance
 func NewTraceAPI(base *BaseAPI, kv kv.RoDB, cfg *httpcfg.HttpCfg) *TraceAPIImpl {
 	return &TraceAPIImpl{
-		BaseAPI:       base,
-		kv:            kv,
-		maxTraces:     cfg.MaxTraces,
-		gasCap:        cfg.Gascap,
-		compatibility: cfg.TraceCompatibility,
+		BaseAPI:         base,
+		kv:              kv,
+		maxTraces:       cfg.MaxTraces,
+		gasCap:          cfg.Gascap,
+		compatibility:   cfg.TraceCompatibility,
+		traceWorkers:    cfg.TraceWorkers,
+		filterMaxBlocks: cfg.TraceFilterMaxBlocks,
 	}
 }
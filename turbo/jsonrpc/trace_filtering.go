@@ -2,6 +2,8 @@ package jsonrpc
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"errors"
 	"fmt"
 
@@ -262,7 +264,7 @@ func traceFilterBitmapsV3(tx kv.TemporalTx, req TraceFilterRequest, from, to uin
 
 	switch req.Mode {
 	case TraceFilterModeIntersection:
-		allBlocks = iter.Intersect[uint64](allBlocks, blocksTo, -1)
+		allBlocks = iter.Intersect[uint64](allBlocks, blocksTo, order.Asc, -1)
 	case TraceFilterModeUnion:
 		fallthrough
 	default:
@@ -283,6 +285,11 @@ func traceFilterBitmapsV3(tx kv.TemporalTx, req TraceFilterRequest, from, to uin
 // Filter implements trace_filter
 // NOTE: We do not store full traces - we just store index for each address
 // Pull blocks which have txs with matching address
+//
+// When req.Cursor is set (an empty string starts from fromBlock) the result is paginated: it is an object
+// {"traces": [...], "nextCursor": "..."}, where at most trace.filter.maxblocks blocks are replayed per call
+// and nextCursor resumes right after the last returned trace. nextCursor is null once the range is exhausted.
+// Without a cursor the whole [fromBlock, toBlock] range is replayed, as before pagination was added.
 func (api *TraceAPIImpl) Filter(ctx context.Context, req TraceFilterRequest, gasBailOut *bool, stream *jsoniter.Stream) error {
 	if gasBailOut == nil {
 		//nolint
@@ -312,24 +319,66 @@ func (api *TraceAPIImpl) Filter(ctx context.Context, req TraceFilterRequest, gas
 		return fmt.Errorf("invalid parameters: fromBlock cannot be greater than toBlock")
 	}
 
-	return api.filterV3(ctx, dbtx.(kv.TemporalTx), fromBlock, toBlock, req, stream, *gasBailOut)
+	var cursor *traceFilterCursor
+	if req.Cursor != nil {
+		if req.After != nil {
+			return fmt.Errorf("invalid parameters: after cannot be combined with cursor")
+		}
+		cursor = &traceFilterCursor{BlockNum: fromBlock}
+		if *req.Cursor != "" {
+			if cursor, err1 = parseTraceFilterCursor(*req.Cursor); err1 != nil {
+				return err1
+			}
+			if cursor.BlockNum < fromBlock {
+				return fmt.Errorf("invalid parameters: cursor points to block %d before fromBlock %d", cursor.BlockNum, fromBlock)
+			}
+		}
+	}
+
+	return api.filterV3(ctx, dbtx.(kv.TemporalTx), fromBlock, toBlock, req, cursor, stream, *gasBailOut)
 }
 
-func (api *TraceAPIImpl) filterV3(ctx context.Context, dbtx kv.TemporalTx, fromBlock, toBlock uint64, req TraceFilterRequest, stream *jsoniter.Stream, gasBailOut bool) error {
+// filterV3 streams the traces of trace_filter. A nil cursor produces the plain array of the original trace_filter,
+// otherwise replay starts at the cursor position and the page is wrapped together with the cursor of the next page.
+func (api *TraceAPIImpl) filterV3(ctx context.Context, dbtx kv.TemporalTx, fromBlock, toBlock uint64, req TraceFilterRequest, cursor *traceFilterCursor, stream *jsoniter.Stream, gasBailOut bool) error {
 	var fromTxNum, toTxNum uint64
 	var err error
+	if cursor != nil {
+		if cursor.BlockNum > toBlock {
+			// nothing left after the previous page
+			stream.WriteObjectStart()
+			stream.WriteObjectField("traces")
+			stream.WriteEmptyArray()
+			writeTraceFilterNextCursor(stream, nil)
+			return stream.Flush()
+		}
+		fromBlock = cursor.BlockNum
+	}
 	if fromBlock > 0 {
 		fromTxNum, err = rawdbv3.TxNums.Min(dbtx, fromBlock)
 		if err != nil {
 			return err
 		}
 	}
+	// txIndex of the cursor follows rawdbv3.TxNums2BlockNums: the block's system txn has index -1
+	startTxNum := fromTxNum
+	if cursor != nil {
+		// the cursor comes from the client, it must point inside its block
+		blockMaxTxNum, err := rawdbv3.TxNums.Max(dbtx, fromBlock)
+		if err != nil {
+			return err
+		}
+		if cursor.TxIndex >= blockMaxTxNum-fromTxNum {
+			return fmt.Errorf("invalid parameters: cursor points to transaction %d past the end of block %d", cursor.TxIndex, fromBlock)
+		}
+		startTxNum = fromTxNum + cursor.TxIndex + 1
+	}
 	toTxNum, err = rawdbv3.TxNums.Max(dbtx, toBlock) // toBlock is an inclusive bound
 	if err != nil {
 		return err
 	}
 	toTxNum++ //+1 because internally Erigon using semantic [from, to), but some RPC have different semantic
-	fromAddresses, toAddresses, allTxs, err := traceFilterBitmapsV3(dbtx, req, fromTxNum, toTxNum)
+	fromAddresses, toAddresses, allTxs, err := traceFilterBitmapsV3(dbtx, req, startTxNum, toTxNum)
 	if err != nil {
 		return err
 	}
@@ -343,6 +392,10 @@ func (api *TraceAPIImpl) filterV3(ctx context.Context, dbtx kv.TemporalTx, fromB
 	engine := api.engine()
 
 	var json = jsoniter.ConfigCompatibleWithStandardLibrary
+	if cursor != nil {
+		stream.WriteObjectStart()
+		stream.WriteObjectField("traces")
+	}
 	stream.WriteArrayStart()
 	first := true
 	// Execute all transactions in picked blocks
@@ -360,6 +413,41 @@ func (api *TraceAPIImpl) filterV3(ctx context.Context, dbtx kv.TemporalTx, fromB
 	nExported := uint64(0)
 	includeAll := len(fromAddresses) == 0 && len(toAddresses) == 0
 
+	// position of the matched traces, a cursor points to the trace the next page starts with
+	var curBlockNum, curTxIndex, nTraceInTxn, skipInTxn uint64
+	var nBlocks uint64
+	var nextCursor *traceFilterCursor
+	// export writes a matched trace unless it belongs to a previous page or is out of after/count bounds.
+	// It returns false when the page is full and replay has to stop.
+	export := func(b []byte) (bool, error) {
+		traceIndex := nTraceInTxn
+		nTraceInTxn++
+		if traceIndex < skipInTxn {
+			return true, nil
+		}
+		nSeen++
+		if nSeen <= after {
+			return true, nil
+		}
+		if nExported >= count {
+			if cursor != nil {
+				nextCursor = &traceFilterCursor{BlockNum: curBlockNum, TxIndex: curTxIndex, TraceIndex: traceIndex}
+				return false, nil
+			}
+			return true, nil
+		}
+		if first {
+			first = false
+		} else {
+			stream.WriteMore()
+		}
+		if _, err := stream.Write(b); err != nil {
+			return false, err
+		}
+		nExported++
+		return true, nil
+	}
+
 	var lastBlockHash common.Hash
 	var lastHeader *types.Header
 	var lastSigner *types.Signer
@@ -369,8 +457,25 @@ func (api *TraceAPIImpl) filterV3(ctx context.Context, dbtx kv.TemporalTx, fromB
 	stateReader.SetTx(dbtx)
 	noop := state.NewNoopWriter()
 	isPos := false
+replay:
 	for it.HasNext() {
 		txNum, blockNum, txIndex, isFnalTxn, blockNumChanged, err := it.Next()
+		if err == nil {
+			curBlockNum, curTxIndex, nTraceInTxn, skipInTxn = blockNum, 0, 0, 0
+			if txIndex > 0 {
+				curTxIndex = uint64(txIndex)
+			}
+			if cursor != nil && txNum == startTxNum {
+				skipInTxn = cursor.TraceIndex
+			}
+			if cursor != nil && blockNumChanged {
+				if api.filterMaxBlocks > 0 && nBlocks >= api.filterMaxBlocks {
+					nextCursor = &traceFilterCursor{BlockNum: curBlockNum, TxIndex: curTxIndex}
+					break
+				}
+				nBlocks++
+			}
+		}
 		if err != nil {
 			if first {
 				first = false
@@ -440,7 +545,6 @@ func (api *TraceAPIImpl) filterV3(ctx context.Context, dbtx kv.TemporalTx, fromB
 			// Block reward section, handle specially
 			minerReward, uncleRewards := ethash.AccumulateRewards(chainConfig, lastHeader, body.Uncles)
 			if _, ok := toAddresses[lastHeader.Coinbase]; ok || includeAll {
-				nSeen++
 				var tr ParityTrace
 				var rewardAction = &RewardTraceAction{}
 				rewardAction.Author = lastHeader.Coinbase
@@ -465,22 +569,15 @@ func (api *TraceAPIImpl) filterV3(ctx context.Context, dbtx kv.TemporalTx, fromB
 					stream.WriteObjectEnd()
 					continue
 				}
-				if nSeen > after && nExported < count {
-					if first {
-						first = false
-					} else {
-						stream.WriteMore()
-					}
-					if _, err := stream.Write(b); err != nil {
-						return err
-					}
-					nExported++
+				if more, err := export(b); err != nil {
+					return err
+				} else if !more {
+					break replay
 				}
 			}
 			for i, uncle := range body.Uncles {
 				if _, ok := toAddresses[uncle.Coinbase]; ok || includeAll {
 					if i < len(uncleRewards) {
-						nSeen++
 						var tr ParityTrace
 						rewardAction := &RewardTraceAction{}
 						rewardAction.Author = uncle.Coinbase
@@ -505,16 +602,10 @@ func (api *TraceAPIImpl) filterV3(ctx context.Context, dbtx kv.TemporalTx, fromB
 							stream.WriteObjectEnd()
 							continue
 						}
-						if nSeen > after && nExported < count {
-							if first {
-								first = false
-							} else {
-								stream.WriteMore()
-							}
-							if _, err := stream.Write(b); err != nil {
-								return err
-							}
-							nExported++
+						if more, err := export(b); err != nil {
+							return err
+						} else if !more {
+							break replay
 						}
 					}
 				}
@@ -615,7 +706,6 @@ func (api *TraceAPIImpl) filterV3(ctx context.Context, dbtx kv.TemporalTx, fromB
 		isIntersectionMode := req.Mode == TraceFilterModeIntersection
 		for _, pt := range traceResult.Trace {
 			if includeAll || filterTrace(pt, fromAddresses, toAddresses, isIntersectionMode) {
-				nSeen++
 				pt.BlockHash = &lastBlockHash
 				pt.BlockNumber = &blockNum
 				pt.TransactionHash = &txHash
@@ -632,24 +722,33 @@ func (api *TraceAPIImpl) filterV3(ctx context.Context, dbtx kv.TemporalTx, fromB
 					stream.WriteObjectEnd()
 					continue
 				}
-				if nSeen > after && nExported < count {
-					if first {
-						first = false
-					} else {
-						stream.WriteMore()
-					}
-					if _, err := stream.Write(b); err != nil {
-						return err
-					}
-					nExported++
+				if more, err := export(b); err != nil {
+					return err
+				} else if !more {
+					break replay
 				}
 			}
 		}
 	}
 	stream.WriteArrayEnd()
+	if cursor != nil {
+		writeTraceFilterNextCursor(stream, nextCursor)
+	}
 	return stream.Flush()
 }
 
+// writeTraceFilterNextCursor closes the paginated trace_filter object after its "traces" array
+func writeTraceFilterNextCursor(stream *jsoniter.Stream, next *traceFilterCursor) {
+	stream.WriteMore()
+	stream.WriteObjectField("nextCursor")
+	if next == nil {
+		stream.WriteNil()
+	} else {
+		stream.WriteString(next.String())
+	}
+	stream.WriteObjectEnd()
+}
+
 func filterTrace(pt *ParityTrace, fromAddresses map[common.Address]struct{}, toAddresses map[common.Address]struct{}, isIntersectionMode bool) bool {
 	f, t := false, false
 	switch action := pt.Action.(type) {
@@ -793,6 +892,35 @@ type TraceFilterRequest struct {
 	Mode        TraceFilterMode   `json:"mode"`
 	After       *uint64           `json:"after"`
 	Count       *uint64           `json:"count"`
+	Cursor      *string           `json:"cursor"` // enables pagination, see TraceAPIImpl.Filter
+}
+
+// traceFilterCursor is the position of the first trace of the next trace_filter page.
+// TxIndex has rawdbv3.TxNums2BlockNums semantic, so block rewards are located at the index past the last transaction.
+type traceFilterCursor struct {
+	BlockNum   uint64
+	TxIndex    uint64
+	TraceIndex uint64 // index among the matched traces of the transaction
+}
+
+func (c *traceFilterCursor) String() string {
+	var buf [24]byte
+	binary.BigEndian.PutUint64(buf[:], c.BlockNum)
+	binary.BigEndian.PutUint64(buf[8:], c.TxIndex)
+	binary.BigEndian.PutUint64(buf[16:], c.TraceIndex)
+	return base64.RawURLEncoding.EncodeToString(buf[:])
+}
+
+func parseTraceFilterCursor(s string) (*traceFilterCursor, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(buf) != 24 {
+		return nil, fmt.Errorf("invalid parameters: malformed cursor %q", s)
+	}
+	return &traceFilterCursor{
+		BlockNum:   binary.BigEndian.Uint64(buf[:]),
+		TxIndex:    binary.BigEndian.Uint64(buf[8:]),
+		TraceIndex: binary.BigEndian.Uint64(buf[16:]),
+	}, nil
 }
 
 type TraceFilterMode string
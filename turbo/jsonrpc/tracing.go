@@ -1,7 +1,10 @@
 package jsonrpc
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,9 +12,12 @@ import (
 	jsoniter "github.com/json-iterator/go"
 	"github.com/ledgerwatch/log/v3"
 
+	"github.com/ledgerwatch/erigon-lib/chain"
 	"github.com/ledgerwatch/erigon-lib/common"
 	"github.com/ledgerwatch/erigon-lib/common/hexutil"
+	"github.com/ledgerwatch/erigon-lib/kv"
 
+	"github.com/ledgerwatch/erigon/common/debug"
 	"github.com/ledgerwatch/erigon/common/math"
 	"github.com/ledgerwatch/erigon/core"
 	"github.com/ledgerwatch/erigon/core/state"
@@ -68,6 +74,11 @@ func (api *PrivateDebugAPIImpl) traceBlock(ctx context.Context, blockNrOrHash rp
 		return err
 	}
 
+	return api.traceBlockWithTx(ctx, tx, block, config, stream)
+}
+
+// traceBlockWithTx writes the traces of all transactions of the block to the stream as a JSON array.
+func (api *PrivateDebugAPIImpl) traceBlockWithTx(ctx context.Context, tx kv.Tx, block *types.Block, config *tracers.TraceConfig, stream *jsoniter.Stream) error {
 	if config == nil {
 		config = &tracers.TraceConfig{}
 	}
@@ -84,12 +95,6 @@ func (api *PrivateDebugAPIImpl) traceBlock(ctx context.Context, blockNrOrHash rp
 	}
 	engine := api.engine()
 
-	_, blockCtx, _, ibs, _, err := transactions.ComputeTxEnv(ctx, engine, block, chainConfig, api._blockReader, tx, 0)
-	if err != nil {
-		stream.WriteNil()
-		return err
-	}
-
 	signer := types.MakeSigner(chainConfig, block.NumberU64(), block.Time())
 	rules := chainConfig.Rules(block.NumberU64(), block.Time())
 	stream.WriteArrayStart()
@@ -109,6 +114,17 @@ func (api *PrivateDebugAPIImpl) traceBlock(ctx context.Context, blockNrOrHash rp
 		}
 	}
 
+	if api.traceWorkers > 1 && borStateSyncTxn == nil {
+		return api.traceBlockParallel(ctx, tx, block, config, chainConfig, stream)
+	}
+
+	// the parallel tracing reads the pre-state of every transaction on its own, so the block one is only needed here
+	_, blockCtx, _, ibs, _, err := transactions.ComputeTxEnv(ctx, engine, block, chainConfig, api._blockReader, tx, 0)
+	if err != nil {
+		stream.WriteArrayEnd()
+		return err
+	}
+
 	for idx, txn := range txns {
 		isBorStateSyncTxn := borStateSyncTxn == txn
 		var txnHash common.Hash
@@ -194,6 +210,198 @@ func (api *PrivateDebugAPIImpl) traceBlock(ctx context.Context, blockNrOrHash rp
 	return nil
 }
 
+// traceBlockParallel traces the transactions of the block on a pool of api.traceWorkers workers. Every transaction
+// is executed on top of its own pre-state read from history, the traces are written to the stream in block order.
+// The caller is expected to have already started the JSON array.
+func (api *PrivateDebugAPIImpl) traceBlockParallel(ctx context.Context, tx kv.Tx, block *types.Block, config *tracers.TraceConfig, chainConfig *chain.Config, stream *jsoniter.Stream) error {
+	engine := api.engine()
+	txns := block.Transactions()
+
+	trace := func(ctx context.Context, tx kv.Tx, idx int) ([]byte, error) {
+		msg, blockCtx, txCtx, ibs, _, err := transactions.ComputeTxEnv(ctx, engine, block, chainConfig, api._blockReader, tx, idx)
+		if err != nil {
+			return nil, err
+		}
+		txCtx.TxHash = txns[idx].Hash()
+
+		txStream := jsoniter.NewStream(jsoniter.ConfigDefault, nil, 4096)
+		err = transactions.TraceTx(ctx, msg, blockCtx, txCtx, ibs, config, chainConfig, txStream, api.evmCallTimeout)
+		// if we have an error we want to output valid json for it, same as the sequential tracing does
+		if err != nil {
+			if len(txStream.Buffer()) == 0 {
+				txStream.WriteNil()
+			}
+			txStream.WriteMore()
+			rpc.HandleError(err, txStream)
+		}
+		return txStream.Buffer(), nil
+	}
+
+	emit := func(idx int, result []byte) error {
+		if idx > 0 {
+			stream.WriteMore()
+		}
+		stream.WriteObjectStart()
+		stream.WriteObjectField("txHash")
+		stream.WriteString(txns[idx].Hash().Hex())
+		stream.WriteMore()
+		stream.WriteObjectField("result")
+		if _, err := stream.Write(result); err != nil {
+			return err
+		}
+		stream.WriteObjectEnd()
+		return stream.Flush()
+	}
+
+	if err := traceTxsParallel(ctx, api.db, tx, api.traceWorkers, len(txns), trace, emit); err != nil {
+		stream.WriteArrayEnd()
+		return err
+	}
+
+	stream.WriteArrayEnd()
+	return stream.Flush()
+}
+
+// blockTraceResult is the notification sent by the debug_traceChain subscription for every traced block
+type blockTraceResult struct {
+	Block  hexutil.Uint64  `json:"block"`
+	Hash   common.Hash     `json:"hash"`
+	Traces json.RawMessage `json:"traces,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// TraceChain implements debug_traceChain. Returns a subscription streaming Geth style block traces for every block
+// of the [start, end] range, one notification per block in block order. Notifications are written to the connection
+// synchronously, so a slow consumer slows down tracing instead of piling up results. A failed block is reported in
+// the "error" field of its notification and ends the stream.
+func (api *PrivateDebugAPIImpl) TraceChain(ctx context.Context, start, end rpc.BlockNumber, config *tracers.TraceConfig) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	tx, err := api.db.BeginRo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	from, _, _, err := rpchelper.GetCanonicalBlockNumber(rpc.BlockNumberOrHashWithNumber(start), tx, api.filters)
+	if err != nil {
+		return nil, err
+	}
+	to, _, _, err := rpchelper.GetCanonicalBlockNumber(rpc.BlockNumberOrHashWithNumber(end), tx, api.filters)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := rpchelper.GetLatestBlockNumber(tx)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, fmt.Errorf("end block #%d needs to come after start block #%d", to, from)
+	}
+	if to > latest {
+		return nil, fmt.Errorf("end block #%d is after the latest block #%d", to, latest)
+	}
+	// fail early instead of streaming errors for every block of a pruned range
+	if err = api.BaseAPI.checkPruneHistory(tx, from); err != nil {
+		return nil, err
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		defer debug.LogPanic()
+		// the context of the subscribe call ends together with the call, tracing lives until unsubscribe
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			select {
+			case <-rpcSub.Err():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		notify := func(res *blockTraceResult) error {
+			return notifier.Notify(rpcSub.ID, res)
+		}
+		if err := api.traceChain(ctx, from, to, config, notify); err != nil && !errors.Is(err, context.Canceled) {
+			log.Warn("[rpc] debug_traceChain stopped", "from", from, "to", to, "err", err)
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// traceChain read transactions are renewed after this many blocks or this much time, whichever comes first, so a long
+// range doesn't keep old database pages pinned for the whole run.
+const (
+	traceChainTxBlocks   = 128
+	traceChainTxInterval = 30 * time.Second
+)
+
+// traceChain traces blocks [from, to] and hands the trace of each block to notify.
+func (api *PrivateDebugAPIImpl) traceChain(ctx context.Context, from, to uint64, config *tracers.TraceConfig, notify func(*blockTraceResult) error) error {
+	var (
+		tx        kv.Tx
+		txBlocks  int
+		txStarted time.Time
+	)
+	defer func() {
+		if tx != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var buf bytes.Buffer
+	stream := jsoniter.NewStream(jsoniter.ConfigDefault, &buf, 4096)
+	for blockNum := from; blockNum <= to; blockNum++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if tx == nil || txBlocks >= traceChainTxBlocks || time.Since(txStarted) >= traceChainTxInterval {
+			if tx != nil {
+				tx.Rollback()
+			}
+			var err error
+			if tx, err = api.db.BeginRo(ctx); err != nil {
+				return err
+			}
+			txBlocks, txStarted = 0, time.Now()
+		}
+		txBlocks++
+
+		res := &blockTraceResult{Block: hexutil.Uint64(blockNum)}
+		block, err := api.blockByNumberWithSenders(ctx, tx, blockNum)
+		if err == nil && block == nil {
+			err = fmt.Errorf("block #%d not found", blockNum)
+		}
+		if err == nil {
+			res.Hash = block.Hash()
+			buf.Reset()
+			stream.Reset(&buf)
+			err = api.traceBlockWithTx(ctx, tx, block, config, stream)
+			if flushErr := stream.Flush(); err == nil {
+				err = flushErr
+			}
+		}
+		if err != nil {
+			res.Error = err.Error()
+		} else {
+			res.Traces = buf.Bytes()
+		}
+		if notifyErr := notify(res); notifyErr != nil {
+			return notifyErr
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // TraceTransaction implements debug_traceTransaction. Returns Geth style transaction traces.
 func (api *PrivateDebugAPIImpl) TraceTransaction(ctx context.Context, hash common.Hash, config *tracers.TraceConfig, stream *jsoniter.Stream) error {
 	tx, err := api.db.BeginRo(ctx)
@@ -0,0 +1,116 @@
+package jsonrpc
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ledgerwatch/erigon-lib/kv"
+)
+
+// txTraceOutput is the outcome of tracing a single transaction of a block
+type txTraceOutput[T any] struct {
+	result T
+	err    error
+}
+
+// traceTxsParallel calls trace for every transaction index in [0, txCount) on a pool of workers and
+// hands the results to emit strictly in transaction order, as soon as every preceding result is ready.
+//
+// Every transaction is expected to be replayed on top of its own historical pre-state (read as of
+// its txNum), so transactions of the same block don't depend on each other. Each worker opens its
+// own read-only transaction because a kv.Tx must not be shared between goroutines.
+//
+// The caller already holds tx: waiting for a free read transaction slot while holding one deadlocks
+// once enough requests do the same (see `--db.read.concurrency`). So workers only start if a slot is
+// free right now (kv.TryBeginRoDB), and the caller itself traces on tx whenever the next result is not
+// ready - without free slots (or on a db which can't tell) tracing is just sequential.
+//
+// An error returned by trace or emit aborts the whole run.
+func traceTxsParallel[T any](ctx context.Context, db kv.RoDB, tx kv.Tx, workers, txCount int,
+	trace func(ctx context.Context, tx kv.Tx, txIndex int) (T, error),
+	emit func(txIndex int, result T) error,
+) error {
+	// the caller is one of the workers
+	workers--
+	if workers > txCount-1 {
+		workers = txCount - 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	// every slot is written exactly once, so the buffer of 1 makes sure workers never block on it
+	outputs := make([]chan txTraceOutput[T], txCount)
+	for i := range outputs {
+		outputs[i] = make(chan txTraceOutput[T], 1)
+	}
+	jobs := make(chan int, txCount)
+	for i := 0; i < txCount; i++ {
+		jobs <- i
+	}
+	close(jobs)
+
+	run := func(tx kv.Tx, txIndex int) {
+		if ctx.Err() != nil {
+			outputs[txIndex] <- txTraceOutput[T]{err: ctx.Err()}
+			return
+		}
+		result, err := trace(ctx, tx, txIndex)
+		outputs[txIndex] <- txTraceOutput[T]{result: result, err: err}
+	}
+
+	if tryDB, ok := db.(kv.TryBeginRoDB); ok {
+		for w := 0; w < workers; w++ {
+			workerTx, err := tryDB.TryBeginRo(ctx)
+			if err != nil {
+				return err
+			}
+			if workerTx == nil { // no free slots
+				break
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer workerTx.Rollback()
+				for txIndex := range jobs {
+					run(workerTx, txIndex)
+				}
+			}()
+		}
+	}
+
+	for txIndex := 0; txIndex < txCount; txIndex++ {
+		var out txTraceOutput[T]
+	Wait:
+		for {
+			select {
+			case out = <-outputs[txIndex]:
+				break Wait
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			// next result is not ready: trace a pending transaction on the caller's tx instead of waiting,
+			// jobs is closed - so it never blocks
+			if job, ok := <-jobs; ok {
+				run(tx, job)
+				continue
+			}
+			select {
+			case out = <-outputs[txIndex]:
+				break Wait
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if out.err != nil {
+			return out.err
+		}
+		if err := emit(txIndex, out.result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
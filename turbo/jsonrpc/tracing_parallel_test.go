@@ -0,0 +1,78 @@
+package jsonrpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ledgerwatch/log/v3"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+
+	"github.com/ledgerwatch/erigon-lib/kv"
+	"github.com/ledgerwatch/erigon-lib/kv/mdbx"
+)
+
+func TestTraceTxsParallelReadConcurrencyLimit(t *testing.T) {
+	const readConcurrency, handlers, txCount = 2, 4, 20
+	db := mdbx.NewMDBX(log.New()).InMem(t.TempDir()).RoTxsLimiter(semaphore.NewWeighted(readConcurrency)).MustOpen()
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	trace := func(ctx context.Context, tx kv.Tx, txIndex int) (int, error) {
+		if _, err := tx.ReadSequence(kv.EthTx); err != nil { // tx must be usable
+			return 0, err
+		}
+		time.Sleep(time.Millisecond)
+		return txIndex * 10, nil
+	}
+
+	// more handlers than read slots: every handler holds its own tx and asks for more workers
+	var wg sync.WaitGroup
+	errs := make(chan error, handlers)
+	for h := 0; h < handlers; h++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- db.View(ctx, func(tx kv.Tx) error {
+				next := 0
+				return traceTxsParallel(ctx, db, tx, 8, txCount, trace, func(txIndex int, result int) error {
+					require.Equal(t, next, txIndex)
+					require.Equal(t, txIndex*10, result)
+					next++
+					return nil
+				})
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+func TestTraceTxsParallelNoFreeSlots(t *testing.T) {
+	db := mdbx.NewMDBX(log.New()).InMem(t.TempDir()).RoTxsLimiter(semaphore.NewWeighted(1)).MustOpen()
+	defer db.Close()
+	ctx := context.Background()
+
+	tx, err := db.BeginRo(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	// the only slot is taken by the caller: everything is traced sequentially on its tx
+	var results []int
+	err = traceTxsParallel(ctx, db, tx, 4, 5, func(ctx context.Context, workerTx kv.Tx, txIndex int) (int, error) {
+		require.Equal(t, tx, workerTx)
+		return txIndex, nil
+	}, func(txIndex int, result int) error {
+		results = append(results, result)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 1, 2, 3, 4}, results)
+}
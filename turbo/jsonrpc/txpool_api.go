@@ -3,6 +3,7 @@ package jsonrpc
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/ledgerwatch/erigon-lib/common/hexutil"
 
@@ -19,6 +20,7 @@ import (
 type TxPoolAPI interface {
 	Content(ctx context.Context) (map[string]map[string]map[string]*RPCTransaction, error)
 	ContentFrom(ctx context.Context, addr libcommon.Address) (map[string]map[string]*RPCTransaction, error)
+	GetTransactionStatusHistory(ctx context.Context, hash libcommon.Hash) ([]*TxStatusEvent, error)
 }
 
 // TxPoolAPIImpl data structure to store things needed for net_ commands
@@ -201,6 +203,35 @@ func (api *TxPoolAPIImpl) Status(ctx context.Context) (map[string]hexutil.Uint,
 	}, nil
 }
 
+// TxStatusEvent is an event of the transaction lifecycle in the pool
+type TxStatusEvent struct {
+	Event       string         `json:"event"`
+	SubPool     string         `json:"subPool,omitempty"`
+	Reason      string         `json:"reason,omitempty"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	Timestamp   hexutil.Uint64 `json:"timestamp"`
+}
+
+// GetTransactionStatusHistory returns the recorded pool events of the transaction, the oldest first.
+// Requires the pool event journal, which keeps the events for a limited time.
+func (api *TxPoolAPIImpl) GetTransactionStatusHistory(ctx context.Context, hash libcommon.Hash) ([]*TxStatusEvent, error) {
+	reply, err := api.pool.TransactionStatusHistory(ctx, &proto_txpool.TransactionStatusHistoryRequest{Hash: gointerfaces.ConvertHashToH256(hash)})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*TxStatusEvent, len(reply.Events))
+	for i, event := range reply.Events {
+		events[i] = &TxStatusEvent{
+			Event:       strings.ToLower(event.Type.String()),
+			SubPool:     event.SubPool,
+			Reason:      event.Reason,
+			BlockNumber: hexutil.Uint64(event.BlockNum),
+			Timestamp:   hexutil.Uint64(event.Timestamp),
+		}
+	}
+	return events, nil
+}
+
 /*
 
 // Inspect retrieves the content of the transaction pool and flattens it into an
@@ -68,4 +68,13 @@ func TestTxPoolContent(t *testing.T) {
 	require.Len(status, 3)
 	require.Equal(status["pending"], hexutil.Uint(1))
 	require.Equal(status["queued"], hexutil.Uint(0))
+
+	history, err := api.GetTransactionStatusHistory(ctx, txn.Hash())
+	require.NoError(err)
+	require.Len(history, 2)
+	require.Equal("added", history[0].Event)
+	require.Equal("Queued", history[0].SubPool)
+	require.Equal("promoted", history[1].Event)
+	require.Equal("Pending", history[1].SubPool)
+	require.Equal(hexutil.Uint64(1), history[1].BlockNumber)
 }
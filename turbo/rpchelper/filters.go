@@ -35,6 +35,7 @@ type Filters struct {
 	pendingLogsSubs  *SyncMap[PendingLogsSubID, Sub[types.Logs]]
 	pendingBlockSubs *SyncMap[PendingBlockSubID, Sub[*types.Block]]
 	pendingTxsSubs   *SyncMap[PendingTxsSubID, Sub[[]types.Transaction]]
+	chainStateSubs   *SyncMap[ChainStateSubID, Sub[struct{}]]
 	logsSubs         *LogsFilterAggregator
 	logsRequestor    atomic.Value
 	onNewSnapshot    func()
@@ -54,6 +55,7 @@ func New(ctx context.Context, ethBackend ApiBackend, txPool txpool.TxpoolClient,
 		pendingTxsSubs:     NewSyncMap[PendingTxsSubID, Sub[[]types.Transaction]](),
 		pendingLogsSubs:    NewSyncMap[PendingLogsSubID, Sub[types.Logs]](),
 		pendingBlockSubs:   NewSyncMap[PendingBlockSubID, Sub[*types.Block]](),
+		chainStateSubs:     NewSyncMap[ChainStateSubID, Sub[struct{}]](),
 		logsSubs:           NewLogsFilterAggregator(),
 		onNewSnapshot:      onNewSnapshot,
 		logsStores:         NewSyncMap[LogsSubID, []*types.Log](),
@@ -315,6 +317,25 @@ func (ff *Filters) UnsubscribeHeads(id HeadsSubID) bool {
 	return true
 }
 
+// SubscribeChainState notifies when stage progress or the forkchoice safe/finalized blocks change.
+// Subscribers re-read the state they are interested in from the db.
+func (ff *Filters) SubscribeChainState(size int) (<-chan struct{}, ChainStateSubID) {
+	id := ChainStateSubID(generateSubscriptionID())
+	sub := newChanSub[struct{}](size)
+	ff.chainStateSubs.Put(id, sub)
+	return sub.ch, id
+}
+
+func (ff *Filters) UnsubscribeChainState(id ChainStateSubID) bool {
+	ch, ok := ff.chainStateSubs.Get(id)
+	if !ok {
+		return false
+	}
+	ch.Close()
+	_, ok = ff.chainStateSubs.Delete(id)
+	return ok
+}
+
 func (ff *Filters) SubscribePendingLogs(size int) (<-chan types.Logs, PendingLogsSubID) {
 	id := PendingLogsSubID(generateSubscriptionID())
 	sub := newChanSub[types.Logs](size)
@@ -468,6 +489,8 @@ func (ff *Filters) onNewEvent(event *remote.SubscribeReply) error {
 		return ff.onPendingLog(event)
 	case remote.Event_PENDING_BLOCK:
 		return ff.onPendingBlock(event)
+	case remote.Event_CHAIN_STATE:
+		return ff.onChainState()
 	default:
 		return fmt.Errorf("unsupported event type")
 	}
@@ -521,6 +544,13 @@ func (ff *Filters) onNewHeader(event *remote.SubscribeReply) error {
 	})
 }
 
+func (ff *Filters) onChainState() error {
+	return ff.chainStateSubs.Range(func(k ChainStateSubID, v Sub[struct{}]) error {
+		v.Send(struct{}{})
+		return nil
+	})
+}
+
 func (ff *Filters) OnNewTx(reply *txpool.OnAddReply) {
 	txs := make([]types.Transaction, len(reply.RplTxs))
 	for i, rlpTx := range reply.RplTxs {
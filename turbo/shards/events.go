@@ -22,6 +22,7 @@ type Events struct {
 	id                        int
 	headerSubscriptions       map[int]chan [][]byte
 	newSnapshotSubscription   map[int]chan struct{}
+	chainStateSubscriptions   map[int]chan struct{}
 	pendingLogsSubscriptions  map[int]PendingLogsSubscription
 	pendingBlockSubscriptions map[int]PendingBlockSubscription
 	pendingTxsSubscriptions   map[int]PendingTxsSubscription
@@ -38,6 +39,7 @@ func NewEvents() *Events {
 		pendingTxsSubscriptions:   map[int]PendingTxsSubscription{},
 		logsSubscriptions:         map[int]chan []*remote.SubscribeLogsReply{},
 		newSnapshotSubscription:   map[int]chan struct{}{},
+		chainStateSubscriptions:   map[int]chan struct{}{},
 	}
 }
 
@@ -67,6 +69,20 @@ func (e *Events) AddNewSnapshotSubscription() (chan struct{}, func()) {
 	}
 }
 
+// AddChainStateSubscription notifies about changes of stage progress and of the forkchoice safe/finalized blocks
+func (e *Events) AddChainStateSubscription() (chan struct{}, func()) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	ch := make(chan struct{}, 8)
+	e.id++
+	id := e.id
+	e.chainStateSubscriptions[id] = ch
+	return ch, func() {
+		delete(e.chainStateSubscriptions, id)
+		close(ch)
+	}
+}
+
 func (e *Events) AddLogsSubscription() (chan []*remote.SubscribeLogsReply, func()) {
 	e.lock.Lock()
 	defer e.lock.Unlock()
@@ -112,6 +128,14 @@ func (e *Events) OnNewSnapshot() {
 	}
 }
 
+func (e *Events) OnChainStateChange() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for _, ch := range e.chainStateSubscriptions {
+		common.PrioritizedSend(ch, struct{}{})
+	}
+}
+
 func (e *Events) OnNewHeader(newHeadersRlp [][]byte) {
 	e.lock.Lock()
 	defer e.lock.Unlock()
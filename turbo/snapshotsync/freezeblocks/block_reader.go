@@ -35,6 +35,9 @@ import (
 
 var ErrSpanNotFound = errors.New("span not found")
 
+// ErrOutOfSelection - partial-history node didn't download files for requested block (see `--snap.partial.blocks`)
+var ErrOutOfSelection = errors.New("block is out of snapshots selection of this node")
+
 type RemoteBlockReader struct {
 	client remote.ETHBACKENDClient
 }
@@ -548,7 +551,7 @@ func (r *BlockReader) BodyWithTransactions(ctx context.Context, tx kv.Getter, ha
 		if dbgLogs {
 			log.Info(dbgPrefix+"no transactions file for this block num", "r.sn.BlocksAvailable()", r.sn.BlocksAvailable(), "r.sn.idxMax", r.sn.idxMax.Load(), "r.sn.segmetntsMax", r.sn.segmentsMax.Load())
 		}
-		return nil, nil
+		return nil, r.checkSelected(coresnaptype.Transactions, blockHeight)
 	}
 	txs, senders, err := r.txsFromSnapshot(baseTxnID, txsAmount, txnSeg, buf)
 	if err != nil {
@@ -718,7 +721,7 @@ func (r *BlockReader) blockWithSenders(ctx context.Context, tx kv.Getter, hash c
 		if dbgLogs {
 			log.Info(dbgPrefix+"no transactions file for this block num", "r.sn.BlocksAvailable()", r.sn.BlocksAvailable(), "r.sn.indicesReady", r.sn.indicesReady.Load())
 		}
-		return
+		return nil, nil, r.checkSelected(coresnaptype.Transactions, blockHeight)
 	}
 	var txs []types.Transaction
 	txs, senders, err = r.txsFromSnapshot(baseTxnId, txsAmount, txnSeg, buf)
@@ -1002,7 +1005,7 @@ func (r *BlockReader) TxnByIdxInBlock(ctx context.Context, tx kv.Getter, blockNu
 
 	txnSeg, ok := view.TxsSegment(blockNum)
 	if !ok {
-		return
+		return nil, r.checkSelected(coresnaptype.Transactions, blockNum)
 	}
 	// +1 because block has system-txn in the beginning of block
 	return r.txnByID(b.BaseTxId+1+uint64(txIdxInBlock), txnSeg, nil)
@@ -1068,20 +1071,33 @@ func (r *BlockReader) IterateFrozenBodies(f func(blockNum, baseTxNum, txAmount u
 	return nil
 }
 
+// checkSelected - returns ErrOutOfSelection if files of type `t` were not downloaded for blockNum
+func (r *BlockReader) checkSelected(t snaptype.Type, blockNum uint64) error {
+	if sel := r.sn.Cfg().Selection; !sel.Contains(t.Name(), blockNum) {
+		return fmt.Errorf("%w: %s of block %d, selection: %s", ErrOutOfSelection, t.Name(), blockNum, sel)
+	}
+	return nil
+}
+
 func (r *BlockReader) IntegrityTxnID(failFast bool) error {
 	defer log.Info("[integrity] IntegrityTxnID done")
 	view := r.sn.View()
 	defer view.Close()
 
 	var expectedFirstTxnID uint64
+	expectedKnown := true
 	for _, snb := range view.Bodies() {
 		firstBlockNum := snb.Index().BaseDataID()
-		sn, _ := view.TxsSegment(firstBlockNum)
+		sn, ok := view.TxsSegment(firstBlockNum)
+		if !ok { // partial-history node may not have transactions of this range
+			expectedKnown = false
+			continue
+		}
 		b, _, err := r.bodyForStorageFromSnapshot(firstBlockNum, snb, nil)
 		if err != nil {
 			return err
 		}
-		if b.BaseTxId != expectedFirstTxnID {
+		if expectedKnown && b.BaseTxId != expectedFirstTxnID {
 			err := fmt.Errorf("[integrity] IntegrityTxnID: bn=%d, baseID=%d, cnt=%d, expectedFirstTxnID=%d", firstBlockNum, b.BaseTxId, sn.Count(), expectedFirstTxnID)
 			if failFast {
 				return err
@@ -1089,7 +1105,7 @@ func (r *BlockReader) IntegrityTxnID(failFast bool) error {
 				log.Error(err.Error())
 			}
 		}
-		expectedFirstTxnID = b.BaseTxId + uint64(sn.Count())
+		expectedFirstTxnID, expectedKnown = b.BaseTxId+uint64(sn.Count()), true
 	}
 	return nil
 }
@@ -318,6 +318,11 @@ func (s *RoSnapshots) LogStat(label string) {
 		"alloc", common2.ByteCount(m.Alloc), "sys", common2.ByteCount(m.Sys))
 }
 
+// cappedBySelection - partial-history node has files of this type only up to `Selection.ToBlock`
+func (s *RoSnapshots) cappedBySelection(t snaptype.Type) bool {
+	return s.cfg.Selection.ToBlock > 0 && s.cfg.Selection.Restricted(t.Name())
+}
+
 func (s *RoSnapshots) EnsureExpectedBlocksAreAvailable(cfg *snapcfg.Cfg) error {
 	if s.BlocksAvailable() < cfg.ExpectBlocks {
 		return fmt.Errorf("app must wait until all expected snapshots are available. Expected: %d, Available: %d", cfg.ExpectBlocks, s.BlocksAvailable())
@@ -384,7 +389,7 @@ func (s *RoSnapshots) idxAvailability() uint64 {
 	//   5. file-types may have different height: 10 headers, 10 bodies, 9 trancasctions (for example if `kill -9` came during files building/merge). still need index all 3 types.
 	amount := 0
 	s.segments.Scan(func(segtype snaptype.Enum, value *segments) bool {
-		if len(value.segments) == 0 || !s.HasType(segtype.Type()) {
+		if len(value.segments) == 0 || !s.HasType(segtype.Type()) || s.cappedBySelection(segtype.Type()) {
 			return true
 		}
 		amount++
@@ -394,7 +399,7 @@ func (s *RoSnapshots) idxAvailability() uint64 {
 	maximums := make([]uint64, amount)
 	var i int
 	s.segments.Scan(func(segtype snaptype.Enum, value *segments) bool {
-		if len(value.segments) == 0 || !s.HasType(segtype.Type()) {
+		if len(value.segments) == 0 || !s.HasType(segtype.Type()) || s.cappedBySelection(segtype.Type()) {
 			return true
 		}
 
@@ -466,6 +471,28 @@ func (s *RoSnapshots) OpenFiles() (list []string) {
 	return list
 }
 
+// OpenedFiles - opened segments with their indices
+func (s *RoSnapshots) OpenedFiles() (list []snaptype.OpenedFile) {
+	s.segments.Scan(func(segtype snaptype.Enum, value *segments) bool {
+		value.lock.RLock()
+		defer value.lock.RUnlock()
+
+		for _, seg := range value.segments {
+			if !seg.IsOpen() {
+				continue
+			}
+			f := snaptype.OpenedFile{Path: seg.FilePath(), Words: uint64(seg.Count())}
+			for _, index := range seg.indexes {
+				f.Accessors = append(f.Accessors, snaptype.OpenedAccessor{Path: index.FilePath(), Keys: index.KeyCount()})
+			}
+			list = append(list, f)
+		}
+		return true
+	})
+
+	return list
+}
+
 // ReopenList stops on optimistic=false, continue opening files on optimistic=true
 func (s *RoSnapshots) ReopenList(fileNames []string, optimistic bool) error {
 	if err := s.rebuildSegments(fileNames, true, optimistic); err != nil {
@@ -566,6 +593,9 @@ func (s *RoSnapshots) rebuildSegments(fileNames []string, open bool, optimistic
 			}
 		}
 
+		if s.cappedBySelection(f.Type) { // partial-history node: this type doesn't reach chain tip
+			continue
+		}
 		if f.To > 0 {
 			segmentsMax = f.To - 1
 		} else {
@@ -600,7 +630,7 @@ func (s *RoSnapshots) ReopenFolder() error {
 }
 
 func (s *RoSnapshots) ReopenSegments(types []snaptype.Type, allowGaps bool) error {
-	files, _, err := typedSegments(s.dir, s.segmentsMin.Load(), types, allowGaps)
+	files, _, err := typedSegments(s.dir, s.segmentsMin.Load(), types, allowGaps, s.cfg.Selection)
 
 	if err != nil {
 		return err
@@ -967,13 +997,16 @@ func noGaps(in []snaptype.FileInfo) (out []snaptype.FileInfo, missingSnapshots [
 	return out, missingSnapshots
 }
 
-func typeOfSegmentsMustExist(dir string, in []snaptype.FileInfo, types []snaptype.Type) (res []snaptype.FileInfo) {
+func typeOfSegmentsMustExist(dir string, in []snaptype.FileInfo, types []snaptype.Type, sel snaptype.Selection) (res []snaptype.FileInfo) {
 MainLoop:
 	for _, f := range in {
 		if f.From == f.To {
 			continue
 		}
 		for _, t := range types {
+			if !sel.Has(t.Name(), f.From, f.To) { // partial-history node doesn't have this type for given range
+				continue
+			}
 			p := filepath.Join(dir, snaptype.SegmentFileName(f.Version, f.From, f.To, t.Enum()))
 			if !dir2.FileExist(p) {
 				continue MainLoop
@@ -1085,12 +1118,13 @@ func SegmentsCaplin(dir string, minBlock uint64) (res []snaptype.FileInfo, missi
 }
 
 func Segments(dir string, minBlock uint64) (res []snaptype.FileInfo, missingSnapshots []Range, err error) {
-	return typedSegments(dir, minBlock, coresnaptype.BlockSnapshotTypes, true)
+	return typedSegments(dir, minBlock, coresnaptype.BlockSnapshotTypes, true, snaptype.Selection{})
 }
 
-func typedSegments(dir string, minBlock uint64, types []snaptype.Type, allowGaps bool) (res []snaptype.FileInfo, missingSnapshots []Range, err error) {
+// typedSegments - `sel` is partial-history node's selection: files out of it are not used
+func typedSegments(dir string, minBlock uint64, types []snaptype.Type, allowGaps bool, sel snaptype.Selection) (res []snaptype.FileInfo, missingSnapshots []Range, err error) {
 	segmentsTypeCheck := func(dir string, in []snaptype.FileInfo) (res []snaptype.FileInfo) {
-		return typeOfSegmentsMustExist(dir, in, types)
+		return typeOfSegmentsMustExist(dir, in, types, sel)
 	}
 
 	list, err := snaptype.Segments(dir)
@@ -1107,6 +1141,9 @@ func typedSegments(dir string, minBlock uint64, types []snaptype.Type, allowGaps
 				if f.Type.Enum() != segType.Enum() {
 					continue
 				}
+				if !sel.Has(segType.Name(), f.From, f.To) {
+					continue
+				}
 				l = append(l, f)
 			}
 
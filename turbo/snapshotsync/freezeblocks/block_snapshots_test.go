@@ -398,6 +398,41 @@ func TestOpenAllSnapshot(t *testing.T) {
 	}
 }
 
+func TestOpenPartialSnapshots(t *testing.T) {
+	logger := log.New()
+	dir, require := t.TempDir(), require.New(t)
+	createFile := func(from, to uint64, name snaptype.Type) {
+		createTestSegmentFile(t, from, to, name.Enum(), dir, 1, logger)
+	}
+	// partial-history node: headers/bodies of all chain, transactions only of selected range
+	createFile(0, 500_000, coresnaptype.Headers)
+	createFile(0, 500_000, coresnaptype.Bodies)
+	createFile(500_000, 1_000_000, coresnaptype.Headers)
+	createFile(500_000, 1_000_000, coresnaptype.Bodies)
+	createFile(500_000, 1_000_000, coresnaptype.Transactions)
+
+	sel, err := snaptype.ParseSelection("600000-", "transactions")
+	require.NoError(err)
+	s := NewRoSnapshots(ethconfig.BlocksFreezing{Enabled: true, Selection: sel}, dir, 0, logger)
+	defer s.Close()
+	require.NoError(s.ReopenFolder())
+	require.Equal(uint64(999_999), s.SegmentsMax())
+
+	view := s.View()
+	defer view.Close()
+	_, ok := view.HeadersSegment(10)
+	require.True(ok)
+	_, ok = view.TxsSegment(10)
+	require.False(ok)
+	_, ok = view.TxsSegment(700_000)
+	require.True(ok)
+
+	br := NewBlockReader(s, nil)
+	require.ErrorIs(br.checkSelected(coresnaptype.Transactions, 10), ErrOutOfSelection)
+	require.NoError(br.checkSelected(coresnaptype.Transactions, 700_000))
+	require.NoError(br.checkSelected(coresnaptype.Headers, 10))
+}
+
 func TestParseCompressedFileName(t *testing.T) {
 	require := require.New(t)
 	fs := fstest.MapFS{
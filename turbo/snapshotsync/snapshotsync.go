@@ -190,6 +190,58 @@ func buildBlackListForPruning(pruneMode bool, stepPrune, minBlockToDownload, blo
 	return blackList, nil
 }
 
+// buildBlackListForSelection - partial-history node: skip files not covered by `sel`.
+// History files are selected by [fromStep, toStep) - steps of selected block range.
+func buildBlackListForSelection(sel snaptype.Selection, fromStep, toStep uint64, preverified snapcfg.Preverified) map[string]struct{} {
+	blackList := make(map[string]struct{})
+	if !sel.Enabled() {
+		return blackList
+	}
+	for _, p := range preverified {
+		name := p.Name
+		if strings.HasPrefix(name, "domain") { // latest state is always needed
+			continue
+		}
+		if shouldUseStepsForPruning(name) {
+			kind, from, to, ok := snaptype.ParseStateFileName(name)
+			if !ok {
+				continue
+			}
+			if !sel.HasType(kind) || to <= fromStep || from >= toStep {
+				blackList[name] = struct{}{}
+			}
+			continue
+		}
+		info, _, ok := snaptype.ParseFileName("", name)
+		if !ok || info.Type == nil {
+			continue
+		}
+		if !sel.Has(info.Type.Name(), info.From, info.To) {
+			blackList[name] = struct{}{}
+		}
+	}
+	return blackList
+}
+
+// getSelectionSteps - maps selected block range to steps range, using frozen bodies: the steps of the first frozen
+// blocks at or after the bounds. Bounds after the frozen blocks are math.MaxUint64: no history files of this node
+// start after them.
+func getSelectionSteps(blockReader services.FullBlockReader, sel snaptype.Selection) (fromStep, toStep uint64, err error) {
+	fromStep, toStep = math.MaxUint64, math.MaxUint64
+	if err := blockReader.IterateFrozenBodies(func(blockNum, baseTxNum, txAmount uint64) error {
+		if fromStep == math.MaxUint64 && blockNum >= sel.FromBlock {
+			fromStep = baseTxNum / config3.HistoryV3AggregationStep
+		}
+		if toStep == math.MaxUint64 && sel.ToBlock > 0 && blockNum >= sel.ToBlock {
+			toStep = (baseTxNum + config3.HistoryV3AggregationStep - 1) / config3.HistoryV3AggregationStep
+		}
+		return nil
+	}); err != nil {
+		return 0, 0, err
+	}
+	return fromStep, toStep, nil
+}
+
 // getMinimumBlocksToDownload - get the minimum number of blocks to download
 func getMinimumBlocksToDownload(tx kv.Tx, blockReader services.FullBlockReader, minStep uint64, expectedPruneBlockAmount, expectedPruneHistoryAmount uint64) (uint64, uint64, error) {
 	frozenBlocks := blockReader.Snapshots().SegmentsMax()
@@ -309,6 +361,16 @@ func WaitForDownloader(ctx context.Context, logPrefix string, headerchain, blobs
 		}
 	}
 
+	blackListForSelection := make(map[string]struct{})
+	if sel := blockReader.FreezingCfg().Selection; !headerchain && sel.Enabled() {
+		fromStep, toStep, err := getSelectionSteps(blockReader, sel)
+		if err != nil {
+			return err
+		}
+		blackListForSelection = buildBlackListForSelection(sel, fromStep, toStep, preverifiedBlockSnapshots)
+		log.Info(fmt.Sprintf("[%s] Partial-history node", logPrefix), "selection", sel, "fromStep", fromStep, "toStep", toStep, "skip", len(blackListForSelection))
+	}
+
 	// build all download requests
 	for _, p := range preverifiedBlockSnapshots {
 		if caplin == NoCaplin && (strings.Contains(p.Name, "beaconblocks") || strings.Contains(p.Name, "blobsidecars")) {
@@ -326,6 +388,9 @@ func WaitForDownloader(ctx context.Context, logPrefix string, headerchain, blobs
 		if _, ok := blackListForPruning[p.Name]; ok {
 			continue
 		}
+		if _, ok := blackListForSelection[p.Name]; ok {
+			continue
+		}
 
 		downloadRequest = append(downloadRequest, services.NewDownloadRequest(p.Name, p.Hash))
 	}
@@ -1,11 +1,15 @@
 package snapshotsync
 
 import (
+	"math"
 	"strings"
 	"testing"
 
 	"github.com/ledgerwatch/erigon-lib/chain/snapcfg"
+	"github.com/ledgerwatch/erigon-lib/config3"
 	"github.com/ledgerwatch/erigon-lib/downloader/snaptype"
+
+	"github.com/ledgerwatch/erigon/turbo/services"
 )
 
 func TestBlackListForPruning(t *testing.T) {
@@ -41,3 +45,83 @@ func TestBlackListForPruning(t *testing.T) {
 	}
 
 }
+
+func TestBlackListForSelection(t *testing.T) {
+	preverified := snapcfg.Mainnet
+
+	sel, err := snaptype.ParseSelection("19000000-", "transactions,accounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	blackList := buildBlackListForSelection(sel, 1024, math.MaxUint64, preverified)
+	if len(blackList) == 0 {
+		t.Fatal("expected some files to be skipped")
+	}
+	for _, p := range preverified {
+		_, skip := blackList[p.Name]
+		if strings.HasPrefix(p.Name, "domain") || strings.Contains(p.Name, "headers") || strings.Contains(p.Name, "bodies") {
+			if skip {
+				t.Errorf("Should not have skipped %s", p.Name)
+			}
+			continue
+		}
+		if kind, _, to, ok := snaptype.ParseStateFileName(p.Name); ok && shouldUseStepsForPruning(p.Name) {
+			if want := kind != "accounts" || to <= 1024; want != skip {
+				t.Errorf("%s: skip=%t, expected %t", p.Name, skip, want)
+			}
+			continue
+		}
+		info, _, ok := snaptype.ParseFileName("", p.Name)
+		if !ok || info.Type == nil {
+			continue
+		}
+		if want := info.Type.Name() != "transactions" || info.To <= 19_000_000; want != skip {
+			t.Errorf("%s: skip=%t, expected %t", p.Name, skip, want)
+		}
+	}
+
+	if blackList = buildBlackListForSelection(snaptype.Selection{}, 0, 0, preverified); len(blackList) != 0 {
+		t.Errorf("empty selection must not skip files")
+	}
+}
+
+// frozenBodies - bodies of blocks [from, from+n), one step is 4 blocks
+type frozenBodies struct {
+	services.FullBlockReader
+	from uint64
+	n    int
+}
+
+func (r frozenBodies) IterateFrozenBodies(f func(blockNum, baseTxNum, txAmount uint64) error) error {
+	for i := 0; i < r.n; i++ {
+		blockNum := r.from + uint64(i)
+		if err := f(blockNum, blockNum*config3.HistoryV3AggregationStep/4, 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestSelectionSteps(t *testing.T) {
+	for _, tc := range []struct {
+		reader           frozenBodies
+		from, to         uint64
+		fromStep, toStep uint64
+	}{
+		{reader: frozenBodies{n: 20}, from: 6, to: 10, fromStep: 1, toStep: 3},
+		{reader: frozenBodies{n: 20}, from: 8, fromStep: 2, toStep: math.MaxUint64},
+		{reader: frozenBodies{n: 20}, from: 6, to: 30, fromStep: 1, toStep: math.MaxUint64},
+		// bounds which are not frozen blocks are matched by the next frozen ones
+		{reader: frozenBodies{from: 8, n: 12}, from: 2, to: 5, fromStep: 2, toStep: 2},
+		// selection after the frozen blocks skips all history files
+		{reader: frozenBodies{n: 20}, from: 30, fromStep: math.MaxUint64, toStep: math.MaxUint64},
+	} {
+		fromStep, toStep, err := getSelectionSteps(tc.reader, snaptype.Selection{FromBlock: tc.from, ToBlock: tc.to})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fromStep != tc.fromStep || toStep != tc.toStep {
+			t.Errorf("blocks %d-%d: steps %d-%d, expected %d-%d", tc.from, tc.to, fromStep, toStep, tc.fromStep, tc.toStep)
+		}
+	}
+}
@@ -303,6 +303,7 @@ func MockWithEverything(tb testing.TB, gspec *types.Genesis, key *ecdsa.PrivateK
 	blockPropagator := func(Ctx context.Context, header *types.Header, body *types.RawBody, td *big.Int) {}
 	if !cfg.DeprecatedTxPool.Disable {
 		poolCfg := txpoolcfg.DefaultConfig
+		poolCfg.Journal = true
 		newTxs := make(chan types2.Announcements, 1024)
 		if tb != nil {
 			tb.Cleanup(func() {
@@ -312,10 +313,15 @@ func MockWithEverything(tb testing.TB, gspec *types.Genesis, key *ecdsa.PrivateK
 		chainID, _ := uint256.FromBig(mock.ChainConfig.ChainID)
 		shanghaiTime := mock.ChainConfig.ShanghaiTime
 		cancunTime := mock.ChainConfig.CancunTime
+		pragueTime := mock.ChainConfig.PragueTime
 		maxBlobsPerBlock := mock.ChainConfig.GetMaxBlobsPerBlock()
-		mock.TxPool, err = txpool.New(newTxs, mock.DB, poolCfg, kvcache.NewDummy(), *chainID, shanghaiTime, nil /* agraBlock */, cancunTime, maxBlobsPerBlock, nil, logger)
+		mock.TxPool, err = txpool.New(newTxs, mock.DB, poolCfg, kvcache.NewDummy(), *chainID, shanghaiTime, nil /* agraBlock */, cancunTime, pragueTime, maxBlobsPerBlock, nil, logger)
 		if err != nil {
-			tb.Fatal(err)
+			if tb != nil {
+				tb.Fatal(err)
+			} else {
+				panic(err)
+			}
 		}
 		mock.txPoolDB = memdb.NewPoolDB(tmpdir)
 
@@ -590,7 +596,11 @@ func MockWithEverything(tb testing.TB, gspec *types.Genesis, key *ecdsa.PrivateK
 		TopBlock: mock.Genesis,
 	}
 	if err = mock.InsertChain(c); err != nil {
-		tb.Fatal(err)
+		if tb != nil {
+			tb.Fatal(err)
+		} else {
+			panic(err)
+		}
 	}
 	return mock
 }
@@ -332,6 +332,8 @@ func (h *Hook) sendNotifications(notifications *shards.Notifications, tx kv.Tx,
 	}
 
 	if notifications.Events != nil {
+		// stage progress and forkchoice safe/finalized blocks are committed by now
+		notifications.Events.OnChainStateChange()
 		finishStageAfterSync, err := stages.GetStageProgress(tx, stages.Finish)
 		if err != nil {
 			return err
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"time"
 
 	jsoniter "github.com/json-iterator/go"
@@ -84,7 +85,15 @@ func TraceTx(
 	stream *jsoniter.Stream,
 	callTimeout time.Duration,
 ) error {
-	tracer, streaming, cancel, err := AssembleTracer(ctx, config, txCtx.TxHash, stream, callTimeout)
+	tracerCtx := &tracers.Context{
+		BlockNumber: new(big.Int).SetUint64(blockCtx.BlockNumber),
+		TxHash:      txCtx.TxHash,
+	}
+	if statedb, ok := ibs.(*state.IntraBlockState); ok {
+		tracerCtx.BlockHash = statedb.BlockHash()
+		tracerCtx.TxIndex = statedb.TxIndex()
+	}
+	tracer, streaming, cancel, err := AssembleTracer(ctx, config, tracerCtx, stream, callTimeout)
 	if err != nil {
 		stream.WriteNil()
 		return err
@@ -103,7 +112,7 @@ func TraceTx(
 func AssembleTracer(
 	ctx context.Context,
 	config *tracers.TraceConfig,
-	txHash libcommon.Hash,
+	tracerCtx *tracers.Context,
 	stream *jsoniter.Stream,
 	callTimeout time.Duration,
 ) (vm.EVMLogger, bool, context.CancelFunc, error) {
@@ -125,7 +134,7 @@ func AssembleTracer(
 		if config != nil && config.TracerConfig != nil {
 			cfg = *config.TracerConfig
 		}
-		tracer, err := tracers.New(*config.Tracer, &tracers.Context{TxHash: txHash}, cfg)
+		tracer, err := tracers.New(*config.Tracer, tracerCtx, cfg)
 		if err != nil {
 			return nil, false, func() {}, err
 		}